@@ -2,7 +2,7 @@ package main
 
 import (
 	"context"
-	"log"
+	"log/slog"
 	"os"
 	"os/signal"
 	"syscall"
@@ -29,19 +29,42 @@ func main() {
 		cancel()
 	}()
 
+	// "migrate up|down|status" manages the schema instead of serving
+	if len(os.Args) > 1 && os.Args[1] == "migrate" {
+		if err := app.RunMigrate(ctx, os.Args[2:], os.Stdout); err != nil {
+			slog.Error("Error while migrating", "error", err)
+			os.Exit(1)
+		}
+		return
+	}
+
 	// Initialize the application using Uber FX
 	app := app.InitializeApp()
 
 	// Start the Uber FX lifecycle
 	if err := app.Start(ctx); err != nil {
-		log.Fatalf("Error while starting app: %v", err)
+		slog.Error("Error while starting app", "error", err)
+		os.Exit(1)
 	}
 
-	// Wait until the context is canceled
-	<-ctx.Done()
+	// Wait for a signal, or for the app to ask to stop itself (e.g. the HTTP
+	// server failed), and remember its exit code.
+	exitCode := 0
+	select {
+	case <-ctx.Done():
+	case shutdown := <-app.Wait():
+		exitCode = shutdown.ExitCode
+	}
 
-	// Stop the Uber FX lifecycle
-	if err := app.Stop(ctx); err != nil {
-		log.Fatalf("Error while stopping app: %v", err)
+	// Stop the Uber FX lifecycle; ctx is already canceled, so the hooks get
+	// their own deadline to drain requests and close the database.
+	stopCtx, stopCancel := context.WithTimeout(context.Background(), app.StopTimeout())
+	err := app.Stop(stopCtx)
+	stopCancel()
+	if err != nil {
+		slog.Error("Error while stopping app", "error", err)
+		os.Exit(1)
 	}
+
+	os.Exit(exitCode)
 }
@@ -2,55 +2,161 @@ package app
 
 import (
 	"context"
-	"log"
+	"errors"
+	"log/slog"
+	"net"
 	"net/http"
-	"os"
+	"strconv"
+	"time"
 
-	"github.com/go-chi/chi/middleware"
 	"github.com/go-chi/chi/v5"
+	"github.com/prometheus/client_golang/prometheus"
 	httpSwagger "github.com/swaggo/http-swagger"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
 	"go.uber.org/fx"
+	"go.uber.org/fx/fxevent"
 
+	"github.com/mathefer/tc-fiap-product/internal/config"
 	productController "github.com/mathefer/tc-fiap-product/internal/product/controller"
 	productRepositories "github.com/mathefer/tc-fiap-product/internal/product/domain/repositories"
 	productApiController "github.com/mathefer/tc-fiap-product/internal/product/infrastructure/api/controller"
-	productPersistence "github.com/mathefer/tc-fiap-product/internal/product/infrastructure/persistence"
+	productMiddleware "github.com/mathefer/tc-fiap-product/internal/product/infrastructure/api/middleware"
 	productPresenter "github.com/mathefer/tc-fiap-product/internal/product/presenter"
+	productUseCasesAddCategory "github.com/mathefer/tc-fiap-product/internal/product/usecase/addCategory"
 	productUseCasesAdd "github.com/mathefer/tc-fiap-product/internal/product/usecase/addProduct"
+	productUseCasesDeleteCategory "github.com/mathefer/tc-fiap-product/internal/product/usecase/deleteCategory"
 	productUseCasesDelete "github.com/mathefer/tc-fiap-product/internal/product/usecase/deleteProduct"
+	productUseCasesGetCategoryByID "github.com/mathefer/tc-fiap-product/internal/product/usecase/getCategoryByID"
 	productUseCasesGet "github.com/mathefer/tc-fiap-product/internal/product/usecase/getProduct"
+	productUseCasesGetByID "github.com/mathefer/tc-fiap-product/internal/product/usecase/getProductByID"
+	productUseCasesLastModified "github.com/mathefer/tc-fiap-product/internal/product/usecase/getProductsLastModified"
+	productUseCasesListCategories "github.com/mathefer/tc-fiap-product/internal/product/usecase/listCategories"
+	productUseCasesList "github.com/mathefer/tc-fiap-product/internal/product/usecase/listProducts"
+	productUseCasesLookup "github.com/mathefer/tc-fiap-product/internal/product/usecase/lookupProducts"
+	productUseCasesPatch "github.com/mathefer/tc-fiap-product/internal/product/usecase/patchProduct"
+	productUseCasesRestore "github.com/mathefer/tc-fiap-product/internal/product/usecase/restoreProduct"
+	productUseCasesUpdateCategory "github.com/mathefer/tc-fiap-product/internal/product/usecase/updateCategory"
 	productUseCasesUpdate "github.com/mathefer/tc-fiap-product/internal/product/usecase/updateProduct"
 
+	"github.com/mathefer/tc-fiap-product/pkg/health"
+	"github.com/mathefer/tc-fiap-product/pkg/logging"
+	"github.com/mathefer/tc-fiap-product/pkg/metrics"
 	"github.com/mathefer/tc-fiap-product/pkg/rest"
-	"github.com/mathefer/tc-fiap-product/pkg/storage/postgres"
+	"github.com/mathefer/tc-fiap-product/pkg/tracing"
 )
 
+const (
+	readHeaderTimeout = 5 * time.Second
+	readTimeout       = 15 * time.Second
+	writeTimeout      = 15 * time.Second
+	idleTimeout       = 60 * time.Second
+
+	// shutdownTimeout bounds how long in-flight requests may take to drain
+	// once the server stops accepting connections. Together with drainDelay
+	// it must stay below the Kubernetes terminationGracePeriodSeconds.
+	shutdownTimeout = 20 * time.Second
+	stopTimeout     = defaultDrainDelay + shutdownTimeout + 5*time.Second
+
+	defaultDrainDelay = 5 * time.Second
+
+	idempotencyCleanupInterval = time.Hour
+	// idempotencyLease is how long a request may hold an Idempotency-Key
+	// before a retry takes it over. It is well past writeTimeout, by which
+	// the client has stopped waiting for the first response.
+	idempotencyLease = 2 * writeTimeout
+)
+
+// drainDelay is how long /readyz reports draining before the server stops
+// accepting connections, giving the load balancer time to stop routing new
+// requests to this pod.
+var drainDelay = defaultDrainDelay
+
+// InitializeApp loads the configuration and builds the service from it. An
+// invalid configuration fails the app before anything starts.
 func InitializeApp() *fx.App {
+	cfg, err := config.Load()
+	if err != nil {
+		return fx.New(fx.Error(err))
+	}
 	return fx.New(
+		fx.StopTimeout(stopTimeout),
+		fx.WithLogger(func(logger *slog.Logger) fxevent.Logger {
+			return &fxevent.SlogLogger{Logger: logger}
+		}),
+		Options(cfg),
+	)
+}
+
+// Options wires the service for cfg, which is provided to every component,
+// around the storage module selected by cfg.Storage.Backend. InitializeApp
+// and the integration tests share it so that both run the same wiring.
+func Options(cfg *config.Config) fx.Option {
+	return fx.Options(
+		fx.Supply(cfg),
+		storage(cfg),
+		productCache(cfg),
 		fx.Provide(
-			postgres.NewPostgresDB,
-			fx.Annotate(productPersistence.NewProductRepositoryImpl, fx.As(new(productRepositories.ProductRepository))),
+			newLogger,
+			newHTTPServer,
+			fx.Annotate(newReadiness, fx.ParamTags(`group:"readiness"`)),
+			fx.Annotate(metrics.NewRegistry, fx.As(fx.Self()), fx.As(new(prometheus.Registerer))),
+			metrics.NewHTTPMetrics,
+			newIdempotency,
+			newTracerProvider,
 			fx.Annotate(productController.NewProductControllerImpl, fx.As(new(productController.ProductController))),
+			fx.Annotate(productController.NewCategoryControllerImpl, fx.As(new(productController.CategoryController))),
 			fx.Annotate(productPresenter.NewProductPresenterImpl, fx.As(new(productPresenter.ProductPresenter))),
+			fx.Annotate(productPresenter.NewCategoryPresenterImpl, fx.As(new(productPresenter.CategoryPresenter))),
 			fx.Annotate(productUseCasesAdd.NewAddProductUseCaseImpl, fx.As(new(productUseCasesAdd.AddProductUseCase))),
 			fx.Annotate(productUseCasesGet.NewGetProductUseCaseImpl, fx.As(new(productUseCasesGet.GetProductUseCase))),
+			fx.Annotate(productUseCasesGetByID.NewGetProductByIDUseCaseImpl, fx.As(new(productUseCasesGetByID.GetProductByIDUseCase))),
+			fx.Annotate(productUseCasesLookup.NewLookupProductsUseCaseImpl, fx.As(new(productUseCasesLookup.LookupProductsUseCase))),
+			fx.Annotate(productUseCasesList.NewListProductsUseCaseImpl, fx.As(new(productUseCasesList.ListProductsUseCase))),
 			fx.Annotate(productUseCasesUpdate.NewUpdateProductUseCaseImpl, fx.As(new(productUseCasesUpdate.UpdateProductUseCase))),
+			fx.Annotate(productUseCasesPatch.NewPatchProductUseCaseImpl, fx.As(new(productUseCasesPatch.PatchProductUseCase))),
 			fx.Annotate(productUseCasesDelete.NewDeleteProductUseCaseImpl, fx.As(new(productUseCasesDelete.DeleteProductUseCase))),
+			fx.Annotate(productUseCasesRestore.NewRestoreProductUseCaseImpl, fx.As(new(productUseCasesRestore.RestoreProductUseCase))),
+			fx.Annotate(productUseCasesLastModified.NewGetProductsLastModifiedUseCaseImpl, fx.As(new(productUseCasesLastModified.GetProductsLastModifiedUseCase))),
+			fx.Annotate(productUseCasesListCategories.NewListCategoriesUseCaseImpl, fx.As(new(productUseCasesListCategories.ListCategoriesUseCase))),
+			fx.Annotate(productUseCasesGetCategoryByID.NewGetCategoryByIDUseCaseImpl, fx.As(new(productUseCasesGetCategoryByID.GetCategoryByIDUseCase))),
+			fx.Annotate(productUseCasesAddCategory.NewAddCategoryUseCaseImpl, fx.As(new(productUseCasesAddCategory.AddCategoryUseCase))),
+			fx.Annotate(productUseCasesUpdateCategory.NewUpdateCategoryUseCaseImpl, fx.As(new(productUseCasesUpdateCategory.UpdateCategoryUseCase))),
+			fx.Annotate(productUseCasesDeleteCategory.NewDeleteCategoryUseCaseImpl, fx.As(new(productUseCasesDeleteCategory.DeleteCategoryUseCase))),
 			chi.NewRouter,
 			func(
-				productController productController.ProductController) []rest.Controller {
+				productController productController.ProductController,
+				categoryController productController.CategoryController,
+				readiness *health.Readiness,
+				registry *prometheus.Registry,
+				idempotency *productMiddleware.Idempotency,
+				cfg *config.Config) []rest.Controller {
 				return []rest.Controller{
-					productApiController.NewProductController(productController),
+					health.NewController(readiness),
+					metrics.NewController(registry),
+					productApiController.NewProductController(productController, idempotency, cfg.HTTP.RequireIfMatch, cfg.HTTP.CacheControl),
+					productApiController.NewCategoryController(categoryController),
 				}
 			},
 		),
+		// Route the standard log package and slog's default logger through
+		// the JSON logger as well.
+		fx.Invoke(slog.SetDefault),
+		fx.Invoke(registerMetrics),
+		fx.Invoke(registerIdempotencyCleanup),
 		fx.Invoke(registerRoutes),
+		// Hooks stop in reverse order, so spans are flushed only after the
+		// HTTP server has drained.
 		fx.Invoke(startHTTPServer),
 	)
 }
 
-func registerRoutes(r *chi.Mux, controllers []rest.Controller) {
-	r.Use(middleware.Logger)
+func registerRoutes(r *chi.Mux, controllers []rest.Controller, httpMetrics *metrics.HTTPMetrics, tracerProvider trace.TracerProvider, logger *slog.Logger) {
+	r.Use(logging.RequestID)
+	r.Use(tracing.Middleware(tracerProvider))
+	r.Use(logging.AccessLog(logger))
+	r.Use(httpMetrics.Middleware)
 
 	// Swagger UI
 	r.Get("/swagger/*", httpSwagger.Handler(
@@ -62,26 +168,140 @@ func registerRoutes(r *chi.Mux, controllers []rest.Controller) {
 	}
 }
 
-func startHTTPServer(lc fx.Lifecycle, r *chi.Mux) {
-	port := os.Getenv("PORT")
-	if port == "" {
-		port = "8081"
+// registerMetrics exposes the catalog size per category, computed on every
+// scrape. Database metrics are recorded by the storage module.
+func registerMetrics(registerer prometheus.Registerer, productRepository productRepositories.ProductRepository) {
+	registerer.MustRegister(metrics.NewGaugeFunc("catalog_products",
+		"Products in the catalog by category, excluding deleted products.",
+		[]string{"category"},
+		func(ctx context.Context) ([]metrics.Sample, error) {
+			counts, err := productRepository.CountByCategory(ctx)
+			if err != nil {
+				return nil, err
+			}
+			samples := make([]metrics.Sample, 0, len(counts))
+			for category, count := range counts {
+				samples = append(samples, metrics.Sample{LabelValues: []string{strconv.Itoa(category)}, Value: float64(count)})
+			}
+			return samples, nil
+		}))
+}
+
+// newTracerProvider builds the configured tracer provider and installs it
+// as the global one used by tracing.Start. The storage module or the routes
+// create it before the HTTP server starts, so its hook, which stops in
+// reverse order, flushes spans only after the server has drained.
+func newTracerProvider(lc fx.Lifecycle, cfg *config.Config, logger *slog.Logger) (trace.TracerProvider, error) {
+	provider, err := tracing.NewTracerProvider(context.Background(), cfg.Tracing.TracerConfig())
+	if err != nil {
+		return nil, err
 	}
 
+	otel.SetTracerProvider(provider)
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+	lc.Append(fx.Hook{
+		OnStop: func(ctx context.Context) error {
+			logger.Info("Flushing traces")
+			return provider.Shutdown(ctx)
+		},
+	})
+	return provider, nil
+}
+
+func newIdempotency(repository productRepositories.IdempotencyKeyRepository, cfg *config.Config, logger *slog.Logger) *productMiddleware.Idempotency {
+	return productMiddleware.NewIdempotency(repository, cfg.HTTP.IdempotencyKeyTTL, idempotencyLease, logger)
+}
+
+// registerIdempotencyCleanup deletes expired idempotency keys every
+// idempotencyCleanupInterval while the app runs, so the table does not grow
+// without bound.
+func registerIdempotencyCleanup(lc fx.Lifecycle, idempotency *productMiddleware.Idempotency, logger *slog.Logger) {
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	lc.Append(fx.Hook{
+		OnStart: func(context.Context) error {
+			go func() {
+				defer close(done)
+				ticker := time.NewTicker(idempotencyCleanupInterval)
+				defer ticker.Stop()
+				for {
+					select {
+					case <-ctx.Done():
+						return
+					case <-ticker.C:
+						if err := idempotency.DeleteExpired(ctx); err != nil && ctx.Err() == nil {
+							logger.Error("Failed to delete expired idempotency keys", "error", err)
+						}
+					}
+				}
+			}()
+			return nil
+		},
+		OnStop: func(stopCtx context.Context) error {
+			cancel()
+			select {
+			case <-done:
+				return nil
+			case <-stopCtx.Done():
+				return stopCtx.Err()
+			}
+		},
+	})
+}
+
+// newReadiness runs the checks contributed by the storage module, such as
+// whether the database answers, before the pod is put behind the load
+// balancer.
+func newReadiness(checks []health.Check) *health.Readiness {
+	return health.NewReadiness(checks...)
+}
+
+func newLogger(cfg *config.Config) *slog.Logger {
+	return logging.NewLogger(cfg.Log.Level)
+}
+
+func newHTTPServer(r *chi.Mux, cfg *config.Config) *http.Server {
+	return &http.Server{
+		Addr:              ":" + strconv.Itoa(cfg.HTTP.Port),
+		Handler:           r,
+		ReadHeaderTimeout: readHeaderTimeout,
+		ReadTimeout:       readTimeout,
+		WriteTimeout:      writeTimeout,
+		IdleTimeout:       idleTimeout,
+	}
+}
+
+// startHTTPServer binds the listener while the app starts, so a port that is
+// already taken fails fx.App.Start, and asks fx to shut down with a non-zero
+// exit code if the server stops serving on its own.
+func startHTTPServer(lc fx.Lifecycle, shutdowner fx.Shutdowner, server *http.Server, readiness *health.Readiness, logger *slog.Logger) {
 	lc.Append(fx.Hook{
 		OnStart: func(ctx context.Context) error {
+			listener, err := net.Listen("tcp", server.Addr)
+			if err != nil {
+				return err
+			}
+
 			go func() {
-				log.Printf("Starting HTTP server on :%s", port)
-				if err := http.ListenAndServe(":"+port, r); err != nil {
-					log.Fatalf("Failed to start HTTP server: %v", err)
+				logger.Info("Starting HTTP server", "addr", listener.Addr().String())
+				if err := server.Serve(listener); err != nil && !errors.Is(err, http.ErrServerClosed) {
+					logger.Error("HTTP server stopped", "error", err)
+					shutdowner.Shutdown(fx.ExitCode(1))
 				}
 			}()
 			return nil
 		},
 		OnStop: func(ctx context.Context) error {
-			log.Println("Shutting down HTTP server gracefully")
-			return nil
+			logger.Info("Shutting down HTTP server gracefully")
+			readiness.SetDraining(true)
+			select {
+			case <-time.After(drainDelay):
+			case <-ctx.Done():
+			}
+
+			ctx, cancel := context.WithTimeout(ctx, shutdownTimeout)
+			defer cancel()
+			return server.Shutdown(ctx)
 		},
 	})
 }
-
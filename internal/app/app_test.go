@@ -0,0 +1,106 @@
+package app
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/mathefer/tc-fiap-product/internal/config"
+	"github.com/mathefer/tc-fiap-product/pkg/health"
+	"github.com/mathefer/tc-fiap-product/pkg/logging"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/fx"
+	"go.uber.org/fx/fxtest"
+)
+
+type fakeShutdowner struct {
+	called chan struct{}
+}
+
+func (s *fakeShutdowner) Shutdown(...fx.ShutdownOption) error {
+	close(s.called)
+	return nil
+}
+
+func freeAddr(t *testing.T) string {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	addr := listener.Addr().String()
+	require.NoError(t, listener.Close())
+	return addr
+}
+
+func TestNewHTTPServer_UsesPortAndTimeouts(t *testing.T) {
+	// Arrange
+	cfg := config.Default()
+	cfg.HTTP.Port = 9090
+
+	// Act
+	server := newHTTPServer(chi.NewRouter(), cfg)
+
+	// Assert
+	assert.Equal(t, ":9090", server.Addr)
+	assert.Equal(t, readHeaderTimeout, server.ReadHeaderTimeout)
+	assert.Equal(t, readTimeout, server.ReadTimeout)
+	assert.Equal(t, writeTimeout, server.WriteTimeout)
+	assert.Equal(t, idleTimeout, server.IdleTimeout)
+}
+
+func TestStartHTTPServer_ListenErrorFailsStart(t *testing.T) {
+	// Arrange
+	taken, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer taken.Close()
+
+	lc := fxtest.NewLifecycle(t)
+	server := &http.Server{Addr: taken.Addr().String(), Handler: chi.NewRouter()}
+	startHTTPServer(lc, &fakeShutdowner{called: make(chan struct{})}, server, health.NewReadiness(), logging.NewNop())
+
+	// Act
+	err = lc.Start(context.Background())
+
+	// Assert
+	assert.Error(t, err)
+}
+
+func TestStartHTTPServer_StopDrainsInFlightRequests(t *testing.T) {
+	// Arrange
+	started := make(chan struct{})
+	router := chi.NewRouter()
+	router.Get("/slow", func(w http.ResponseWriter, r *http.Request) {
+		close(started)
+		time.Sleep(200 * time.Millisecond)
+		w.WriteHeader(http.StatusOK)
+	})
+
+	drainDelay = 0
+	readiness := health.NewReadiness()
+	addr := freeAddr(t)
+	lc := fxtest.NewLifecycle(t)
+	startHTTPServer(lc, &fakeShutdowner{called: make(chan struct{})}, &http.Server{Addr: addr, Handler: router}, readiness, logging.NewNop())
+	require.NoError(t, lc.Start(context.Background()))
+
+	status := make(chan int, 1)
+	go func() {
+		resp, err := http.Get("http://" + addr + "/slow")
+		if err != nil {
+			status <- 0
+			return
+		}
+		resp.Body.Close()
+		status <- resp.StatusCode
+	}()
+	<-started
+
+	// Act
+	err := lc.Stop(context.Background())
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, <-status)
+	assert.Equal(t, health.StatusFail, readiness.Run(context.Background()).Status)
+}
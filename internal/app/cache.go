@@ -0,0 +1,83 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"go.uber.org/fx"
+
+	"github.com/mathefer/tc-fiap-product/internal/config"
+	productRepositories "github.com/mathefer/tc-fiap-product/internal/product/domain/repositories"
+	productPersistence "github.com/mathefer/tc-fiap-product/internal/product/infrastructure/persistence"
+
+	"github.com/mathefer/tc-fiap-product/pkg/cache"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// redisTimeout bounds each attempt of a cache command. A command is sent at
+// most twice, so an unreachable Redis slows product reads down by about
+// twice this much before they go to the database.
+const redisTimeout = 100 * time.Millisecond
+
+// productCache returns the cache selected by cfg.Cache.Backend, which wraps
+// the product repository of the storage module, or nothing for
+// config.CacheNone.
+func productCache(cfg *config.Config) fx.Option {
+	switch cfg.Cache.Backend {
+	case config.CacheNone:
+		return fx.Options()
+	case config.CacheMemory:
+		return cachedProducts(fx.Provide(func(cfg *config.Config) cache.Cache {
+			return cache.NewLRU(cfg.Cache.MaxEntries)
+		}))
+	case config.CacheRedis:
+		return cachedProducts(fx.Provide(newRedisCache))
+	default:
+		return fx.Error(fmt.Errorf("unknown cache backend %q, expected %s, %s or %s",
+			cfg.Cache.Backend, config.CacheNone, config.CacheMemory, config.CacheRedis))
+	}
+}
+
+// cachedProducts decorates the product repository with the cache.Cache
+// provided by backend. It is not an fx.Module so that the decorated
+// repository is the one every component receives.
+func cachedProducts(backend fx.Option) fx.Option {
+	return fx.Options(
+		backend,
+		fx.Decorate(func(
+			repository productRepositories.ProductRepository,
+			c cache.Cache,
+			cfg *config.Config,
+			registerer prometheus.Registerer,
+			logger *slog.Logger) productRepositories.ProductRepository {
+			return productPersistence.NewCachedProductRepository(repository, c, cfg.Cache.TTL, registerer, logger)
+		}),
+	)
+}
+
+// newRedisCache connects to the Redis server shared by every replica and
+// closes its connections on stop.
+func newRedisCache(lc fx.Lifecycle, cfg *config.Config, logger *slog.Logger) cache.Cache {
+	redis := cache.NewRedis(cache.RedisOptions{
+		Addr:     cfg.Cache.RedisAddr,
+		Password: cfg.Cache.RedisPassword,
+		DB:       cfg.Cache.RedisDB,
+		Timeout:  redisTimeout,
+	})
+	lc.Append(fx.Hook{
+		OnStart: func(ctx context.Context) error {
+			// The cache is optional: reads fall back to the database while
+			// Redis is away, so this only warns.
+			if err := redis.Ping(ctx); err != nil {
+				logger.Warn("Redis cache is unavailable", "addr", cfg.Cache.RedisAddr, "error", err)
+			}
+			return nil
+		},
+		OnStop: func(context.Context) error {
+			return redis.Close()
+		},
+	})
+	return redis
+}
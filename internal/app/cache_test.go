@@ -0,0 +1,72 @@
+package app
+
+import (
+	"net"
+	"net/http"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/mathefer/tc-fiap-product/internal/config"
+	"github.com/mathefer/tc-fiap-product/pkg/logging"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/fx"
+	"go.uber.org/fx/fxtest"
+)
+
+func TestProductCache_UnknownBackend(t *testing.T) {
+	// Arrange
+	cfg := config.Default()
+	cfg.Cache.Backend = "memcached"
+
+	// Act
+	app := fx.New(productCache(cfg), fx.NopLogger)
+
+	// Assert
+	assert.ErrorContains(t, app.Err(), `unknown cache backend "memcached"`)
+}
+
+func TestOptions_MemoryCache(t *testing.T) {
+	// Arrange
+	cfg := config.Default()
+	cfg.Storage.Backend = config.StorageMemory
+	cfg.Cache.Backend = config.CacheMemory
+	var router *chi.Mux
+	fxtest.New(t, Options(cfg), fx.Replace(logging.NewNop()), fx.Populate(&router))
+	serve(router, http.MethodPost, "/v1/product", `{"name":"Hamburguer","category":1,"price":"34.99"}`)
+
+	// Act - the menu is read twice, then again after a product changes
+	serve(router, http.MethodGet, "/v1/product?category=1", "")
+	serve(router, http.MethodGet, "/v1/product?category=1", "")
+	serve(router, http.MethodPut, "/v1/product/1", `{"name":"X-Burguer","category":1,"price":"39.99"}`)
+	menu := serve(router, http.MethodGet, "/v1/product?category=1", "")
+	scrape := serve(router, http.MethodGet, "/metrics", "")
+
+	// Assert - the second read was a hit and the update made the third miss
+	assert.Equal(t, http.StatusOK, menu.Code)
+	assert.Contains(t, menu.Body.String(), `"name":"X-Burguer"`)
+	assert.Contains(t, scrape.Body.String(), `product_cache_requests_total{query="get",result="hit"} 1`)
+	assert.Contains(t, scrape.Body.String(), `product_cache_requests_total{query="get",result="miss"} 2`)
+}
+
+func TestOptions_RedisCacheUnavailable(t *testing.T) {
+	// Arrange - an address nothing listens on
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	cfg := config.Default()
+	cfg.Storage.Backend = config.StorageMemory
+	cfg.Cache.Backend = config.CacheRedis
+	cfg.Cache.RedisAddr = listener.Addr().String()
+	require.NoError(t, listener.Close())
+	var router *chi.Mux
+	fxtest.New(t, Options(cfg), fx.Replace(logging.NewNop()), fx.Populate(&router))
+
+	// Act
+	created := serve(router, http.MethodPost, "/v1/product", `{"name":"Hamburguer","category":1,"price":"34.99"}`)
+	found := serve(router, http.MethodGet, "/v1/product/1", "")
+
+	// Assert - reads go to the storage while the cache is away
+	assert.Equal(t, http.StatusCreated, created.Code)
+	assert.Equal(t, http.StatusOK, found.Code)
+	assert.Contains(t, found.Body.String(), `"name":"Hamburguer"`)
+}
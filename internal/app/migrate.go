@@ -0,0 +1,42 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/mathefer/tc-fiap-product/internal/config"
+	"github.com/mathefer/tc-fiap-product/pkg/logging"
+	"github.com/mathefer/tc-fiap-product/pkg/storage/postgres"
+)
+
+// RunMigrate implements the "migrate up|down|status" subcommand against the
+// configured database. It does not start the application, so it can run as
+// a Kubernetes init container or job.
+func RunMigrate(ctx context.Context, args []string, out io.Writer) error {
+	cfg, err := config.Load()
+	if err != nil {
+		return err
+	}
+	settings, err := cfg.Database.Settings()
+	if err != nil {
+		return fmt.Errorf("failed to load database config: %w", err)
+	}
+	logger := logging.NewLogger(cfg.Log.Level)
+
+	db, err := postgres.Connect(ctx, settings.DSN, settings.Connect, logger)
+	if err != nil {
+		return err
+	}
+	sqlDB, err := db.DB()
+	if err != nil {
+		return err
+	}
+	defer sqlDB.Close()
+
+	migrator, err := postgres.NewMigrator(sqlDB, logger)
+	if err != nil {
+		return err
+	}
+	return postgres.RunMigrateCommand(ctx, migrator, args, out)
+}
@@ -0,0 +1,150 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/fx"
+	"gorm.io/gorm"
+
+	"github.com/mathefer/tc-fiap-product/internal/config"
+	productRepositories "github.com/mathefer/tc-fiap-product/internal/product/domain/repositories"
+	productPersistence "github.com/mathefer/tc-fiap-product/internal/product/infrastructure/persistence"
+
+	"github.com/mathefer/tc-fiap-product/pkg/health"
+	"github.com/mathefer/tc-fiap-product/pkg/logging"
+	"github.com/mathefer/tc-fiap-product/pkg/metrics"
+	"github.com/mathefer/tc-fiap-product/pkg/storage/postgres"
+	"github.com/mathefer/tc-fiap-product/pkg/storage/sqlite"
+	"github.com/mathefer/tc-fiap-product/pkg/tracing"
+)
+
+// readinessChecks tags the health checks a storage module contributes to
+// /readyz.
+const readinessChecks = `group:"readiness,flatten"`
+
+// storage returns the module of the backend selected by cfg.Storage.Backend.
+func storage(cfg *config.Config) fx.Option {
+	switch cfg.Storage.Backend {
+	case config.StoragePostgres:
+		return PostgresStorage()
+	case config.StorageSQLite:
+		return SQLiteStorage()
+	case config.StorageMemory:
+		return MemoryStorage()
+	default:
+		return fx.Error(fmt.Errorf("unknown storage backend %q, expected %s, %s or %s",
+			cfg.Storage.Backend, config.StoragePostgres, config.StorageSQLite, config.StorageMemory))
+	}
+}
+
+// PostgresStorage keeps the catalog in the PostgreSQL database described by
+// the database section of the configuration.
+func PostgresStorage() fx.Option {
+	return fx.Module("storage.postgres",
+		fx.Provide(
+			newPostgresDB,
+			fx.Annotate(func(db *gorm.DB) []health.Check {
+				return []health.Check{databaseCheck(db), {Name: "migrations", Run: func(ctx context.Context) error {
+					return postgres.CheckMigrations(ctx, db)
+				}}}
+			}, fx.ResultTags(readinessChecks)),
+		),
+		gormStorage(),
+	)
+}
+
+// SQLiteStorage keeps the catalog in the SQLite file at
+// cfg.Storage.SQLitePath, or in memory for sqlite.MemoryPath. The schema is
+// created on start.
+func SQLiteStorage() fx.Option {
+	return fx.Module("storage.sqlite",
+		fx.Provide(
+			newSQLiteDB,
+			fx.Annotate(func(db *gorm.DB) []health.Check {
+				return []health.Check{databaseCheck(db)}
+			}, fx.ResultTags(readinessChecks)),
+		),
+		gormStorage(),
+	)
+}
+
+// MemoryStorage keeps the catalog in process memory, seeded with the default
+// categories, so the service runs with no database at all.
+func MemoryStorage() fx.Option {
+	return fx.Module("storage.memory",
+		fx.Provide(
+			productPersistence.NewInMemoryStore,
+			fx.Annotate(productPersistence.NewInMemoryProductRepository, fx.As(new(productRepositories.ProductRepository))),
+			fx.Annotate(productPersistence.NewInMemoryCategoryRepository, fx.As(new(productRepositories.CategoryRepository))),
+			fx.Annotate(productPersistence.NewInMemoryIdempotencyKeyRepository, fx.As(new(productRepositories.IdempotencyKeyRepository))),
+		),
+	)
+}
+
+// gormStorage provides the GORM repositories for a *gorm.DB provided
+// alongside it, instruments the database and closes it on stop.
+func gormStorage() fx.Option {
+	return fx.Options(
+		fx.Provide(
+			fx.Annotate(productPersistence.NewProductRepositoryImpl, fx.As(new(productRepositories.ProductRepository))),
+			fx.Annotate(productPersistence.NewCategoryRepositoryImpl, fx.As(new(productRepositories.CategoryRepository))),
+			fx.Annotate(productPersistence.NewIdempotencyKeyRepositoryImpl, fx.As(new(productRepositories.IdempotencyKeyRepository))),
+		),
+		fx.Invoke(func(db *gorm.DB, registerer prometheus.Registerer, tracerProvider trace.TracerProvider) error {
+			if err := db.Use(metrics.NewGormMetrics(registerer)); err != nil {
+				return err
+			}
+			return db.Use(tracing.NewGormTracing(tracerProvider))
+		}),
+		// Hooks stop in reverse order, and module invokes run before the
+		// application's, so the database is closed only after the HTTP
+		// server has drained.
+		fx.Invoke(closeDatabase),
+	)
+}
+
+func newPostgresDB(cfg *config.Config, logger *slog.Logger) (*gorm.DB, error) {
+	settings, err := cfg.Database.Settings()
+	if err != nil {
+		return nil, fmt.Errorf("failed to load database config: %w", err)
+	}
+	return postgres.NewPostgresDB(settings, logger)
+}
+
+func newSQLiteDB(cfg *config.Config, logger *slog.Logger) (*gorm.DB, error) {
+	path := cfg.Storage.SQLitePath
+	db, err := sqlite.NewDB(path)
+	if err != nil {
+		return nil, err
+	}
+	db.Logger = logging.NewGormLogger(logger)
+
+	if err := productPersistence.AutoMigrate(context.Background(), db); err != nil {
+		return nil, err
+	}
+	logger.Info("Opened sqlite database", "path", path)
+	return db, nil
+}
+
+func databaseCheck(db *gorm.DB) health.Check {
+	return health.Check{Name: "database", Run: func(ctx context.Context) error {
+		return postgres.Ping(ctx, db)
+	}}
+}
+
+func closeDatabase(lc fx.Lifecycle, db *gorm.DB, logger *slog.Logger) {
+	lc.Append(fx.Hook{
+		OnStop: func(ctx context.Context) error {
+			sqlDB, err := db.DB()
+			if err != nil {
+				return err
+			}
+			logger.Info("Closing database connections")
+			return sqlDB.Close()
+		},
+	})
+}
@@ -0,0 +1,185 @@
+package app
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/mathefer/tc-fiap-product/internal/config"
+	"github.com/mathefer/tc-fiap-product/internal/product/infrastructure/api/dto"
+	"github.com/mathefer/tc-fiap-product/pkg/logging"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/fx"
+	"go.uber.org/fx/fxtest"
+	"gorm.io/gorm"
+)
+
+func serve(router *chi.Mux, method, target, body string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(method, target, strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	return w
+}
+
+func TestStorage_UnknownBackend(t *testing.T) {
+	// Arrange
+	cfg := config.Default()
+	cfg.Storage.Backend = "mongodb"
+
+	// Act
+	app := fx.New(storage(cfg), fx.NopLogger)
+
+	// Assert
+	assert.ErrorContains(t, app.Err(), `unknown storage backend "mongodb"`)
+}
+
+func TestOptions_MemoryStorage(t *testing.T) {
+	// Arrange
+	cfg := config.Default()
+	cfg.Storage.Backend = config.StorageMemory
+	var router *chi.Mux
+	fxtest.New(t, Options(cfg), fx.Replace(logging.NewNop()), fx.Populate(&router))
+
+	// Act
+	created := serve(router, http.MethodPost, "/v1/product", `{"name":"Hamburguer","category":1,"price":"34.99"}`)
+	found := serve(router, http.MethodGet, "/v1/product/1", "")
+	ready := serve(router, http.MethodGet, "/readyz", "")
+
+	// Assert
+	assert.Equal(t, http.StatusCreated, created.Code)
+	assert.Equal(t, http.StatusOK, found.Code)
+	assert.Contains(t, found.Body.String(), `"name":"Hamburguer"`)
+	assert.Equal(t, http.StatusOK, ready.Code)
+}
+
+func TestOptions_SQLiteStorage(t *testing.T) {
+	// Arrange
+	cfg := config.Default()
+	cfg.Storage.Backend = config.StorageSQLite
+	cfg.Storage.SQLitePath = filepath.Join(t.TempDir(), "catalog.db")
+	var router *chi.Mux
+	var db *gorm.DB
+	fxtest.New(t, Options(cfg), fx.Replace(logging.NewNop()), fx.Populate(&router, &db))
+
+	// Act
+	created := serve(router, http.MethodPost, "/v1/product", `{"name":"Hamburguer","category":1,"price":"34.99"}`)
+	ready := serve(router, http.MethodGet, "/readyz", "")
+
+	// Assert - the schema was created and the default categories seeded
+	assert.Equal(t, http.StatusCreated, created.Code)
+	assert.Equal(t, http.StatusOK, ready.Code)
+	assert.Contains(t, ready.Body.String(), `"database"`)
+	var count int64
+	assert.NoError(t, db.Table("product").Count(&count).Error)
+	assert.Equal(t, int64(1), count)
+}
+
+func TestOptions_SQLiteStorage_IfMatch(t *testing.T) {
+	// Arrange
+	cfg := config.Default()
+	cfg.Storage.Backend = config.StorageSQLite
+	cfg.Storage.SQLitePath = filepath.Join(t.TempDir(), "catalog.db")
+	var router *chi.Mux
+	fxtest.New(t, Options(cfg), fx.Replace(logging.NewNop()), fx.Populate(&router))
+	created := serve(router, http.MethodPost, "/v1/product", `{"name":"Hamburguer","category":1,"price":"34.99"}`)
+	put := func(ifMatch string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodPut, "/v1/product/1", strings.NewReader(`{"name":"X-Burguer","category":1,"price":"39.99"}`))
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("If-Match", ifMatch)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+		return w
+	}
+
+	// Act - two writers both based on the created version
+	first := put(created.Header().Get("ETag"))
+	second := put(created.Header().Get("ETag"))
+
+	// Assert
+	assert.Equal(t, `W/"1"`, created.Header().Get("ETag"))
+	assert.Equal(t, http.StatusOK, first.Code)
+	assert.Equal(t, `W/"2"`, first.Header().Get("ETag"))
+	assert.Equal(t, http.StatusPreconditionFailed, second.Code)
+}
+
+func TestOptions_SQLiteStorage_ConditionalGet(t *testing.T) {
+	// Arrange
+	cfg := config.Default()
+	cfg.Storage.Backend = config.StorageSQLite
+	cfg.Storage.SQLitePath = filepath.Join(t.TempDir(), "catalog.db")
+	var router *chi.Mux
+	fxtest.New(t, Options(cfg), fx.Replace(logging.NewNop()), fx.Populate(&router))
+	serve(router, http.MethodPost, "/v1/product", `{"name":"Hamburguer","category":1,"price":"34.99"}`)
+	serve(router, http.MethodPost, "/v1/product", `{"name":"X-Burguer","category":1,"price":"39.99"}`)
+	get := func(ifNoneMatch string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodGet, "/v1/product?category=1", nil)
+		req.Header.Set("If-None-Match", ifNoneMatch)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+		return w
+	}
+
+	// Act - the menu is read, read again unchanged, and read after a
+	// product is removed from it
+	menu := get("")
+	unchanged := get(menu.Header().Get("ETag"))
+	serve(router, http.MethodDelete, "/v1/product/2", "")
+	changed := get(menu.Header().Get("ETag"))
+
+	// Assert
+	assert.Equal(t, http.StatusOK, menu.Code)
+	assert.Equal(t, "no-cache", menu.Header().Get("Cache-Control"))
+	assert.NotEmpty(t, menu.Header().Get("Last-Modified"))
+	assert.Equal(t, http.StatusNotModified, unchanged.Code)
+	assert.Empty(t, unchanged.Body.String())
+	assert.Equal(t, http.StatusOK, changed.Code)
+	assert.NotEqual(t, menu.Header().Get("ETag"), changed.Header().Get("ETag"))
+	assert.NotContains(t, changed.Body.String(), "X-Burguer")
+}
+
+func TestOptions_SQLiteStorage_ListPages(t *testing.T) {
+	// Arrange
+	cfg := config.Default()
+	cfg.Storage.Backend = config.StorageSQLite
+	cfg.Storage.SQLitePath = filepath.Join(t.TempDir(), "catalog.db")
+	var router *chi.Mux
+	fxtest.New(t, Options(cfg), fx.Replace(logging.NewNop()), fx.Populate(&router))
+	for _, name := range []string{"Hamburguer", "X-Burguer", "Cheeseburguer"} {
+		serve(router, http.MethodPost, "/v1/product", `{"name":"`+name+`","category":1,"price":"34.99"}`)
+	}
+	page := func(target string) dto.ListProductsResponseDto {
+		var response dto.ListProductsResponseDto
+		assert.NoError(t, json.NewDecoder(serve(router, http.MethodGet, target, "").Body).Decode(&response))
+		return response
+	}
+
+	// Act - a product of the first page is deleted before the second one
+	// is read, which would make an offset skip a product
+	first := page("/v1/product?sort=name&limit=2")
+	serve(router, http.MethodDelete, "/v1/product/3", "")
+	second := page("/v1/product?sort=name&limit=2&cursor=" + first.NextCursor)
+	var byCreation []string
+	for cursor, pages := "", 0; pages < 3; pages++ {
+		next := page("/v1/product?sort=created_at&order=desc&limit=1&cursor=" + cursor)
+		for _, item := range next.Items {
+			byCreation = append(byCreation, item.Name)
+		}
+		if cursor = next.NextCursor; cursor == "" {
+			break
+		}
+	}
+
+	// Assert
+	assert.Equal(t, int64(3), first.Total)
+	assert.Equal(t, "Cheeseburguer", first.Items[0].Name)
+	assert.Equal(t, "Hamburguer", first.Items[1].Name)
+	assert.Len(t, second.Items, 1)
+	assert.Equal(t, "X-Burguer", second.Items[0].Name)
+	assert.Empty(t, second.NextCursor)
+	assert.ElementsMatch(t, []string{"Hamburguer", "X-Burguer"}, byCreation, "each remaining product on exactly one page")
+}
@@ -0,0 +1,286 @@
+// Package config loads the service configuration into a typed struct from
+// defaults, an optional YAML file and environment variables, and validates
+// it once at startup.
+package config
+
+import (
+	"fmt"
+	"log/slog"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/mathefer/tc-fiap-product/pkg/storage/postgres"
+	"github.com/mathefer/tc-fiap-product/pkg/tracing"
+)
+
+// Storage backends accepted by STORAGE_BACKEND.
+const (
+	StoragePostgres = "postgres"
+	StorageSQLite   = "sqlite"
+	StorageMemory   = "memory"
+)
+
+// Cache backends accepted by CACHE_BACKEND.
+const (
+	CacheNone   = "none"
+	CacheMemory = "memory"
+	CacheRedis  = "redis"
+)
+
+// Config is the whole service configuration. The env tag names the
+// environment variable that sets a field; NAME_FILE reads it from a file.
+type Config struct {
+	HTTP     HTTP     `yaml:"http"`
+	Storage  Storage  `yaml:"storage"`
+	Cache    Cache    `yaml:"cache"`
+	Database Database `yaml:"database"`
+	Log      Log      `yaml:"log"`
+	Tracing  Tracing  `yaml:"tracing"`
+}
+
+type HTTP struct {
+	Port int `yaml:"port" env:"PORT"`
+	// IdempotencyKeyTTL is how long the response to a request sent with an
+	// Idempotency-Key header is replayed to repeats.
+	IdempotencyKeyTTL time.Duration `yaml:"idempotency_key_ttl" env:"IDEMPOTENCY_KEY_TTL"`
+	// RequireIfMatch rejects product writes that do not send the ETag of
+	// the version they replace in an If-Match header.
+	RequireIfMatch bool `yaml:"require_if_match" env:"REQUIRE_IF_MATCH"`
+	// CacheControl is sent with product reads. The default, no-cache, lets
+	// caches keep the menu but makes them revalidate it on every use.
+	CacheControl string `yaml:"cache_control" env:"CACHE_CONTROL"`
+}
+
+type Storage struct {
+	// Backend is "postgres", "sqlite" or "memory".
+	Backend    string `yaml:"backend" env:"STORAGE_BACKEND"`
+	SQLitePath string `yaml:"sqlite_path" env:"SQLITE_PATH"`
+}
+
+// Cache configures the cache of product reads. The memory backend keeps a
+// cache in each replica, which may serve entries changed through another
+// replica until they expire; redis shares one cache between them.
+type Cache struct {
+	// Backend is "none", "memory" or "redis".
+	Backend       string        `yaml:"backend" env:"CACHE_BACKEND"`
+	TTL           time.Duration `yaml:"ttl" env:"CACHE_TTL"`
+	MaxEntries    int           `yaml:"max_entries" env:"CACHE_MAX_ENTRIES"`
+	RedisAddr     string        `yaml:"redis_addr" env:"REDIS_ADDR"`
+	RedisPassword string        `yaml:"redis_password" env:"REDIS_PASSWORD"`
+	RedisDB       int           `yaml:"redis_db" env:"REDIS_DB"`
+}
+
+// Database configures the postgres backend. URL, when set, replaces the
+// individual connection fields.
+type Database struct {
+	URL      string `yaml:"url" env:"DATABASE_URL"`
+	Host     string `yaml:"host" env:"DB_HOST"`
+	Port     string `yaml:"port" env:"DB_PORT"`
+	User     string `yaml:"user" env:"DB_USER"`
+	Password string `yaml:"password" env:"DB_PASSWORD"`
+	Name     string `yaml:"name" env:"DB_NAME"`
+	SSLMode  string `yaml:"sslmode" env:"DB_SSLMODE"`
+
+	MaxOpenConns    int           `yaml:"max_open_conns" env:"DB_MAX_OPEN_CONNS"`
+	MaxIdleConns    int           `yaml:"max_idle_conns" env:"DB_MAX_IDLE_CONNS"`
+	ConnMaxLifetime time.Duration `yaml:"conn_max_lifetime" env:"DB_CONN_MAX_LIFETIME"`
+	ConnMaxIdleTime time.Duration `yaml:"conn_max_idle_time" env:"DB_CONN_MAX_IDLE_TIME"`
+	ConnectTimeout  time.Duration `yaml:"connect_timeout" env:"DB_CONNECT_TIMEOUT"`
+	QueryTimeout    time.Duration `yaml:"query_timeout" env:"DB_QUERY_TIMEOUT"`
+	MigrateOnStart  bool          `yaml:"migrate_on_start" env:"DB_MIGRATE_ON_START"`
+}
+
+type Log struct {
+	Level slog.Level `yaml:"level" env:"LOG_LEVEL"`
+}
+
+type Tracing struct {
+	// Exporter is "none", "stdout" or "otlp".
+	Exporter     string `yaml:"exporter" env:"OTEL_TRACES_EXPORTER"`
+	OTLPEndpoint string `yaml:"otlp_endpoint" env:"OTEL_EXPORTER_OTLP_ENDPOINT"`
+	ServiceName  string `yaml:"service_name" env:"OTEL_SERVICE_NAME"`
+}
+
+// Default returns the configuration used for everything that is not set.
+func Default() *Config {
+	return &Config{
+		HTTP: HTTP{Port: 8081, IdempotencyKeyTTL: 24 * time.Hour, CacheControl: "no-cache"},
+		Storage: Storage{
+			Backend:    StoragePostgres,
+			SQLitePath: "tc-fiap-product.db",
+		},
+		Cache: Cache{
+			Backend:    CacheNone,
+			TTL:        30 * time.Second,
+			MaxEntries: 1000,
+			RedisAddr:  "localhost:6379",
+		},
+		Database: Database{
+			Port:            "5432",
+			Name:            "product_db",
+			SSLMode:         "disable",
+			MaxOpenConns:    10,
+			MaxIdleConns:    5,
+			ConnMaxLifetime: 30 * time.Minute,
+			ConnMaxIdleTime: 5 * time.Minute,
+			ConnectTimeout:  time.Minute,
+			QueryTimeout:    5 * time.Second,
+		},
+		Log: Log{Level: slog.LevelInfo},
+		Tracing: Tracing{
+			Exporter:     "none",
+			OTLPEndpoint: "http://localhost:4318",
+			ServiceName:  "tc-fiap-product",
+		},
+	}
+}
+
+// ValidationError lists every problem found in the configuration, so that
+// they can all be fixed at once.
+type ValidationError struct {
+	Problems []string
+}
+
+func (e *ValidationError) Error() string {
+	return "invalid configuration:\n  - " + strings.Join(e.Problems, "\n  - ")
+}
+
+// Validate checks the configuration and returns a *ValidationError listing
+// every violation, or nil.
+func (c *Config) Validate() error {
+	var problems []string
+	problem := func(format string, args ...any) {
+		problems = append(problems, fmt.Sprintf(format, args...))
+	}
+
+	if c.HTTP.Port < 1 || c.HTTP.Port > 65535 {
+		problem("PORT must be between 1 and 65535, got %d", c.HTTP.Port)
+	}
+	if c.HTTP.IdempotencyKeyTTL <= 0 {
+		problem("IDEMPOTENCY_KEY_TTL must be positive")
+	}
+
+	switch c.Storage.Backend {
+	case StoragePostgres:
+		c.Database.validate(problem)
+	case StorageSQLite:
+		if c.Storage.SQLitePath == "" {
+			problem("SQLITE_PATH is required with the sqlite backend")
+		}
+	case StorageMemory:
+	default:
+		problem("STORAGE_BACKEND must be %s, %s or %s, got %q", StoragePostgres, StorageSQLite, StorageMemory, c.Storage.Backend)
+	}
+
+	switch c.Cache.Backend {
+	case CacheNone:
+	case CacheMemory:
+		if c.Cache.MaxEntries < 1 {
+			problem("CACHE_MAX_ENTRIES must be positive")
+		}
+	case CacheRedis:
+		if c.Cache.RedisAddr == "" {
+			problem("REDIS_ADDR is required with the redis cache")
+		}
+	default:
+		problem("CACHE_BACKEND must be %s, %s or %s, got %q", CacheNone, CacheMemory, CacheRedis, c.Cache.Backend)
+	}
+	if c.Cache.Backend != CacheNone && c.Cache.TTL <= 0 {
+		problem("CACHE_TTL must be positive")
+	}
+
+	switch c.Tracing.Exporter {
+	case "none", "stdout", "console":
+	case "otlp":
+		if endpoint, err := url.Parse(c.Tracing.OTLPEndpoint); err != nil || endpoint.Scheme == "" || endpoint.Host == "" {
+			problem("OTEL_EXPORTER_OTLP_ENDPOINT must be an absolute URL, got %q", c.Tracing.OTLPEndpoint)
+		}
+	default:
+		problem("OTEL_TRACES_EXPORTER must be none, stdout or otlp, got %q", c.Tracing.Exporter)
+	}
+
+	if len(problems) > 0 {
+		return &ValidationError{Problems: problems}
+	}
+	return nil
+}
+
+func (d *Database) validate(problem func(format string, args ...any)) {
+	if d.URL == "" {
+		for _, field := range []struct{ name, value string }{
+			{"DB_HOST", d.Host}, {"DB_PORT", d.Port}, {"DB_USER", d.User},
+			{"DB_PASSWORD", d.Password}, {"DB_NAME", d.Name}, {"DB_SSLMODE", d.SSLMode},
+		} {
+			if field.value == "" {
+				problem("%s is required unless DATABASE_URL is set", field.name)
+			}
+		}
+	}
+
+	for _, field := range []struct {
+		name  string
+		value int
+	}{{"DB_MAX_OPEN_CONNS", d.MaxOpenConns}, {"DB_MAX_IDLE_CONNS", d.MaxIdleConns}} {
+		if field.value < 0 {
+			problem("%s must not be negative", field.name)
+		}
+	}
+	for _, field := range []struct {
+		name  string
+		value time.Duration
+	}{
+		{"DB_CONN_MAX_LIFETIME", d.ConnMaxLifetime}, {"DB_CONN_MAX_IDLE_TIME", d.ConnMaxIdleTime},
+		{"DB_CONNECT_TIMEOUT", d.ConnectTimeout}, {"DB_QUERY_TIMEOUT", d.QueryTimeout},
+	} {
+		if field.value < 0 {
+			problem("%s must not be negative", field.name)
+		}
+	}
+}
+
+// DSN returns DATABASE_URL, or a DSN built from the individual fields.
+func (d Database) DSN() (string, error) {
+	if d.URL != "" {
+		return d.URL, nil
+	}
+	return postgres.BuildDSN(postgres.DBConfig{
+		Host:     d.Host,
+		Port:     d.Port,
+		User:     d.User,
+		Password: d.Password,
+		DBName:   d.Name,
+		SSLMode:  d.SSLMode,
+	})
+}
+
+// Settings returns what postgres.NewPostgresDB needs to connect.
+func (d Database) Settings() (postgres.Settings, error) {
+	dsn, err := d.DSN()
+	if err != nil {
+		return postgres.Settings{}, err
+	}
+	return postgres.Settings{
+		DSN: dsn,
+		Connect: postgres.ConnectConfig{
+			Pool: postgres.PoolConfig{
+				MaxOpenConns:    d.MaxOpenConns,
+				MaxIdleConns:    d.MaxIdleConns,
+				ConnMaxLifetime: d.ConnMaxLifetime,
+				ConnMaxIdleTime: d.ConnMaxIdleTime,
+			},
+			Timeout: d.ConnectTimeout,
+		},
+		QueryTimeout:   d.QueryTimeout,
+		MigrateOnStart: d.MigrateOnStart,
+	}, nil
+}
+
+// TracerConfig returns the settings of tracing.NewTracerProvider.
+func (t Tracing) TracerConfig() tracing.Config {
+	return tracing.Config{
+		Exporter:     t.Exporter,
+		OTLPEndpoint: t.OTLPEndpoint,
+		ServiceName:  t.ServiceName,
+	}
+}
@@ -0,0 +1,145 @@
+package config
+
+import (
+	"bytes"
+	"encoding"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+// fileEnvSuffix marks a variable holding the path of a file with the value,
+// as Kubernetes mounts secrets, e.g. DB_PASSWORD_FILE.
+const fileEnvSuffix = "_FILE"
+
+// Load builds the configuration from, in increasing precedence, the
+// defaults, the YAML file named by CONFIG_FILE and the environment. Every
+// problem found, from unreadable files to invalid values, is reported in a
+// single *ValidationError.
+func Load() (*Config, error) {
+	config := Default()
+
+	if path := os.Getenv("CONFIG_FILE"); path != "" {
+		if err := loadFile(config, path); err != nil {
+			return nil, err
+		}
+	}
+
+	var problems []string
+	loadEnv(reflect.ValueOf(config).Elem(), &problems)
+	config.Tracing.Exporter = strings.ToLower(config.Tracing.Exporter)
+
+	var invalid *ValidationError
+	if errors.As(config.Validate(), &invalid) {
+		problems = append(problems, invalid.Problems...)
+	}
+	if len(problems) > 0 {
+		return nil, &ValidationError{Problems: problems}
+	}
+	return config, nil
+}
+
+// loadFile overlays the YAML file at path on config. Unknown keys are
+// rejected so that typos do not go unnoticed.
+func loadFile(config *Config, path string) error {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("failed to read config file: %w", err)
+	}
+
+	decoder := yaml.NewDecoder(bytes.NewReader(content))
+	decoder.KnownFields(true)
+	if err := decoder.Decode(config); err != nil && !errors.Is(err, io.EOF) {
+		return fmt.Errorf("invalid config file %s: %w", path, err)
+	}
+	return nil
+}
+
+// loadEnv sets every field of v carrying an env tag from its environment
+// variable or the file named by NAME_FILE, recursing into nested structs.
+func loadEnv(v reflect.Value, problems *[]string) {
+	for i := 0; i < v.NumField(); i++ {
+		field := v.Field(i)
+		if field.Kind() == reflect.Struct && v.Type().Field(i).Tag.Get("env") == "" {
+			loadEnv(field, problems)
+			continue
+		}
+
+		name := v.Type().Field(i).Tag.Get("env")
+		if name == "" {
+			continue
+		}
+		value, ok, err := lookup(name)
+		if err != nil {
+			*problems = append(*problems, err.Error())
+			continue
+		}
+		if !ok {
+			continue
+		}
+		if err := set(field, value); err != nil {
+			*problems = append(*problems, fmt.Sprintf("%s: %v", name, err))
+		}
+	}
+}
+
+// lookup returns the value of the variable name, or the trimmed content of
+// the file named by name_FILE. Empty variables count as unset.
+func lookup(name string) (string, bool, error) {
+	value := os.Getenv(name)
+	path := os.Getenv(name + fileEnvSuffix)
+	switch {
+	case value != "" && path != "":
+		return "", false, fmt.Errorf("%s and %s%s are both set", name, name, fileEnvSuffix)
+	case path != "":
+		content, err := os.ReadFile(path)
+		if err != nil {
+			return "", false, fmt.Errorf("%s%s: %v", name, fileEnvSuffix, err)
+		}
+		return strings.TrimRight(string(content), "\r\n"), true, nil
+	default:
+		return value, value != "", nil
+	}
+}
+
+var durationType = reflect.TypeOf(time.Duration(0))
+
+// set parses value into field according to the field's type.
+func set(field reflect.Value, value string) error {
+	if unmarshaler, ok := field.Addr().Interface().(encoding.TextUnmarshaler); ok {
+		return unmarshaler.UnmarshalText([]byte(value))
+	}
+
+	switch {
+	case field.Type() == durationType:
+		d, err := time.ParseDuration(value)
+		if err != nil {
+			return fmt.Errorf("invalid duration %q", value)
+		}
+		field.SetInt(int64(d))
+	case field.Kind() == reflect.String:
+		field.SetString(value)
+	case field.Kind() == reflect.Int:
+		n, err := strconv.Atoi(value)
+		if err != nil {
+			return fmt.Errorf("invalid integer %q", value)
+		}
+		field.SetInt(int64(n))
+	case field.Kind() == reflect.Bool:
+		b, err := strconv.ParseBool(value)
+		if err != nil {
+			return fmt.Errorf("invalid boolean %q", value)
+		}
+		field.SetBool(b)
+	default:
+		return fmt.Errorf("unsupported type %s", field.Type())
+	}
+	return nil
+}
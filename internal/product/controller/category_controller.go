@@ -0,0 +1,15 @@
+package controller
+
+import (
+	"context"
+
+	"github.com/mathefer/tc-fiap-product/internal/product/infrastructure/api/dto"
+)
+
+type CategoryController interface {
+	List(ctx context.Context, activeOnly bool) ([]*dto.GetCategoryResponseDto, error)
+	GetByID(ctx context.Context, id uint) (*dto.GetCategoryResponseDto, error)
+	Add(ctx context.Context, category *dto.AddCategoryRequestDto) error
+	Update(ctx context.Context, id uint, category *dto.UpdateCategoryRequestDto) error
+	Delete(ctx context.Context, id uint) error
+}
@@ -0,0 +1,151 @@
+package controller
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/mathefer/tc-fiap-product/internal/product/infrastructure/api/dto"
+	productPresenter "github.com/mathefer/tc-fiap-product/internal/product/presenter"
+	addCategory "github.com/mathefer/tc-fiap-product/internal/product/usecase/addCategory"
+	"github.com/mathefer/tc-fiap-product/internal/product/usecase/commands"
+	deleteCategory "github.com/mathefer/tc-fiap-product/internal/product/usecase/deleteCategory"
+	getCategoryByID "github.com/mathefer/tc-fiap-product/internal/product/usecase/getCategoryByID"
+	listCategories "github.com/mathefer/tc-fiap-product/internal/product/usecase/listCategories"
+	updateCategory "github.com/mathefer/tc-fiap-product/internal/product/usecase/updateCategory"
+	"github.com/mathefer/tc-fiap-product/pkg/logging"
+	"github.com/mathefer/tc-fiap-product/pkg/tracing"
+)
+
+var (
+	_ CategoryController = (*CategoryControllerImpl)(nil)
+)
+
+type CategoryControllerImpl struct {
+	presenter              productPresenter.CategoryPresenter
+	listCategoriesUseCase  listCategories.ListCategoriesUseCase
+	getCategoryByIDUseCase getCategoryByID.GetCategoryByIDUseCase
+	addCategoryUseCase     addCategory.AddCategoryUseCase
+	updateCategoryUseCase  updateCategory.UpdateCategoryUseCase
+	deleteCategoryUseCase  deleteCategory.DeleteCategoryUseCase
+	logger                 *slog.Logger
+}
+
+func NewCategoryControllerImpl(
+	presenter productPresenter.CategoryPresenter,
+	listCategoriesUseCase listCategories.ListCategoriesUseCase,
+	getCategoryByIDUseCase getCategoryByID.GetCategoryByIDUseCase,
+	addCategoryUseCase addCategory.AddCategoryUseCase,
+	updateCategoryUseCase updateCategory.UpdateCategoryUseCase,
+	deleteCategoryUseCase deleteCategory.DeleteCategoryUseCase,
+	logger *slog.Logger) *CategoryControllerImpl {
+	return &CategoryControllerImpl{
+		presenter:              presenter,
+		listCategoriesUseCase:  listCategoriesUseCase,
+		getCategoryByIDUseCase: getCategoryByIDUseCase,
+		addCategoryUseCase:     addCategoryUseCase,
+		updateCategoryUseCase:  updateCategoryUseCase,
+		deleteCategoryUseCase:  deleteCategoryUseCase,
+		logger:                 logger,
+	}
+}
+
+func (c *CategoryControllerImpl) List(ctx context.Context, activeOnly bool) (_ []*dto.GetCategoryResponseDto, err error) {
+	ctx, span := tracing.Start(ctx, "CategoryController.List")
+	defer func() {
+		c.logFailure(ctx, "List", err)
+		tracing.RecordError(span, err)
+		span.End()
+	}()
+
+	categories, err := c.listCategoriesUseCase.Execute(ctx, commands.NewListCategoriesCommand(activeOnly))
+	if err != nil {
+		return nil, err
+	}
+
+	return c.presenter.Present(categories), nil
+}
+
+func (c *CategoryControllerImpl) GetByID(ctx context.Context, id uint) (_ *dto.GetCategoryResponseDto, err error) {
+	ctx = logging.ContextWithAttrs(ctx, slog.Uint64("category_id", uint64(id)))
+	ctx, span := tracing.Start(ctx, "CategoryController.GetByID")
+	defer func() {
+		c.logFailure(ctx, "GetByID", err)
+		tracing.RecordError(span, err)
+		span.End()
+	}()
+
+	category, err := c.getCategoryByIDUseCase.Execute(ctx, commands.NewGetCategoryByIDCommand(id))
+	if err != nil {
+		return nil, err
+	}
+
+	return c.presenter.PresentOne(category), nil
+}
+
+func (c *CategoryControllerImpl) Add(ctx context.Context, category *dto.AddCategoryRequestDto) (err error) {
+	ctx, span := tracing.Start(ctx, "CategoryController.Add")
+	defer func() {
+		c.logFailure(ctx, "Add", err)
+		tracing.RecordError(span, err)
+		span.End()
+	}()
+
+	active := true
+	if category.Active != nil {
+		active = *category.Active
+	}
+
+	command := commands.NewAddCategoryCommand(category.DisplayName, category.SortOrder, active)
+	err = c.addCategoryUseCase.Execute(ctx, command)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func (c *CategoryControllerImpl) Update(ctx context.Context, id uint, category *dto.UpdateCategoryRequestDto) (err error) {
+	ctx = logging.ContextWithAttrs(ctx, slog.Uint64("category_id", uint64(id)))
+	ctx, span := tracing.Start(ctx, "CategoryController.Update")
+	defer func() {
+		c.logFailure(ctx, "Update", err)
+		tracing.RecordError(span, err)
+		span.End()
+	}()
+
+	command := commands.NewUpdateCategoryCommand(id, category.DisplayName, category.SortOrder, category.Active)
+	err = c.updateCategoryUseCase.Execute(ctx, command)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func (c *CategoryControllerImpl) Delete(ctx context.Context, id uint) (err error) {
+	ctx = logging.ContextWithAttrs(ctx, slog.Uint64("category_id", uint64(id)))
+	ctx, span := tracing.Start(ctx, "CategoryController.Delete")
+	defer func() {
+		c.logFailure(ctx, "Delete", err)
+		tracing.RecordError(span, err)
+		span.End()
+	}()
+
+	command := commands.NewDeleteCategoryCommand(id)
+	err = c.deleteCategoryUseCase.Execute(ctx, command)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+// logFailure records why a request failed, at info for rejections caused by
+// the input and at error for anything else.
+func (c *CategoryControllerImpl) logFailure(ctx context.Context, operation string, err error) {
+	if err == nil {
+		return
+	}
+	level := slog.LevelError
+	if isDomainError(err) {
+		level = slog.LevelInfo
+	}
+	c.logger.Log(ctx, level, "Category request failed", "operation", operation, "error", err)
+}
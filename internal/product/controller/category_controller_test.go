@@ -0,0 +1,179 @@
+package controller_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"github.com/mathefer/tc-fiap-product/internal/product/controller"
+	"github.com/mathefer/tc-fiap-product/internal/product/domain/entities"
+	"github.com/mathefer/tc-fiap-product/internal/product/infrastructure/api/dto"
+	"github.com/mathefer/tc-fiap-product/internal/product/usecase/commands"
+	mockPresenter "github.com/mathefer/tc-fiap-product/mocks/product/presenter"
+	mockAddCategory "github.com/mathefer/tc-fiap-product/mocks/product/usecase/addCategory"
+	mockDeleteCategory "github.com/mathefer/tc-fiap-product/mocks/product/usecase/deleteCategory"
+	mockGetCategoryByID "github.com/mathefer/tc-fiap-product/mocks/product/usecase/getCategoryByID"
+	mockListCategories "github.com/mathefer/tc-fiap-product/mocks/product/usecase/listCategories"
+	mockUpdateCategory "github.com/mathefer/tc-fiap-product/mocks/product/usecase/updateCategory"
+	"github.com/mathefer/tc-fiap-product/pkg/logging"
+)
+
+type CategoryControllerTestSuite struct {
+	suite.Suite
+	mockPresenter              *mockPresenter.MockCategoryPresenter
+	mockListCategoriesUseCase  *mockListCategories.MockListCategoriesUseCase
+	mockGetCategoryByIDUseCase *mockGetCategoryByID.MockGetCategoryByIDUseCase
+	mockAddCategoryUseCase     *mockAddCategory.MockAddCategoryUseCase
+	mockUpdateCategoryUseCase  *mockUpdateCategory.MockUpdateCategoryUseCase
+	mockDeleteCategoryUseCase  *mockDeleteCategory.MockDeleteCategoryUseCase
+	categoryController         controller.CategoryController
+}
+
+func (suite *CategoryControllerTestSuite) SetupTest() {
+	suite.mockPresenter = mockPresenter.NewMockCategoryPresenter(suite.T())
+	suite.mockListCategoriesUseCase = mockListCategories.NewMockListCategoriesUseCase(suite.T())
+	suite.mockGetCategoryByIDUseCase = mockGetCategoryByID.NewMockGetCategoryByIDUseCase(suite.T())
+	suite.mockAddCategoryUseCase = mockAddCategory.NewMockAddCategoryUseCase(suite.T())
+	suite.mockUpdateCategoryUseCase = mockUpdateCategory.NewMockUpdateCategoryUseCase(suite.T())
+	suite.mockDeleteCategoryUseCase = mockDeleteCategory.NewMockDeleteCategoryUseCase(suite.T())
+
+	suite.categoryController = controller.NewCategoryControllerImpl(
+		suite.mockPresenter,
+		suite.mockListCategoriesUseCase,
+		suite.mockGetCategoryByIDUseCase,
+		suite.mockAddCategoryUseCase,
+		suite.mockUpdateCategoryUseCase,
+		suite.mockDeleteCategoryUseCase,
+		logging.NewNop(),
+	)
+}
+
+func TestCategoryControllerTestSuite(t *testing.T) {
+	suite.Run(t, new(CategoryControllerTestSuite))
+}
+
+func (suite *CategoryControllerTestSuite) TestList_Success() {
+	// Arrange
+	categories := entities.DefaultCategories()
+	expectedResponse := []*dto.GetCategoryResponseDto{{ID: 1, DisplayName: "Lanche"}}
+
+	suite.mockListCategoriesUseCase.EXPECT().
+		Execute(mock.Anything, commands.NewListCategoriesCommand(true)).
+		Return(categories, nil).
+		Once()
+
+	suite.mockPresenter.EXPECT().
+		Present(categories).
+		Return(expectedResponse).
+		Once()
+
+	// Act
+	result, err := suite.categoryController.List(context.Background(), true)
+
+	// Assert
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), expectedResponse, result)
+}
+
+func (suite *CategoryControllerTestSuite) TestGetByID_UseCaseError() {
+	// Arrange
+	expectedError := errors.New("category not found")
+
+	suite.mockGetCategoryByIDUseCase.EXPECT().
+		Execute(mock.Anything, commands.NewGetCategoryByIDCommand(99)).
+		Return(nil, expectedError).
+		Once()
+
+	// Act
+	result, err := suite.categoryController.GetByID(context.Background(), 99)
+
+	// Assert
+	assert.Nil(suite.T(), result)
+	assert.Equal(suite.T(), expectedError, err)
+}
+
+func (suite *CategoryControllerTestSuite) TestGetByID_Success() {
+	// Arrange
+	category := &entities.Category{ID: 2, DisplayName: "Acompanhamento", SortOrder: 2, Active: true}
+	expectedResponse := &dto.GetCategoryResponseDto{ID: 2, DisplayName: "Acompanhamento", SortOrder: 2, Active: true}
+
+	suite.mockGetCategoryByIDUseCase.EXPECT().
+		Execute(mock.Anything, commands.NewGetCategoryByIDCommand(2)).
+		Return(category, nil).
+		Once()
+
+	suite.mockPresenter.EXPECT().
+		PresentOne(category).
+		Return(expectedResponse).
+		Once()
+
+	// Act
+	result, err := suite.categoryController.GetByID(context.Background(), 2)
+
+	// Assert
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), expectedResponse, result)
+}
+
+func (suite *CategoryControllerTestSuite) TestAdd_DefaultsToActive() {
+	// Arrange
+	suite.mockAddCategoryUseCase.EXPECT().
+		Execute(mock.Anything, commands.NewAddCategoryCommand("Combo", 5, true)).
+		Return(nil).
+		Once()
+
+	// Act
+	err := suite.categoryController.Add(context.Background(), &dto.AddCategoryRequestDto{DisplayName: "Combo", SortOrder: 5})
+
+	// Assert
+	assert.NoError(suite.T(), err)
+}
+
+func (suite *CategoryControllerTestSuite) TestAdd_Inactive() {
+	// Arrange
+	active := false
+
+	suite.mockAddCategoryUseCase.EXPECT().
+		Execute(mock.Anything, commands.NewAddCategoryCommand("Combo", 5, false)).
+		Return(nil).
+		Once()
+
+	// Act
+	err := suite.categoryController.Add(context.Background(), &dto.AddCategoryRequestDto{DisplayName: "Combo", SortOrder: 5, Active: &active})
+
+	// Assert
+	assert.NoError(suite.T(), err)
+}
+
+func (suite *CategoryControllerTestSuite) TestUpdate_UseCaseError() {
+	// Arrange
+	expectedError := errors.New("category not found")
+
+	suite.mockUpdateCategoryUseCase.EXPECT().
+		Execute(mock.Anything, commands.NewUpdateCategoryCommand(5, "Combo", 6, true)).
+		Return(expectedError).
+		Once()
+
+	// Act
+	err := suite.categoryController.Update(context.Background(), 5, &dto.UpdateCategoryRequestDto{DisplayName: "Combo", SortOrder: 6, Active: true})
+
+	// Assert
+	assert.Equal(suite.T(), expectedError, err)
+}
+
+func (suite *CategoryControllerTestSuite) TestDelete_Success() {
+	// Arrange
+	suite.mockDeleteCategoryUseCase.EXPECT().
+		Execute(mock.Anything, commands.NewDeleteCategoryCommand(5)).
+		Return(nil).
+		Once()
+
+	// Act
+	err := suite.categoryController.Delete(context.Background(), 5)
+
+	// Assert
+	assert.NoError(suite.T(), err)
+}
@@ -1,12 +1,25 @@
 package controller
 
 import (
+	"context"
+	"time"
+
 	"github.com/mathefer/tc-fiap-product/internal/product/infrastructure/api/dto"
 )
 
+// ProductController adapts the product use cases to the API. The version
+// taken by the writes is the one the client based them on, 0 meaning any.
 type ProductController interface {
-	Get(category uint) ([]*dto.GetProductResponseDto, error)
-	Add(product *dto.AddProductRequestDto) error
-	Update(id uint, product *dto.UpdateProductRequestDto) error
-	Delete(id uint) error
+	Get(ctx context.Context, category uint, includeDeleted bool) ([]*dto.GetProductResponseDto, error)
+	GetByID(ctx context.Context, id uint, includeDeleted bool) (*dto.GetProductResponseDto, error)
+	Lookup(ctx context.Context, request *dto.LookupProductsRequestDto) (*dto.LookupProductsResponseDto, error)
+	List(ctx context.Context, request *dto.ListProductsRequestDto) (*dto.ListProductsResponseDto, error)
+	Add(ctx context.Context, product *dto.AddProductRequestDto) (*dto.GetProductResponseDto, error)
+	Update(ctx context.Context, id uint, version uint, product *dto.UpdateProductRequestDto) (*dto.GetProductResponseDto, error)
+	Patch(ctx context.Context, id uint, version uint, product *dto.PatchProductRequestDto) (*dto.GetProductResponseDto, error)
+	Delete(ctx context.Context, id uint, version uint) error
+	Restore(ctx context.Context, id uint) (*dto.GetProductResponseDto, error)
+	// LastModified returns when any product was last added, changed or
+	// deleted; listings are only as fresh as this.
+	LastModified(ctx context.Context) (time.Time, error)
 }
@@ -1,13 +1,28 @@
 package controller
 
 import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+
+	"github.com/mathefer/tc-fiap-product/internal/product/domain/domainerrors"
+	"github.com/mathefer/tc-fiap-product/internal/product/domain/repositories"
 	"github.com/mathefer/tc-fiap-product/internal/product/infrastructure/api/dto"
 	productPresenter "github.com/mathefer/tc-fiap-product/internal/product/presenter"
 	addProduct "github.com/mathefer/tc-fiap-product/internal/product/usecase/addProduct"
 	"github.com/mathefer/tc-fiap-product/internal/product/usecase/commands"
 	deleteProduct "github.com/mathefer/tc-fiap-product/internal/product/usecase/deleteProduct"
 	getProduct "github.com/mathefer/tc-fiap-product/internal/product/usecase/getProduct"
+	getProductByID "github.com/mathefer/tc-fiap-product/internal/product/usecase/getProductByID"
+	getProductsLastModified "github.com/mathefer/tc-fiap-product/internal/product/usecase/getProductsLastModified"
+	listProducts "github.com/mathefer/tc-fiap-product/internal/product/usecase/listProducts"
+	lookupProducts "github.com/mathefer/tc-fiap-product/internal/product/usecase/lookupProducts"
+	patchProduct "github.com/mathefer/tc-fiap-product/internal/product/usecase/patchProduct"
+	restoreProduct "github.com/mathefer/tc-fiap-product/internal/product/usecase/restoreProduct"
 	updateProduct "github.com/mathefer/tc-fiap-product/internal/product/usecase/updateProduct"
+	"github.com/mathefer/tc-fiap-product/pkg/logging"
+	"github.com/mathefer/tc-fiap-product/pkg/tracing"
 )
 
 var (
@@ -15,30 +30,58 @@ var (
 )
 
 type ProductControllerImpl struct {
-	presenter            productPresenter.ProductPresenter
-	addProductUseCase    addProduct.AddProductUseCase
-	getProductUseCase    getProduct.GetProductUseCase
-	updateProductUseCase updateProduct.UpdateProductUseCase
-	deleteProductUseCase deleteProduct.DeleteProductUseCase
+	presenter             productPresenter.ProductPresenter
+	addProductUseCase     addProduct.AddProductUseCase
+	getProductUseCase     getProduct.GetProductUseCase
+	getProductByIDUseCase getProductByID.GetProductByIDUseCase
+	lookupProductsUseCase lookupProducts.LookupProductsUseCase
+	listProductsUseCase   listProducts.ListProductsUseCase
+	updateProductUseCase  updateProduct.UpdateProductUseCase
+	patchProductUseCase   patchProduct.PatchProductUseCase
+	deleteProductUseCase  deleteProduct.DeleteProductUseCase
+	restoreProductUseCase restoreProduct.RestoreProductUseCase
+	lastModifiedUseCase   getProductsLastModified.GetProductsLastModifiedUseCase
+	logger                *slog.Logger
 }
 
 func NewProductControllerImpl(
 	presenter productPresenter.ProductPresenter,
 	addProductUseCase addProduct.AddProductUseCase,
 	getProductUseCase getProduct.GetProductUseCase,
+	getProductByIDUseCase getProductByID.GetProductByIDUseCase,
+	lookupProductsUseCase lookupProducts.LookupProductsUseCase,
+	listProductsUseCase listProducts.ListProductsUseCase,
 	updateProductUseCase updateProduct.UpdateProductUseCase,
-	deleteProductUseCase deleteProduct.DeleteProductUseCase) *ProductControllerImpl {
+	patchProductUseCase patchProduct.PatchProductUseCase,
+	deleteProductUseCase deleteProduct.DeleteProductUseCase,
+	restoreProductUseCase restoreProduct.RestoreProductUseCase,
+	lastModifiedUseCase getProductsLastModified.GetProductsLastModifiedUseCase,
+	logger *slog.Logger) *ProductControllerImpl {
 	return &ProductControllerImpl{
-		presenter:            presenter,
-		addProductUseCase:    addProductUseCase,
-		getProductUseCase:    getProductUseCase,
-		updateProductUseCase: updateProductUseCase,
-		deleteProductUseCase: deleteProductUseCase,
+		presenter:             presenter,
+		addProductUseCase:     addProductUseCase,
+		getProductUseCase:     getProductUseCase,
+		getProductByIDUseCase: getProductByIDUseCase,
+		lookupProductsUseCase: lookupProductsUseCase,
+		listProductsUseCase:   listProductsUseCase,
+		updateProductUseCase:  updateProductUseCase,
+		patchProductUseCase:   patchProductUseCase,
+		deleteProductUseCase:  deleteProductUseCase,
+		restoreProductUseCase: restoreProductUseCase,
+		lastModifiedUseCase:   lastModifiedUseCase,
+		logger:                logger,
 	}
 }
 
-func (p *ProductControllerImpl) Get(category uint) ([]*dto.GetProductResponseDto, error) {
-	products, err := p.getProductUseCase.Execute(commands.NewGetProductCommand(category))
+func (p *ProductControllerImpl) Get(ctx context.Context, category uint, includeDeleted bool) (_ []*dto.GetProductResponseDto, err error) {
+	ctx, span := tracing.Start(ctx, "ProductController.Get")
+	defer func() {
+		p.logFailure(ctx, "Get", err)
+		tracing.RecordError(span, err)
+		span.End()
+	}()
+
+	products, err := p.getProductUseCase.Execute(ctx, commands.NewGetProductCommand(category, includeDeleted))
 	if err != nil {
 		return nil, err
 	}
@@ -46,29 +89,193 @@ func (p *ProductControllerImpl) Get(category uint) ([]*dto.GetProductResponseDto
 	return p.presenter.Present(products), nil
 }
 
-func (p *ProductControllerImpl) Add(product *dto.AddProductRequestDto) error {
-	command := commands.NewAddProductCommand(product.Name, product.Category, product.Price, product.Description, product.ImageLink)
-	err := p.addProductUseCase.Execute(command)
+func (p *ProductControllerImpl) GetByID(ctx context.Context, id uint, includeDeleted bool) (_ *dto.GetProductResponseDto, err error) {
+	ctx = logging.ContextWithAttrs(ctx, slog.Uint64("product_id", uint64(id)))
+	ctx, span := tracing.Start(ctx, "ProductController.GetByID")
+	defer func() {
+		p.logFailure(ctx, "GetByID", err)
+		tracing.RecordError(span, err)
+		span.End()
+	}()
+
+	product, err := p.getProductByIDUseCase.Execute(ctx, commands.NewGetProductByIDCommand(id, includeDeleted))
 	if err != nil {
-		return err
+		return nil, err
 	}
-	return nil
+
+	return p.presenter.PresentOne(product), nil
 }
 
-func (p *ProductControllerImpl) Update(id uint, product *dto.UpdateProductRequestDto) error {
-	command := commands.NewUpdateProductCommand(id, product.Name, product.Category, product.Price, product.Description, product.ImageLink)
-	err := p.updateProductUseCase.Execute(command)
+func (p *ProductControllerImpl) Lookup(ctx context.Context, request *dto.LookupProductsRequestDto) (_ *dto.LookupProductsResponseDto, err error) {
+	ctx, span := tracing.Start(ctx, "ProductController.Lookup")
+	defer func() {
+		p.logFailure(ctx, "Lookup", err)
+		tracing.RecordError(span, err)
+		span.End()
+	}()
+
+	products, missingIDs, err := p.lookupProductsUseCase.Execute(ctx, commands.NewLookupProductsCommand(request.IDs, request.IncludeDeleted))
 	if err != nil {
-		return err
+		return nil, err
 	}
-	return nil
+
+	return p.presenter.PresentLookup(products, missingIDs), nil
+}
+
+func (p *ProductControllerImpl) List(ctx context.Context, request *dto.ListProductsRequestDto) (_ *dto.ListProductsResponseDto, err error) {
+	ctx, span := tracing.Start(ctx, "ProductController.List")
+	defer func() {
+		p.logFailure(ctx, "List", err)
+		tracing.RecordError(span, err)
+		span.End()
+	}()
+
+	var after *repositories.ProductListPosition
+	if cursor := request.After; cursor != nil {
+		after = &repositories.ProductListPosition{ID: cursor.ID, Name: cursor.Name, PriceAmount: cursor.Price}
+		if cursor.CreatedAt != nil {
+			after.CreatedAt = *cursor.CreatedAt
+		}
+	}
+
+	// One product more than the page is loaded to learn whether another
+	// page follows.
+	command := commands.NewListProductsCommand(request.Category, request.Sort, request.Order == "desc", request.IncludeDeleted, request.Limit+1, request.Offset, after)
+	products, total, err := p.listProductsUseCase.Execute(ctx, command)
+	if err != nil {
+		return nil, err
+	}
+
+	more := len(products) > request.Limit
+	if more {
+		products = products[:request.Limit]
+	}
+	return p.presenter.PresentList(products, total, request, more), nil
+}
+
+func (p *ProductControllerImpl) Add(ctx context.Context, product *dto.AddProductRequestDto) (_ *dto.GetProductResponseDto, err error) {
+	ctx, span := tracing.Start(ctx, "ProductController.Add")
+	defer func() {
+		p.logFailure(ctx, "Add", err)
+		tracing.RecordError(span, err)
+		span.End()
+	}()
+
+	command := commands.NewAddProductCommand(product.Name, product.Category, string(product.Price), product.Currency, product.Description, product.ImageLink)
+	created, err := p.addProductUseCase.Execute(ctx, command)
+	if err != nil {
+		return nil, err
+	}
+
+	return p.presenter.PresentOne(created), nil
 }
 
-func (p *ProductControllerImpl) Delete(id uint) error {
-	command := commands.NewDeleteProductCommand(id)
-	err := p.deleteProductUseCase.Execute(command)
+func (p *ProductControllerImpl) Update(ctx context.Context, id uint, version uint, product *dto.UpdateProductRequestDto) (_ *dto.GetProductResponseDto, err error) {
+	ctx = logging.ContextWithAttrs(ctx, slog.Uint64("product_id", uint64(id)))
+	ctx, span := tracing.Start(ctx, "ProductController.Update")
+	defer func() {
+		p.logFailure(ctx, "Update", err)
+		tracing.RecordError(span, err)
+		span.End()
+	}()
+
+	command := commands.NewUpdateProductCommand(id, version, product.Name, product.Category, string(product.Price), product.Currency, product.Description, product.ImageLink)
+	updated, err := p.updateProductUseCase.Execute(ctx, command)
+	if err != nil {
+		return nil, err
+	}
+
+	return p.presenter.PresentOne(updated), nil
+}
+
+func (p *ProductControllerImpl) Patch(ctx context.Context, id uint, version uint, product *dto.PatchProductRequestDto) (_ *dto.GetProductResponseDto, err error) {
+	ctx = logging.ContextWithAttrs(ctx, slog.Uint64("product_id", uint64(id)))
+	ctx, span := tracing.Start(ctx, "ProductController.Patch")
+	defer func() {
+		p.logFailure(ctx, "Patch", err)
+		tracing.RecordError(span, err)
+		span.End()
+	}()
+
+	command := commands.NewPatchProductCommand(id, version, product.Name.Ptr(), product.Category.Ptr(), decimalPtr(product.Price.Ptr()), product.Currency.Ptr(), product.Description.Ptr(), product.ImageLink.Ptr())
+	patched, err := p.patchProductUseCase.Execute(ctx, command)
+	if err != nil {
+		return nil, err
+	}
+
+	return p.presenter.PresentOne(patched), nil
+}
+
+func (p *ProductControllerImpl) Delete(ctx context.Context, id uint, version uint) (err error) {
+	ctx = logging.ContextWithAttrs(ctx, slog.Uint64("product_id", uint64(id)))
+	ctx, span := tracing.Start(ctx, "ProductController.Delete")
+	defer func() {
+		p.logFailure(ctx, "Delete", err)
+		tracing.RecordError(span, err)
+		span.End()
+	}()
+
+	command := commands.NewDeleteProductCommand(id, version)
+	err = p.deleteProductUseCase.Execute(ctx, command)
 	if err != nil {
 		return err
 	}
 	return nil
 }
+
+func (p *ProductControllerImpl) Restore(ctx context.Context, id uint) (_ *dto.GetProductResponseDto, err error) {
+	ctx = logging.ContextWithAttrs(ctx, slog.Uint64("product_id", uint64(id)))
+	ctx, span := tracing.Start(ctx, "ProductController.Restore")
+	defer func() {
+		p.logFailure(ctx, "Restore", err)
+		tracing.RecordError(span, err)
+		span.End()
+	}()
+
+	product, err := p.restoreProductUseCase.Execute(ctx, commands.NewRestoreProductCommand(id))
+	if err != nil {
+		return nil, err
+	}
+
+	return p.presenter.PresentOne(product), nil
+}
+
+func (p *ProductControllerImpl) LastModified(ctx context.Context) (_ time.Time, err error) {
+	ctx, span := tracing.Start(ctx, "ProductController.LastModified")
+	defer func() {
+		p.logFailure(ctx, "LastModified", err)
+		tracing.RecordError(span, err)
+		span.End()
+	}()
+
+	return p.lastModifiedUseCase.Execute(ctx, commands.NewGetProductsLastModifiedCommand())
+}
+
+// logFailure records why a request failed. Rejections caused by the input
+// are expected and logged at info; anything else is an error.
+func (p *ProductControllerImpl) logFailure(ctx context.Context, operation string, err error) {
+	if err == nil {
+		return
+	}
+	level := slog.LevelError
+	if isDomainError(err) {
+		level = slog.LevelInfo
+	}
+	p.logger.Log(ctx, level, "Product request failed", "operation", operation, "error", err)
+}
+
+func isDomainError(err error) bool {
+	return errors.Is(err, domainerrors.ErrNotFound) ||
+		errors.Is(err, domainerrors.ErrInvalidArgument) ||
+		errors.Is(err, domainerrors.ErrValidation) ||
+		errors.Is(err, domainerrors.ErrConflict) ||
+		errors.Is(err, domainerrors.ErrPreconditionFailed)
+}
+
+func decimalPtr(value *dto.Decimal) *string {
+	if value == nil {
+		return nil
+	}
+	decimal := string(*value)
+	return &decimal
+}
@@ -1,6 +1,7 @@
 package controller_test
 
 import (
+	"context"
 	"errors"
 	"testing"
 	"time"
@@ -10,12 +11,21 @@ import (
 	"github.com/stretchr/testify/suite"
 	"github.com/mathefer/tc-fiap-product/internal/product/controller"
 	"github.com/mathefer/tc-fiap-product/internal/product/domain/entities"
+	"github.com/mathefer/tc-fiap-product/internal/product/domain/repositories"
 	"github.com/mathefer/tc-fiap-product/internal/product/infrastructure/api/dto"
+	"github.com/mathefer/tc-fiap-product/internal/product/usecase/commands"
 	mockPresenter "github.com/mathefer/tc-fiap-product/mocks/product/presenter"
 	mockAddProduct "github.com/mathefer/tc-fiap-product/mocks/product/usecase/addProduct"
 	mockDeleteProduct "github.com/mathefer/tc-fiap-product/mocks/product/usecase/deleteProduct"
 	mockGetProduct "github.com/mathefer/tc-fiap-product/mocks/product/usecase/getProduct"
+	mockGetProductByID "github.com/mathefer/tc-fiap-product/mocks/product/usecase/getProductByID"
+	mockGetProductsLastModified "github.com/mathefer/tc-fiap-product/mocks/product/usecase/getProductsLastModified"
+	mockListProducts "github.com/mathefer/tc-fiap-product/mocks/product/usecase/listProducts"
+	mockLookupProducts "github.com/mathefer/tc-fiap-product/mocks/product/usecase/lookupProducts"
+	mockPatchProduct "github.com/mathefer/tc-fiap-product/mocks/product/usecase/patchProduct"
+	mockRestoreProduct "github.com/mathefer/tc-fiap-product/mocks/product/usecase/restoreProduct"
 	mockUpdateProduct "github.com/mathefer/tc-fiap-product/mocks/product/usecase/updateProduct"
+	"github.com/mathefer/tc-fiap-product/pkg/logging"
 )
 
 type ProductControllerTestSuite struct {
@@ -23,8 +33,14 @@ type ProductControllerTestSuite struct {
 	mockPresenter          *mockPresenter.MockProductPresenter
 	mockAddProductUseCase  *mockAddProduct.MockAddProductUseCase
 	mockGetProductUseCase  *mockGetProduct.MockGetProductUseCase
+	mockGetProductByIDUseCase *mockGetProductByID.MockGetProductByIDUseCase
+	mockLookupProductsUseCase *mockLookupProducts.MockLookupProductsUseCase
+	mockListProductsUseCase   *mockListProducts.MockListProductsUseCase
 	mockUpdateProductUseCase *mockUpdateProduct.MockUpdateProductUseCase
+	mockPatchProductUseCase  *mockPatchProduct.MockPatchProductUseCase
 	mockDeleteProductUseCase *mockDeleteProduct.MockDeleteProductUseCase
+	mockRestoreProductUseCase *mockRestoreProduct.MockRestoreProductUseCase
+	mockLastModifiedUseCase   *mockGetProductsLastModified.MockGetProductsLastModifiedUseCase
 	productController      controller.ProductController
 }
 
@@ -32,15 +48,28 @@ func (suite *ProductControllerTestSuite) SetupTest() {
 	suite.mockPresenter = mockPresenter.NewMockProductPresenter(suite.T())
 	suite.mockAddProductUseCase = mockAddProduct.NewMockAddProductUseCase(suite.T())
 	suite.mockGetProductUseCase = mockGetProduct.NewMockGetProductUseCase(suite.T())
+	suite.mockGetProductByIDUseCase = mockGetProductByID.NewMockGetProductByIDUseCase(suite.T())
+	suite.mockLookupProductsUseCase = mockLookupProducts.NewMockLookupProductsUseCase(suite.T())
+	suite.mockListProductsUseCase = mockListProducts.NewMockListProductsUseCase(suite.T())
 	suite.mockUpdateProductUseCase = mockUpdateProduct.NewMockUpdateProductUseCase(suite.T())
+	suite.mockPatchProductUseCase = mockPatchProduct.NewMockPatchProductUseCase(suite.T())
 	suite.mockDeleteProductUseCase = mockDeleteProduct.NewMockDeleteProductUseCase(suite.T())
+	suite.mockRestoreProductUseCase = mockRestoreProduct.NewMockRestoreProductUseCase(suite.T())
+	suite.mockLastModifiedUseCase = mockGetProductsLastModified.NewMockGetProductsLastModifiedUseCase(suite.T())
 
 	suite.productController = controller.NewProductControllerImpl(
 		suite.mockPresenter,
 		suite.mockAddProductUseCase,
 		suite.mockGetProductUseCase,
+		suite.mockGetProductByIDUseCase,
+		suite.mockLookupProductsUseCase,
+		suite.mockListProductsUseCase,
 		suite.mockUpdateProductUseCase,
+		suite.mockPatchProductUseCase,
 		suite.mockDeleteProductUseCase,
+		suite.mockRestoreProductUseCase,
+		suite.mockLastModifiedUseCase,
+		logging.NewNop(),
 	)
 }
 
@@ -59,7 +88,7 @@ func (suite *ProductControllerTestSuite) TestGet_Success() {
 			CreatedAt:   now,
 			Name:        "Hamburguer",
 			Category:    1,
-			Price:       34.99,
+			Price:       entities.NewMoney(3499, "BRL"),
 			Description: "Hamburguer com salada",
 			ImageLink:   "https://example.com/image.jpg",
 		},
@@ -78,7 +107,7 @@ func (suite *ProductControllerTestSuite) TestGet_Success() {
 	}
 
 	suite.mockGetProductUseCase.EXPECT().
-		Execute(mock.Anything).
+		Execute(mock.Anything, mock.Anything).
 		Return(products, nil).
 		Once()
 
@@ -88,7 +117,7 @@ func (suite *ProductControllerTestSuite) TestGet_Success() {
 		Once()
 
 	// Act
-	result, err := suite.productController.Get(category)
+	result, err := suite.productController.Get(context.Background(), category, false)
 
 	// Assert
 	assert.NoError(suite.T(), err)
@@ -106,12 +135,12 @@ func (suite *ProductControllerTestSuite) TestGet_UseCaseError() {
 	expectedError := errors.New("database error")
 
 	suite.mockGetProductUseCase.EXPECT().
-		Execute(mock.Anything).
+		Execute(mock.Anything, mock.Anything).
 		Return(nil, expectedError).
 		Once()
 
 	// Act
-	result, err := suite.productController.Get(category)
+	result, err := suite.productController.Get(context.Background(), category, false)
 
 	// Assert
 	assert.Error(suite.T(), err)
@@ -120,26 +149,230 @@ func (suite *ProductControllerTestSuite) TestGet_UseCaseError() {
 	suite.mockGetProductUseCase.AssertExpectations(suite.T())
 }
 
+func (suite *ProductControllerTestSuite) TestGetByID_Success() {
+	// Arrange
+	id := uint(1)
+	now := time.Now()
+
+	product := &entities.Product{
+		ID:          1,
+		CreatedAt:   now,
+		Name:        "Hamburguer",
+		Category:    1,
+		Price:       entities.NewMoney(3499, "BRL"),
+		Description: "Hamburguer com salada",
+		ImageLink:   "https://example.com/image.jpg",
+	}
+
+	expectedDto := &dto.GetProductResponseDto{
+		ID:          1,
+		CreatedAt:   now,
+		Name:        "Hamburguer",
+		Category:    1,
+		Price:       34.99,
+		Description: "Hamburguer com salada",
+		ImageLink:   "https://example.com/image.jpg",
+	}
+
+	suite.mockGetProductByIDUseCase.EXPECT().
+		Execute(mock.Anything, mock.Anything).
+		Return(product, nil).
+		Once()
+
+	suite.mockPresenter.EXPECT().
+		PresentOne(product).
+		Return(expectedDto).
+		Once()
+
+	// Act
+	result, err := suite.productController.GetByID(context.Background(), id, false)
+
+	// Assert
+	assert.NoError(suite.T(), err)
+	assert.NotNil(suite.T(), result)
+	assert.Equal(suite.T(), expectedDto.ID, result.ID)
+	assert.Equal(suite.T(), expectedDto.Name, result.Name)
+	suite.mockGetProductByIDUseCase.AssertExpectations(suite.T())
+	suite.mockPresenter.AssertExpectations(suite.T())
+}
+
+func (suite *ProductControllerTestSuite) TestGetByID_UseCaseError() {
+	// Arrange
+	id := uint(999)
+	expectedError := errors.New("record not found")
+
+	suite.mockGetProductByIDUseCase.EXPECT().
+		Execute(mock.Anything, mock.Anything).
+		Return(nil, expectedError).
+		Once()
+
+	// Act
+	result, err := suite.productController.GetByID(context.Background(), id, false)
+
+	// Assert
+	assert.Error(suite.T(), err)
+	assert.Nil(suite.T(), result)
+	assert.Equal(suite.T(), expectedError, err)
+	suite.mockGetProductByIDUseCase.AssertExpectations(suite.T())
+}
+
+func (suite *ProductControllerTestSuite) TestLookup_Success() {
+	// Arrange
+	requestDto := &dto.LookupProductsRequestDto{IDs: []uint{1, 2}, IncludeDeleted: true}
+	products := []*entities.Product{
+		{ID: 1, Name: "Hamburguer", Category: 1, Price: entities.NewMoney(3499, "BRL")},
+	}
+	missingIDs := []uint{2}
+	expectedDto := &dto.LookupProductsResponseDto{
+		Products:   []*dto.GetProductResponseDto{{ID: 1, Name: "Hamburguer", Category: 1, Price: 34.99}},
+		MissingIDs: missingIDs,
+	}
+
+	suite.mockLookupProductsUseCase.EXPECT().
+		Execute(mock.Anything, commands.NewLookupProductsCommand([]uint{1, 2}, true)).
+		Return(products, missingIDs, nil).
+		Once()
+
+	suite.mockPresenter.EXPECT().
+		PresentLookup(products, missingIDs).
+		Return(expectedDto).
+		Once()
+
+	// Act
+	result, err := suite.productController.Lookup(context.Background(), requestDto)
+
+	// Assert
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), expectedDto, result)
+}
+
+func (suite *ProductControllerTestSuite) TestLookup_UseCaseError() {
+	// Arrange
+	requestDto := &dto.LookupProductsRequestDto{IDs: []uint{1}}
+	expectedError := errors.New("database error")
+
+	suite.mockLookupProductsUseCase.EXPECT().
+		Execute(mock.Anything, mock.Anything).
+		Return(nil, nil, expectedError).
+		Once()
+
+	// Act
+	result, err := suite.productController.Lookup(context.Background(), requestDto)
+
+	// Assert
+	assert.Error(suite.T(), err)
+	assert.Nil(suite.T(), result)
+	assert.Equal(suite.T(), expectedError, err)
+}
+
+func (suite *ProductControllerTestSuite) TestList_Success() {
+	// Arrange
+	requestDto := &dto.ListProductsRequestDto{Category: 1, Sort: "price", Order: "desc", Limit: 10, Offset: 0}
+	products := []*entities.Product{
+		{ID: 1, Name: "Hamburguer", Category: 1, Price: entities.NewMoney(3499, "BRL")},
+	}
+	expectedDto := &dto.ListProductsResponseDto{
+		Items: []*dto.GetProductResponseDto{{ID: 1, Name: "Hamburguer", Category: 1, Price: 34.99}},
+		Total: 1,
+		Limit: 10,
+	}
+
+	suite.mockListProductsUseCase.EXPECT().
+		Execute(mock.Anything, commands.NewListProductsCommand(1, "price", true, false, 11, 0, nil)).
+		Return(products, int64(1), nil).
+		Once()
+
+	suite.mockPresenter.EXPECT().
+		PresentList(products, int64(1), requestDto, false).
+		Return(expectedDto).
+		Once()
+
+	// Act
+	result, err := suite.productController.List(context.Background(), requestDto)
+
+	// Assert
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), expectedDto, result)
+}
+
+func (suite *ProductControllerTestSuite) TestList_AfterCursorWithNextPage() {
+	// Arrange
+	createdAt := time.Date(2024, 2, 1, 12, 0, 0, 0, time.UTC)
+	requestDto := &dto.ListProductsRequestDto{
+		Sort:  "created_at",
+		Limit: 2,
+		After: &dto.ListProductsCursorDto{Sort: "created_at", ID: 4, CreatedAt: &createdAt},
+	}
+	products := []*entities.Product{{ID: 5}, {ID: 6}, {ID: 7}}
+	expectedDto := &dto.ListProductsResponseDto{Limit: 2, NextCursor: "next"}
+
+	suite.mockListProductsUseCase.EXPECT().
+		Execute(mock.Anything, commands.NewListProductsCommand(0, "created_at", false, false, 3, 0,
+			&repositories.ProductListPosition{ID: 4, CreatedAt: createdAt})).
+		Return(products, int64(9), nil).
+		Once()
+
+	suite.mockPresenter.EXPECT().
+		PresentList(products[:2], int64(9), requestDto, true).
+		Return(expectedDto).
+		Once()
+
+	// Act
+	result, err := suite.productController.List(context.Background(), requestDto)
+
+	// Assert - the extra product only tells that another page follows
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), expectedDto, result)
+}
+
+func (suite *ProductControllerTestSuite) TestList_UseCaseError() {
+	// Arrange
+	requestDto := &dto.ListProductsRequestDto{Limit: 20}
+	expectedError := errors.New("database error")
+
+	suite.mockListProductsUseCase.EXPECT().
+		Execute(mock.Anything, mock.Anything).
+		Return(nil, int64(0), expectedError).
+		Once()
+
+	// Act
+	result, err := suite.productController.List(context.Background(), requestDto)
+
+	// Assert
+	assert.Error(suite.T(), err)
+	assert.Nil(suite.T(), result)
+	assert.Equal(suite.T(), expectedError, err)
+}
+
 func (suite *ProductControllerTestSuite) TestAdd_Success() {
 	// Arrange
 	requestDto := &dto.AddProductRequestDto{
 		Name:        "Pizza",
 		Category:    1,
-		Price:       45.99,
+		Price:       "45.99",
 		Description: "Pizza margherita",
 		ImageLink:   "https://example.com/pizza.jpg",
 	}
 
+	product := &entities.Product{ID: 7, Name: "Pizza", Category: 1, Price: entities.NewMoney(4599, "BRL")}
+	expectedDto := &dto.GetProductResponseDto{ID: 7, Name: "Pizza", Category: 1, Price: 45.99}
+
 	suite.mockAddProductUseCase.EXPECT().
-		Execute(mock.Anything).
-		Return(nil).
+		Execute(mock.Anything, mock.Anything).
+		Return(product, nil).
+		Once()
+
+	suite.mockPresenter.EXPECT().
+		PresentOne(product).
+		Return(expectedDto).
 		Once()
 
 	// Act
-	err := suite.productController.Add(requestDto)
+	result, err := suite.productController.Add(context.Background(), requestDto)
 
 	// Assert
 	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), expectedDto, result)
 	suite.mockAddProductUseCase.AssertExpectations(suite.T())
 }
 
@@ -148,7 +381,7 @@ func (suite *ProductControllerTestSuite) TestAdd_UseCaseError() {
 	requestDto := &dto.AddProductRequestDto{
 		Name:        "Hamburguer",
 		Category:    1,
-		Price:       34.99,
+		Price:       "34.99",
 		Description: "Hamburguer com salada",
 		ImageLink:   "https://example.com/image.jpg",
 	}
@@ -156,15 +389,16 @@ func (suite *ProductControllerTestSuite) TestAdd_UseCaseError() {
 	expectedError := errors.New("database error")
 
 	suite.mockAddProductUseCase.EXPECT().
-		Execute(mock.Anything).
-		Return(expectedError).
+		Execute(mock.Anything, mock.Anything).
+		Return(nil, expectedError).
 		Once()
 
 	// Act
-	err := suite.productController.Add(requestDto)
+	result, err := suite.productController.Add(context.Background(), requestDto)
 
 	// Assert
 	assert.Error(suite.T(), err)
+	assert.Nil(suite.T(), result)
 	assert.Equal(suite.T(), expectedError, err)
 	suite.mockAddProductUseCase.AssertExpectations(suite.T())
 }
@@ -175,21 +409,32 @@ func (suite *ProductControllerTestSuite) TestUpdate_Success() {
 	requestDto := &dto.UpdateProductRequestDto{
 		Name:        "Hamburguer Atualizado",
 		Category:    1,
-		Price:       39.99,
+		Price:       "39.99",
 		Description: "Hamburguer com bacon",
 		ImageLink:   "https://example.com/updated.jpg",
 	}
 
+	product := &entities.Product{ID: id, Name: "Hamburguer Atualizado", Category: 1, Price: entities.NewMoney(3999, "BRL")}
+	expectedDto := &dto.GetProductResponseDto{ID: id, Name: "Hamburguer Atualizado", Category: 1, Price: 39.99}
+
 	suite.mockUpdateProductUseCase.EXPECT().
-		Execute(mock.Anything).
-		Return(nil).
+		Execute(mock.Anything, mock.MatchedBy(func(command *commands.UpdateProductCommand) bool {
+			return command.ID == id && command.Version == 3
+		})).
+		Return(product, nil).
+		Once()
+
+	suite.mockPresenter.EXPECT().
+		PresentOne(product).
+		Return(expectedDto).
 		Once()
 
 	// Act
-	err := suite.productController.Update(id, requestDto)
+	result, err := suite.productController.Update(context.Background(), id, 3, requestDto)
 
 	// Assert
 	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), expectedDto, result)
 	suite.mockUpdateProductUseCase.AssertExpectations(suite.T())
 }
 
@@ -199,7 +444,7 @@ func (suite *ProductControllerTestSuite) TestUpdate_UseCaseError() {
 	requestDto := &dto.UpdateProductRequestDto{
 		Name:        "Pizza",
 		Category:    1,
-		Price:       45.99,
+		Price:       "45.99",
 		Description: "Pizza margherita",
 		ImageLink:   "https://example.com/pizza.jpg",
 	}
@@ -207,30 +452,92 @@ func (suite *ProductControllerTestSuite) TestUpdate_UseCaseError() {
 	expectedError := errors.New("product not found")
 
 	suite.mockUpdateProductUseCase.EXPECT().
-		Execute(mock.Anything).
-		Return(expectedError).
+		Execute(mock.Anything, mock.Anything).
+		Return(nil, expectedError).
 		Once()
 
 	// Act
-	err := suite.productController.Update(id, requestDto)
+	result, err := suite.productController.Update(context.Background(), id, 0, requestDto)
 
 	// Assert
 	assert.Error(suite.T(), err)
+	assert.Nil(suite.T(), result)
 	assert.Equal(suite.T(), expectedError, err)
 	suite.mockUpdateProductUseCase.AssertExpectations(suite.T())
 }
 
+func (suite *ProductControllerTestSuite) TestPatch_Success() {
+	// Arrange
+	id := uint(1)
+	requestDto := &dto.PatchProductRequestDto{
+		Price:     dto.Optional[dto.Decimal]{Set: true, Value: "34.99"},
+		ImageLink: dto.Optional[string]{Set: true, Null: true},
+	}
+
+	product := &entities.Product{ID: id, Name: "Hamburguer", Category: 1, Price: entities.NewMoney(3499, "BRL"), Version: 3}
+	expectedDto := &dto.GetProductResponseDto{ID: id, Name: "Hamburguer", Category: 1, Price: 34.99, Version: 3}
+
+	suite.mockPatchProductUseCase.EXPECT().
+		Execute(mock.Anything, mock.MatchedBy(func(command *commands.PatchProductCommand) bool {
+			return command.ID == id &&
+				command.Version == 2 &&
+				command.Name == nil &&
+				command.Price != nil && *command.Price == "34.99" &&
+				command.Currency == nil &&
+				command.ImageLink != nil && *command.ImageLink == ""
+		})).
+		Return(product, nil).
+		Once()
+
+	suite.mockPresenter.EXPECT().
+		PresentOne(product).
+		Return(expectedDto).
+		Once()
+
+	// Act
+	result, err := suite.productController.Patch(context.Background(), id, 2, requestDto)
+
+	// Assert
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), expectedDto, result)
+	suite.mockPatchProductUseCase.AssertExpectations(suite.T())
+}
+
+func (suite *ProductControllerTestSuite) TestPatch_UseCaseError() {
+	// Arrange
+	id := uint(1)
+	requestDto := &dto.PatchProductRequestDto{
+		Name: dto.Optional[string]{Set: true, Value: "Pizza"},
+	}
+
+	expectedError := errors.New("product not found")
+
+	suite.mockPatchProductUseCase.EXPECT().
+		Execute(mock.Anything, mock.Anything).
+		Return(nil, expectedError).
+		Once()
+
+	// Act
+	result, err := suite.productController.Patch(context.Background(), id, 0, requestDto)
+
+	// Assert
+	assert.Error(suite.T(), err)
+	assert.Nil(suite.T(), result)
+	assert.Equal(suite.T(), expectedError, err)
+	suite.mockPatchProductUseCase.AssertExpectations(suite.T())
+}
+
 func (suite *ProductControllerTestSuite) TestDelete_Success() {
 	// Arrange
 	id := uint(1)
 
 	suite.mockDeleteProductUseCase.EXPECT().
-		Execute(mock.Anything).
+		Execute(mock.Anything, commands.NewDeleteProductCommand(id, 4)).
 		Return(nil).
 		Once()
 
 	// Act
-	err := suite.productController.Delete(id)
+	err := suite.productController.Delete(context.Background(), id, 4)
 
 	// Assert
 	assert.NoError(suite.T(), err)
@@ -243,12 +550,12 @@ func (suite *ProductControllerTestSuite) TestDelete_UseCaseError() {
 	expectedError := errors.New("database error")
 
 	suite.mockDeleteProductUseCase.EXPECT().
-		Execute(mock.Anything).
+		Execute(mock.Anything, mock.Anything).
 		Return(expectedError).
 		Once()
 
 	// Act
-	err := suite.productController.Delete(id)
+	err := suite.productController.Delete(context.Background(), id, 0)
 
 	// Assert
 	assert.Error(suite.T(), err)
@@ -256,3 +563,77 @@ func (suite *ProductControllerTestSuite) TestDelete_UseCaseError() {
 	suite.mockDeleteProductUseCase.AssertExpectations(suite.T())
 }
 
+func (suite *ProductControllerTestSuite) TestRestore_Success() {
+	// Arrange
+	id := uint(1)
+	product := &entities.Product{ID: id, Name: "Hamburguer", Category: 1}
+	expectedDto := &dto.GetProductResponseDto{ID: id, Name: "Hamburguer", Category: 1}
+
+	suite.mockRestoreProductUseCase.EXPECT().
+		Execute(mock.Anything, commands.NewRestoreProductCommand(id)).
+		Return(product, nil).
+		Once()
+
+	suite.mockPresenter.EXPECT().
+		PresentOne(product).
+		Return(expectedDto).
+		Once()
+
+	// Act
+	result, err := suite.productController.Restore(context.Background(), id)
+
+	// Assert
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), expectedDto, result)
+}
+
+func (suite *ProductControllerTestSuite) TestRestore_UseCaseError() {
+	// Arrange
+	id := uint(1)
+	expectedError := errors.New("database error")
+
+	suite.mockRestoreProductUseCase.EXPECT().
+		Execute(mock.Anything, mock.Anything).
+		Return(nil, expectedError).
+		Once()
+
+	// Act
+	result, err := suite.productController.Restore(context.Background(), id)
+
+	// Assert
+	assert.Nil(suite.T(), result)
+	assert.Equal(suite.T(), expectedError, err)
+}
+
+func (suite *ProductControllerTestSuite) TestLastModified_Success() {
+	// Arrange
+	expected := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	suite.mockLastModifiedUseCase.EXPECT().
+		Execute(mock.Anything, commands.NewGetProductsLastModifiedCommand()).
+		Return(expected, nil).
+		Once()
+
+	// Act
+	lastModified, err := suite.productController.LastModified(context.Background())
+
+	// Assert
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), expected, lastModified)
+}
+
+func (suite *ProductControllerTestSuite) TestLastModified_UseCaseError() {
+	// Arrange
+	expectedError := errors.New("database error")
+
+	suite.mockLastModifiedUseCase.EXPECT().
+		Execute(mock.Anything, mock.Anything).
+		Return(time.Time{}, expectedError).
+		Once()
+
+	// Act
+	_, err := suite.productController.LastModified(context.Background())
+
+	// Assert
+	assert.Equal(suite.T(), expectedError, err)
+}
@@ -0,0 +1,115 @@
+package domainerrors
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// Sentinel errors describing the category of a domain failure.
+// Use errors.Is to test for them; the typed errors below all match one.
+var (
+	ErrNotFound           = errors.New("resource not found")
+	ErrInvalidArgument    = errors.New("invalid argument")
+	ErrValidation         = errors.New("validation failed")
+	ErrConflict           = errors.New("resource conflict")
+	ErrPreconditionFailed = errors.New("precondition failed")
+)
+
+// NotFoundError reports that the requested resource does not exist.
+type NotFoundError struct {
+	Resource string
+	ID       uint
+}
+
+func NewNotFoundError(resource string, id uint) *NotFoundError {
+	return &NotFoundError{Resource: resource, ID: id}
+}
+
+func (e *NotFoundError) Error() string {
+	return fmt.Sprintf("%s %d not found", e.Resource, e.ID)
+}
+
+func (e *NotFoundError) Is(target error) bool {
+	return target == ErrNotFound
+}
+
+// InvalidArgumentError reports a malformed input that could not be interpreted.
+type InvalidArgumentError struct {
+	Message string
+}
+
+func NewInvalidArgumentError(message string) *InvalidArgumentError {
+	return &InvalidArgumentError{Message: message}
+}
+
+func (e *InvalidArgumentError) Error() string {
+	return e.Message
+}
+
+func (e *InvalidArgumentError) Is(target error) bool {
+	return target == ErrInvalidArgument
+}
+
+// FieldError describes why a single field was rejected.
+type FieldError struct {
+	Field   string
+	Message string
+}
+
+// ValidationError reports well-formed input that breaks one or more business rules.
+type ValidationError struct {
+	Fields []FieldError
+}
+
+func NewValidationError(fields ...FieldError) *ValidationError {
+	return &ValidationError{Fields: fields}
+}
+
+func (e *ValidationError) Error() string {
+	messages := make([]string, len(e.Fields))
+	for i, field := range e.Fields {
+		messages[i] = field.Field + ": " + field.Message
+	}
+	return "validation failed: " + strings.Join(messages, "; ")
+}
+
+func (e *ValidationError) Is(target error) bool {
+	return target == ErrValidation
+}
+
+// ConflictError reports that the operation clashes with the current state of a resource.
+type ConflictError struct {
+	Message string
+}
+
+func NewConflictError(message string) *ConflictError {
+	return &ConflictError{Message: message}
+}
+
+func (e *ConflictError) Error() string {
+	return e.Message
+}
+
+func (e *ConflictError) Is(target error) bool {
+	return target == ErrConflict
+}
+
+// PreconditionFailedError reports that the resource changed since the
+// version the caller based its write on.
+type PreconditionFailedError struct {
+	Resource string
+	ID       uint
+}
+
+func NewPreconditionFailedError(resource string, id uint) *PreconditionFailedError {
+	return &PreconditionFailedError{Resource: resource, ID: id}
+}
+
+func (e *PreconditionFailedError) Error() string {
+	return fmt.Sprintf("%s %d has been modified", e.Resource, e.ID)
+}
+
+func (e *PreconditionFailedError) Is(target error) bool {
+	return target == ErrPreconditionFailed
+}
@@ -0,0 +1,73 @@
+package domainerrors_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/mathefer/tc-fiap-product/internal/product/domain/domainerrors"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNotFoundError(t *testing.T) {
+	// Arrange
+	err := domainerrors.NewNotFoundError("product", 42)
+
+	// Assert
+	assert.Equal(t, "product 42 not found", err.Error())
+	assert.ErrorIs(t, err, domainerrors.ErrNotFound)
+	assert.NotErrorIs(t, err, domainerrors.ErrConflict)
+}
+
+func TestNotFoundError_Wrapped(t *testing.T) {
+	// Arrange
+	err := fmt.Errorf("loading product: %w", domainerrors.NewNotFoundError("product", 1))
+
+	// Assert
+	assert.ErrorIs(t, err, domainerrors.ErrNotFound)
+
+	var notFound *domainerrors.NotFoundError
+	assert.True(t, errors.As(err, &notFound))
+	assert.Equal(t, uint(1), notFound.ID)
+}
+
+func TestInvalidArgumentError(t *testing.T) {
+	// Arrange
+	err := domainerrors.NewInvalidArgumentError("invalid sort field")
+
+	// Assert
+	assert.Equal(t, "invalid sort field", err.Error())
+	assert.ErrorIs(t, err, domainerrors.ErrInvalidArgument)
+}
+
+func TestValidationError(t *testing.T) {
+	// Arrange
+	err := domainerrors.NewValidationError(
+		domainerrors.FieldError{Field: "name", Message: "is required"},
+		domainerrors.FieldError{Field: "price", Message: "must be greater than zero"},
+	)
+
+	// Assert
+	assert.Equal(t, "validation failed: name: is required; price: must be greater than zero", err.Error())
+	assert.ErrorIs(t, err, domainerrors.ErrValidation)
+	assert.Len(t, err.Fields, 2)
+}
+
+func TestConflictError(t *testing.T) {
+	// Arrange
+	err := domainerrors.NewConflictError("product already exists")
+
+	// Assert
+	assert.Equal(t, "product already exists", err.Error())
+	assert.ErrorIs(t, err, domainerrors.ErrConflict)
+}
+
+func TestPreconditionFailedError(t *testing.T) {
+	// Arrange
+	err := domainerrors.NewPreconditionFailedError("product", 7)
+
+	// Assert
+	assert.Equal(t, "product 7 has been modified", err.Error())
+	assert.ErrorIs(t, err, domainerrors.ErrPreconditionFailed)
+	assert.NotErrorIs(t, err, domainerrors.ErrConflict)
+}
@@ -0,0 +1,67 @@
+package entities
+
+import (
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/mathefer/tc-fiap-product/internal/product/domain/domainerrors"
+)
+
+const (
+	CategoryLanche         = 1
+	CategoryAcompanhamento = 2
+	CategoryBebida         = 3
+	CategorySobremesa      = 4
+)
+
+// maxCategoryNameLength matches the size of the category display_name column.
+const maxCategoryNameLength = 100
+
+type Category struct {
+	ID          uint      `gorm:"primaryKey"`
+	CreatedAt   time.Time `gorm:"default:current_timestamp"`
+	DisplayName string    `gorm:"size:100;not null;uniqueIndex"`
+	SortOrder   int       `gorm:"not null"`
+	Active      bool      `gorm:"not null"`
+}
+
+func (Category) TableName() string {
+	return "category"
+}
+
+// DefaultCategories are the categories the service has always offered. They
+// are seeded on first start so existing products keep a valid category.
+func DefaultCategories() []*Category {
+	return []*Category{
+		{ID: CategoryLanche, DisplayName: "Lanche", SortOrder: 1, Active: true},
+		{ID: CategoryAcompanhamento, DisplayName: "Acompanhamento", SortOrder: 2, Active: true},
+		{ID: CategoryBebida, DisplayName: "Bebida", SortOrder: 3, Active: true},
+		{ID: CategorySobremesa, DisplayName: "Sobremesa", SortOrder: 4, Active: true},
+	}
+}
+
+// Validate checks the category against the business rules and returns a
+// *domainerrors.ValidationError listing every violation, or nil.
+func (c *Category) Validate() error {
+	var fields []domainerrors.FieldError
+	violation := func(field, message string) {
+		fields = append(fields, domainerrors.FieldError{Field: field, Message: message})
+	}
+
+	switch {
+	case strings.TrimSpace(c.DisplayName) == "":
+		violation("display_name", "is required")
+	case utf8.RuneCountInString(c.DisplayName) > maxCategoryNameLength:
+		violation("display_name", "must be at most 100 characters")
+	}
+
+	if c.SortOrder < 0 {
+		violation("sort_order", "must not be negative")
+	}
+
+	if len(fields) > 0 {
+		return domainerrors.NewValidationError(fields...)
+	}
+	return nil
+}
@@ -0,0 +1,62 @@
+package entities_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/mathefer/tc-fiap-product/internal/product/domain/domainerrors"
+	"github.com/mathefer/tc-fiap-product/internal/product/domain/entities"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCategory_TableName(t *testing.T) {
+	assert.Equal(t, "category", entities.Category{}.TableName())
+}
+
+func TestCategory_Validate_Valid(t *testing.T) {
+	// Arrange
+	category := entities.Category{DisplayName: "Lanche", SortOrder: 1, Active: true}
+
+	// Act
+	err := category.Validate()
+
+	// Assert
+	assert.NoError(t, err)
+}
+
+func TestCategory_Validate_ReportsAllViolations(t *testing.T) {
+	// Arrange
+	category := entities.Category{DisplayName: "  ", SortOrder: -1}
+
+	// Act
+	err := category.Validate()
+
+	// Assert
+	assert.ErrorIs(t, err, domainerrors.ErrValidation)
+	assert.Equal(t, []string{"display_name", "sort_order"}, fieldNames(t, err))
+}
+
+func TestCategory_Validate_DisplayNameTooLong(t *testing.T) {
+	// Arrange
+	category := entities.Category{DisplayName: strings.Repeat("a", 101)}
+
+	// Act
+	err := category.Validate()
+
+	// Assert
+	assert.Equal(t, []string{"display_name"}, fieldNames(t, err))
+}
+
+func TestDefaultCategories(t *testing.T) {
+	// Act
+	categories := entities.DefaultCategories()
+
+	// Assert
+	assert.Len(t, categories, 4)
+	for i, category := range categories {
+		assert.Equal(t, uint(i+1), category.ID)
+		assert.True(t, category.Active)
+		assert.NoError(t, category.Validate())
+	}
+	assert.Equal(t, "Sobremesa", categories[3].DisplayName)
+}
@@ -0,0 +1,32 @@
+package entities
+
+import "time"
+
+// IdempotencyKey remembers a request sent with an Idempotency-Key header so
+// that repeating it replays the first response instead of running again.
+// StatusCode stays zero while the first request is still being processed.
+type IdempotencyKey struct {
+	Key          string `gorm:"primaryKey;size:255"`
+	RequestHash  string `gorm:"size:64;not null"`
+	StatusCode   int    `gorm:"not null;default:0"`
+	ContentType  string `gorm:"size:255;not null;default:''"`
+	Location     string `gorm:"size:255;not null;default:''"`
+	ETag         string `gorm:"column:etag;size:255;not null;default:''"`
+	ResponseBody []byte
+	CreatedAt    time.Time `gorm:"not null;index"`
+}
+
+func (IdempotencyKey) TableName() string {
+	return "idempotency_key"
+}
+
+// Completed reports whether the response of the first request is stored.
+func (k *IdempotencyKey) Completed() bool {
+	return k.StatusCode != 0
+}
+
+// Expired reports whether the key is older than ttl at now, after which the
+// same key may be used for a new request.
+func (k *IdempotencyKey) Expired(now time.Time, ttl time.Duration) bool {
+	return !k.CreatedAt.Add(ttl).After(now)
+}
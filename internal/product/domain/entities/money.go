@@ -0,0 +1,97 @@
+package entities
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// DefaultCurrency is used when a price is given without a currency.
+const DefaultCurrency = "BRL"
+
+// minorUnitDigits is the number of decimal places of the supported currencies.
+const minorUnitDigits = 2
+
+// ErrInvalidAmount is returned by ParseMoney for amounts that are not a
+// decimal number with at most two decimal places.
+var ErrInvalidAmount = errors.New("must be a decimal number with at most two decimal places")
+
+// Money is an amount in integer minor units (cents) of an ISO 4217 currency,
+// so sums never suffer from floating point rounding.
+type Money struct {
+	Amount   int64  `gorm:"not null;default:0"`
+	Currency string `gorm:"size:3;not null;default:'BRL'"`
+}
+
+func NewMoney(amount int64, currency string) Money {
+	return Money{Amount: amount, Currency: currency}
+}
+
+// ParseMoney converts a decimal string such as "34.99" into minor units. An
+// empty currency defaults to DefaultCurrency.
+func ParseMoney(amount string, currency string) (Money, error) {
+	amount = strings.TrimSpace(amount)
+
+	negative := strings.HasPrefix(amount, "-")
+	amount = strings.TrimPrefix(amount, "-")
+
+	whole, fraction, _ := strings.Cut(amount, ".")
+	if (whole == "" && fraction == "") || len(fraction) > minorUnitDigits || !isDigits(whole) || !isDigits(fraction) {
+		return Money{}, ErrInvalidAmount
+	}
+
+	minor, err := strconv.ParseInt(whole+fraction+strings.Repeat("0", minorUnitDigits-len(fraction)), 10, 64)
+	if err != nil {
+		return Money{}, ErrInvalidAmount
+	}
+	if negative {
+		minor = -minor
+	}
+
+	currency = strings.ToUpper(strings.TrimSpace(currency))
+	if currency == "" {
+		currency = DefaultCurrency
+	}
+
+	return NewMoney(minor, currency), nil
+}
+
+// String formats the amount as a decimal string such as "34.99", without the
+// currency.
+func (m Money) String() string {
+	sign := ""
+	amount := m.Amount
+	if amount < 0 {
+		sign = "-"
+		amount = -amount
+	}
+	return fmt.Sprintf("%s%d.%02d", sign, amount/100, amount%100)
+}
+
+// Float64 returns the amount in major units. It only exists for the
+// deprecated numeric price of the API and must not be used for arithmetic.
+func (m Money) Float64() float64 {
+	return float64(m.Amount) / 100
+}
+
+func isDigits(value string) bool {
+	for _, r := range value {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+func isCurrencyCode(currency string) bool {
+	if len(currency) != 3 {
+		return false
+	}
+	for _, r := range currency {
+		if r < 'A' || r > 'Z' {
+			return false
+		}
+	}
+	return true
+}
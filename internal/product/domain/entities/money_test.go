@@ -0,0 +1,59 @@
+package entities_test
+
+import (
+	"testing"
+
+	"github.com/mathefer/tc-fiap-product/internal/product/domain/entities"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseMoney(t *testing.T) {
+	cases := map[string]int64{
+		"34.99":  3499,
+		"34.9":   3490,
+		"34":     3400,
+		"0.01":   1,
+		".5":     50,
+		" 10.00": 1000,
+		"-5":     -500,
+	}
+
+	for amount, expected := range cases {
+		// Act
+		money, err := entities.ParseMoney(amount, "")
+
+		// Assert
+		assert.NoError(t, err, "amount %q", amount)
+		assert.Equal(t, expected, money.Amount, "amount %q", amount)
+		assert.Equal(t, entities.DefaultCurrency, money.Currency)
+	}
+}
+
+func TestParseMoney_Invalid(t *testing.T) {
+	for _, amount := range []string{"", ".", "34.999", "0.005", "abc", "1e2", "12.3.4", "99999999999999999999"} {
+		// Act
+		_, err := entities.ParseMoney(amount, "BRL")
+
+		// Assert
+		assert.ErrorIs(t, err, entities.ErrInvalidAmount, "amount %q", amount)
+	}
+}
+
+func TestParseMoney_NormalizesCurrency(t *testing.T) {
+	// Act
+	money, err := entities.ParseMoney("10", " usd ")
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, entities.NewMoney(1000, "USD"), money)
+}
+
+func TestMoney_String(t *testing.T) {
+	assert.Equal(t, "34.99", entities.NewMoney(3499, "BRL").String())
+	assert.Equal(t, "0.05", entities.NewMoney(5, "BRL").String())
+	assert.Equal(t, "-1.50", entities.NewMoney(-150, "BRL").String())
+}
+
+func TestMoney_Float64(t *testing.T) {
+	assert.Equal(t, 34.99, entities.NewMoney(3499, "BRL").Float64())
+}
@@ -1,18 +1,99 @@
 package entities
 
-import "time"
+import (
+	"net/url"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/mathefer/tc-fiap-product/internal/product/domain/domainerrors"
+	"gorm.io/gorm"
+)
+
+// maxTextLength matches the size of the product text columns.
+const maxTextLength = 255
 
 type Product struct {
 	ID          uint      `gorm:"primaryKey"`
 	CreatedAt   time.Time `gorm:"default:current_timestamp"`
 	Name        string    `gorm:"size:255;not null"`
-	Category    int       `gorm:"not null"`
-	Price       float64   `gorm:"not null"`
+	Category    int       `gorm:"not null;index"`
+	Price       Money     `gorm:"embedded;embeddedPrefix:price_"`
 	Description string    `gorm:"size:255"`
 	ImageLink   string    `gorm:"size:255"`
+
+	// UpdatedAt is set by every write, including soft deletion and restore,
+	// and is exposed as Last-Modified.
+	UpdatedAt time.Time `gorm:"index"`
+
+	// Version starts at 1 and is incremented by every update. It is exposed
+	// as the ETag so that clients can update only the version they read.
+	Version uint `gorm:"not null;default:1"`
+
+	// DeletedAt marks the product as soft deleted. Deleted products keep
+	// their row so that orders referencing the ID can still resolve it by
+	// asking for deleted products too.
+	DeletedAt gorm.DeletedAt `gorm:"index"`
+
+	// CategoryRef only exists so that AutoMigrate, used by the tests, creates
+	// the foreign key the SQL migrations declare from product.category to
+	// category.id; it is never loaded.
+	CategoryRef *Category `gorm:"foreignKey:Category;constraint:OnUpdate:CASCADE,OnDelete:RESTRICT"`
 }
 
 func (Product) TableName() string {
 	return "product"
 }
 
+// Validate checks the product against the business rules and returns a
+// *domainerrors.ValidationError listing every violation, or nil. Whether the
+// category exists is checked by the use cases against the repository.
+func (p *Product) Validate() error {
+	var fields []domainerrors.FieldError
+	violation := func(field, message string) {
+		fields = append(fields, domainerrors.FieldError{Field: field, Message: message})
+	}
+
+	switch {
+	case strings.TrimSpace(p.Name) == "":
+		violation("name", "is required")
+	case utf8.RuneCountInString(p.Name) > maxTextLength:
+		violation("name", "must be at most 255 characters")
+	}
+
+	if p.Category <= 0 {
+		violation("category", "is required")
+	}
+
+	if p.Price.Amount <= 0 {
+		violation("price", "must be greater than zero")
+	}
+
+	if !isCurrencyCode(p.Price.Currency) {
+		violation("currency", "must be a three-letter ISO 4217 code")
+	}
+
+	if utf8.RuneCountInString(p.Description) > maxTextLength {
+		violation("description", "must be at most 255 characters")
+	}
+
+	switch {
+	case utf8.RuneCountInString(p.ImageLink) > maxTextLength:
+		violation("image_link", "must be at most 255 characters")
+	case p.ImageLink != "" && !isHTTPURL(p.ImageLink):
+		violation("image_link", "must be an absolute http or https URL")
+	}
+
+	if len(fields) > 0 {
+		return domainerrors.NewValidationError(fields...)
+	}
+	return nil
+}
+
+func isHTTPURL(raw string) bool {
+	parsed, err := url.ParseRequestURI(raw)
+	if err != nil {
+		return false
+	}
+	return (parsed.Scheme == "http" || parsed.Scheme == "https") && parsed.Host != ""
+}
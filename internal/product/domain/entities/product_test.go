@@ -1,9 +1,11 @@
 package entities_test
 
 import (
+	"strings"
 	"testing"
 	"time"
 
+	"github.com/mathefer/tc-fiap-product/internal/product/domain/domainerrors"
 	"github.com/mathefer/tc-fiap-product/internal/product/domain/entities"
 	"github.com/stretchr/testify/assert"
 )
@@ -27,7 +29,7 @@ func TestProduct_StructFields(t *testing.T) {
 		CreatedAt:   createdAt,
 		Name:        "Hamburguer",
 		Category:    1,
-		Price:       34.99,
+		Price:       entities.NewMoney(3499, "BRL"),
 		Description: "Hamburguer com salada",
 		ImageLink:   "https://example.com/image.jpg",
 	}
@@ -37,7 +39,7 @@ func TestProduct_StructFields(t *testing.T) {
 	assert.Equal(t, createdAt, product.CreatedAt)
 	assert.Equal(t, "Hamburguer", product.Name)
 	assert.Equal(t, 1, product.Category)
-	assert.Equal(t, 34.99, product.Price)
+	assert.Equal(t, entities.NewMoney(3499, "BRL"), product.Price)
 	assert.Equal(t, "Hamburguer com salada", product.Description)
 	assert.Equal(t, "https://example.com/image.jpg", product.ImageLink)
 }
@@ -55,3 +57,133 @@ func TestProduct_ZeroValues(t *testing.T) {
 	assert.Empty(t, product.Description)
 	assert.Empty(t, product.ImageLink)
 }
+
+func validProduct() entities.Product {
+	return entities.Product{
+		Name:        "Hamburguer",
+		Category:    entities.CategoryLanche,
+		Price:       entities.NewMoney(3499, "BRL"),
+		Description: "Hamburguer com salada",
+		ImageLink:   "https://example.com/image.jpg",
+	}
+}
+
+func fieldNames(t *testing.T, err error) []string {
+	var validationErr *domainerrors.ValidationError
+	if !assert.ErrorAs(t, err, &validationErr) {
+		return nil
+	}
+	names := make([]string, len(validationErr.Fields))
+	for i, field := range validationErr.Fields {
+		names[i] = field.Field
+	}
+	return names
+}
+
+func TestProduct_Validate_Success(t *testing.T) {
+	// Arrange
+	product := validProduct()
+
+	// Act
+	err := product.Validate()
+
+	// Assert
+	assert.NoError(t, err)
+}
+
+func TestProduct_Validate_OptionalFieldsEmpty(t *testing.T) {
+	// Arrange
+	product := validProduct()
+	product.Description = ""
+	product.ImageLink = ""
+
+	// Act
+	err := product.Validate()
+
+	// Assert
+	assert.NoError(t, err)
+}
+
+func TestProduct_Validate_ReportsAllViolations(t *testing.T) {
+	// Arrange
+	product := entities.Product{
+		Name:      "   ",
+		Category:  0,
+		Price:     entities.NewMoney(-100, "BRL"),
+		ImageLink: "not a url",
+	}
+
+	// Act
+	err := product.Validate()
+
+	// Assert
+	assert.ErrorIs(t, err, domainerrors.ErrValidation)
+	assert.Equal(t, []string{"name", "category", "price", "image_link"}, fieldNames(t, err))
+}
+
+func TestProduct_Validate_Price(t *testing.T) {
+	cases := map[int64]bool{
+		1:    true,
+		1000: true,
+		3499: true,
+		0:    false,
+		-500: false,
+	}
+
+	for amount, valid := range cases {
+		// Arrange
+		product := validProduct()
+		product.Price = entities.NewMoney(amount, entities.DefaultCurrency)
+
+		// Act
+		err := product.Validate()
+
+		// Assert
+		if valid {
+			assert.NoError(t, err, "price %v", amount)
+		} else {
+			assert.Equal(t, []string{"price"}, fieldNames(t, err), "price %v", amount)
+		}
+	}
+}
+
+func TestProduct_Validate_Currency(t *testing.T) {
+	for _, currency := range []string{"", "BR", "brl", "REAL"} {
+		// Arrange
+		product := validProduct()
+		product.Price = entities.NewMoney(3499, currency)
+
+		// Act
+		err := product.Validate()
+
+		// Assert
+		assert.Equal(t, []string{"currency"}, fieldNames(t, err), "currency %q", currency)
+	}
+}
+
+func TestProduct_Validate_Lengths(t *testing.T) {
+	// Arrange
+	long := strings.Repeat("a", 256)
+	product := validProduct()
+	product.Name = long
+	product.Description = long
+	product.ImageLink = "https://example.com/" + long
+
+	// Act
+	err := product.Validate()
+
+	// Assert
+	assert.Equal(t, []string{"name", "description", "image_link"}, fieldNames(t, err))
+}
+
+func TestProduct_Validate_ImageLinkScheme(t *testing.T) {
+	// Arrange
+	product := validProduct()
+	product.ImageLink = "ftp://example.com/image.jpg"
+
+	// Act
+	err := product.Validate()
+
+	// Assert
+	assert.Equal(t, []string{"image_link"}, fieldNames(t, err))
+}
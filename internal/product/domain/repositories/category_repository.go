@@ -0,0 +1,33 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+
+	"github.com/mathefer/tc-fiap-product/internal/product/domain/domainerrors"
+	"github.com/mathefer/tc-fiap-product/internal/product/domain/entities"
+)
+
+type CategoryRepository interface {
+	List(ctx context.Context, activeOnly bool) ([]*entities.Category, error)
+	GetByID(ctx context.Context, id uint) (*entities.Category, error)
+	Add(ctx context.Context, category *entities.Category) error
+	Update(ctx context.Context, category *entities.Category) error
+	Delete(ctx context.Context, id uint) error
+}
+
+// CheckCategory reports a validation error on the category field of a
+// product when the category does not exist or no longer accepts products.
+func CheckCategory(ctx context.Context, categories CategoryRepository, id int) error {
+	category, err := categories.GetByID(ctx, uint(id))
+	if errors.Is(err, domainerrors.ErrNotFound) {
+		return domainerrors.NewValidationError(domainerrors.FieldError{Field: "category", Message: "does not exist"})
+	}
+	if err != nil {
+		return err
+	}
+	if !category.Active {
+		return domainerrors.NewValidationError(domainerrors.FieldError{Field: "category", Message: "is not active"})
+	}
+	return nil
+}
@@ -0,0 +1,67 @@
+package repositories_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/mathefer/tc-fiap-product/internal/product/domain/domainerrors"
+	"github.com/mathefer/tc-fiap-product/internal/product/domain/entities"
+	"github.com/mathefer/tc-fiap-product/internal/product/domain/repositories"
+	mockRepositories "github.com/mathefer/tc-fiap-product/mocks/product/domain/repositories"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestCheckCategory_Active(t *testing.T) {
+	// Arrange
+	categories := mockRepositories.NewMockCategoryRepository(t)
+	categories.EXPECT().GetByID(mock.Anything, uint(1)).Return(&entities.Category{ID: 1, Active: true}, nil).Once()
+
+	// Act
+	err := repositories.CheckCategory(context.Background(), categories, 1)
+
+	// Assert
+	assert.NoError(t, err)
+}
+
+func TestCheckCategory_Missing(t *testing.T) {
+	// Arrange
+	categories := mockRepositories.NewMockCategoryRepository(t)
+	categories.EXPECT().GetByID(mock.Anything, uint(99)).Return(nil, domainerrors.NewNotFoundError("category", 99)).Once()
+
+	// Act
+	err := repositories.CheckCategory(context.Background(), categories, 99)
+
+	// Assert
+	var validationErr *domainerrors.ValidationError
+	assert.ErrorAs(t, err, &validationErr)
+	assert.Equal(t, []domainerrors.FieldError{{Field: "category", Message: "does not exist"}}, validationErr.Fields)
+}
+
+func TestCheckCategory_Inactive(t *testing.T) {
+	// Arrange
+	categories := mockRepositories.NewMockCategoryRepository(t)
+	categories.EXPECT().GetByID(mock.Anything, uint(2)).Return(&entities.Category{ID: 2}, nil).Once()
+
+	// Act
+	err := repositories.CheckCategory(context.Background(), categories, 2)
+
+	// Assert
+	var validationErr *domainerrors.ValidationError
+	assert.ErrorAs(t, err, &validationErr)
+	assert.Equal(t, []domainerrors.FieldError{{Field: "category", Message: "is not active"}}, validationErr.Fields)
+}
+
+func TestCheckCategory_RepositoryError(t *testing.T) {
+	// Arrange
+	categories := mockRepositories.NewMockCategoryRepository(t)
+	expectedError := errors.New("database connection error")
+	categories.EXPECT().GetByID(mock.Anything, uint(1)).Return(nil, expectedError).Once()
+
+	// Act
+	err := repositories.CheckCategory(context.Background(), categories, 1)
+
+	// Assert
+	assert.Equal(t, expectedError, err)
+}
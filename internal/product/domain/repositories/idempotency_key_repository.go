@@ -0,0 +1,29 @@
+package repositories
+
+import (
+	"context"
+	"time"
+
+	"github.com/mathefer/tc-fiap-product/internal/product/domain/entities"
+)
+
+// IdempotencyKeyRepository stores the keys of idempotent requests. Create is
+// the only way to claim a key, so of several concurrent requests with the
+// same key exactly one succeeds.
+type IdempotencyKeyRepository interface {
+	// Create claims key.Key, reporting domainerrors.ErrConflict when it is
+	// already taken.
+	Create(ctx context.Context, key *entities.IdempotencyKey) error
+	// GetByKey reports domainerrors.ErrNotFound for unknown keys.
+	GetByKey(ctx context.Context, key string) (*entities.IdempotencyKey, error)
+	// Complete stores the response of the request holding key.Key.
+	Complete(ctx context.Context, key *entities.IdempotencyKey) error
+	// Delete releases a key, so that the request can be retried.
+	Delete(ctx context.Context, key string) error
+	// DeleteAbandoned releases key when it is still in progress and was
+	// claimed before the given time, leaving a key claimed afresh alone.
+	DeleteAbandoned(ctx context.Context, key string, before time.Time) error
+	// DeleteExpired removes the keys created before the given time and
+	// returns how many were removed.
+	DeleteExpired(ctx context.Context, before time.Time) (int64, error)
+}
@@ -0,0 +1,34 @@
+package repositories
+
+import "time"
+
+const (
+	ProductSortByName      = "name"
+	ProductSortByPrice     = "price"
+	ProductSortByCreatedAt = "created_at"
+)
+
+// ProductListOptions describes which page of the product listing to load.
+// A zero Category lists every category and an empty SortBy orders by ID.
+// Soft-deleted products are only listed when IncludeDeleted is set. A page
+// starts After the given position when it is set, or else at Offset.
+type ProductListOptions struct {
+	Category       uint
+	SortBy         string
+	Descending     bool
+	IncludeDeleted bool
+	Limit          int
+	Offset         int
+	After          *ProductListPosition
+}
+
+// ProductListPosition is the place of a product in a sorted listing: its ID
+// and the value of the sort column, of which only the one matching SortBy is
+// used. Unlike an offset, it does not shift when products are added or
+// removed before it.
+type ProductListPosition struct {
+	ID          uint
+	Name        string
+	PriceAmount int64
+	CreatedAt   time.Time
+}
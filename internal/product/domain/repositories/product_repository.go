@@ -1,10 +1,32 @@
 package repositories
 
-import "github.com/mathefer/tc-fiap-product/internal/product/domain/entities"
+import (
+	"context"
+	"time"
+
+	"github.com/mathefer/tc-fiap-product/internal/product/domain/entities"
+)
 
 type ProductRepository interface {
-	Get(category uint) ([]*entities.Product, error)
-	Add(product *entities.Product) error
-	Update(product *entities.Product) error
-	Delete(id uint) error
+	Get(ctx context.Context, category uint, includeDeleted bool) ([]*entities.Product, error)
+	// GetByID and GetByIDs only find soft-deleted products with
+	// includeDeleted, so that orders can still resolve what they reference.
+	GetByID(ctx context.Context, id uint, includeDeleted bool) (*entities.Product, error)
+	GetByIDs(ctx context.Context, ids []uint, includeDeleted bool) ([]*entities.Product, error)
+	// GetByIDForUpdate reads a product that is about to be written. Unlike
+	// GetByID it is never answered from a cache, so the version it returns
+	// is the stored one. It does not lock the product.
+	GetByIDForUpdate(ctx context.Context, id uint) (*entities.Product, error)
+	List(ctx context.Context, options ProductListOptions) ([]*entities.Product, int64, error)
+	CountByCategory(ctx context.Context) (map[int]int64, error)
+	Add(ctx context.Context, product *entities.Product) error
+	// Update and Delete only apply to the given version of the product and
+	// report a *domainerrors.PreconditionFailedError when it has changed;
+	// version 0 applies to any version. Update increments the version.
+	Update(ctx context.Context, product *entities.Product) error
+	Delete(ctx context.Context, id uint, version uint) error
+	Restore(ctx context.Context, id uint) error
+	// LastModified returns the most recent UpdatedAt of any product,
+	// including deleted ones, or the zero time when there are none.
+	LastModified(ctx context.Context) (time.Time, error)
 }
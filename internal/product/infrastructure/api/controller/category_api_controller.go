@@ -0,0 +1,180 @@
+package controller
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+
+	"github.com/go-chi/chi/v5"
+	productController "github.com/mathefer/tc-fiap-product/internal/product/controller"
+	"github.com/mathefer/tc-fiap-product/internal/product/infrastructure/api/dto"
+	"github.com/mathefer/tc-fiap-product/pkg/rest"
+)
+
+const categoryResource = "category"
+
+type categoryApiController struct {
+	controller productController.CategoryController
+}
+
+func NewCategoryController(controller productController.CategoryController) *categoryApiController {
+	return &categoryApiController{
+		controller: controller,
+	}
+}
+
+func (c *categoryApiController) RegisterRoutes(r chi.Router) {
+	prefix := "/v1/category"
+	r.Get(prefix, c.List)
+	r.Get(prefix+"/{id}", c.GetByID)
+	r.Post(prefix, c.Add)
+	r.Put(prefix+"/{id}", c.Update)
+	r.Delete(prefix+"/{id}", c.Delete)
+}
+
+// @Summary     List categories
+// @Description List categories ordered by sort order
+// @Tags        Category
+// @Produce     json
+// @Param       active query bool false "Only active categories"
+// @Success     200  {array}  dto.GetCategoryResponseDto
+// @Failure     400  {object} rest.Problem
+// @Router      /v1/category [get]
+func (h *categoryApiController) List(w http.ResponseWriter, r *http.Request) {
+	activeOnly := false
+	if active := r.URL.Query().Get("active"); active != "" {
+		parsed, err := strconv.ParseBool(active)
+		if err != nil {
+			rest.WriteError(w, r, http.StatusBadRequest, "Invalid active parameter")
+			return
+		}
+		activeOnly = parsed
+	}
+
+	categories, err := h.controller.List(r.Context(), activeOnly)
+
+	if err != nil {
+		writeError(w, r, categoryResource, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(categories)
+}
+
+// @Summary     Get category by ID
+// @Description Get a single category by its ID
+// @Tags        Category
+// @Produce     json
+// @Param       id path uint true "Id"
+// @Success     200  {object} dto.GetCategoryResponseDto
+// @Failure     400  {object} rest.Problem
+// @Failure     404  {object} rest.Problem
+// @Router      /v1/category/{id} [get]
+func (h *categoryApiController) GetByID(w http.ResponseWriter, r *http.Request) {
+	id, err := getIDFromPath(r)
+	if err != nil {
+		rest.WriteError(w, r, http.StatusBadRequest, "Invalid parameter")
+		return
+	}
+
+	category, err := h.controller.GetByID(r.Context(), id)
+
+	if err != nil {
+		writeError(w, r, categoryResource, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(category)
+}
+
+// @Summary     Add category
+// @Description Add a category. Active defaults to true.
+// @Tags        Category
+// @Accept      json
+// @Produce     json
+// @Param       body body dto.AddCategoryRequestDto true "Category"
+// @Success     201
+// @Failure     400  {object} rest.Problem
+// @Failure     409  {object} rest.Problem
+// @Failure     422  {object} rest.Problem
+// @Router      /v1/category [post]
+func (h *categoryApiController) Add(w http.ResponseWriter, r *http.Request) {
+	var categoryRequest dto.AddCategoryRequestDto
+
+	if err := json.NewDecoder(r.Body).Decode(&categoryRequest); err != nil {
+		rest.WriteError(w, r, http.StatusBadRequest, "Invalid request payload")
+		return
+	}
+
+	err := h.controller.Add(r.Context(), &categoryRequest)
+
+	if err != nil {
+		writeError(w, r, categoryResource, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusCreated)
+}
+
+// @Summary     Update category
+// @Description Replace every field of the category
+// @Tags        Category
+// @Accept      json
+// @Produce     json
+// @Param       id path uint true "Id"
+// @Param       body body dto.UpdateCategoryRequestDto true "Category"
+// @Success     200
+// @Failure     404  {object} rest.Problem
+// @Failure     409  {object} rest.Problem
+// @Failure     422  {object} rest.Problem
+// @Router      /v1/category/{id} [put]
+func (h *categoryApiController) Update(w http.ResponseWriter, r *http.Request) {
+	id, err := getIDFromPath(r)
+	if err != nil {
+		rest.WriteError(w, r, http.StatusBadRequest, "Invalid parameter")
+		return
+	}
+
+	var categoryRequest dto.UpdateCategoryRequestDto
+
+	if err := json.NewDecoder(r.Body).Decode(&categoryRequest); err != nil {
+		rest.WriteError(w, r, http.StatusBadRequest, "Invalid request payload")
+		return
+	}
+
+	err = h.controller.Update(r.Context(), id, &categoryRequest)
+
+	if err != nil {
+		writeError(w, r, categoryResource, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
+
+// @Summary     Delete category
+// @Description Delete a category that no product uses
+// @Tags        Category
+// @Param       id path uint true "Id"
+// @Success     204
+// @Failure     404  {object} rest.Problem
+// @Failure     409  {object} rest.Problem
+// @Router      /v1/category/{id} [delete]
+func (h *categoryApiController) Delete(w http.ResponseWriter, r *http.Request) {
+	id, err := getIDFromPath(r)
+	if err != nil {
+		rest.WriteError(w, r, http.StatusBadRequest, "Invalid parameter")
+		return
+	}
+
+	err = h.controller.Delete(r.Context(), id)
+
+	if err != nil {
+		writeError(w, r, categoryResource, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
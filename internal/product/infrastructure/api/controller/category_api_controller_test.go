@@ -0,0 +1,229 @@
+package controller_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	apiController "github.com/mathefer/tc-fiap-product/internal/product/infrastructure/api/controller"
+	"github.com/mathefer/tc-fiap-product/internal/product/infrastructure/api/dto"
+	"github.com/mathefer/tc-fiap-product/internal/product/domain/domainerrors"
+	mockController "github.com/mathefer/tc-fiap-product/mocks/product/controller"
+)
+
+type CategoryApiControllerTestSuite struct {
+	suite.Suite
+	mockController *mockController.MockCategoryController
+	router         *chi.Mux
+}
+
+func (suite *CategoryApiControllerTestSuite) SetupTest() {
+	suite.mockController = mockController.NewMockCategoryController(suite.T())
+	apiCtrl := apiController.NewCategoryController(suite.mockController)
+	suite.router = chi.NewRouter()
+	apiCtrl.RegisterRoutes(suite.router)
+}
+
+func TestCategoryApiControllerTestSuite(t *testing.T) {
+	suite.Run(t, new(CategoryApiControllerTestSuite))
+}
+
+func (suite *CategoryApiControllerTestSuite) TestList_Success() {
+	// Arrange
+	expectedResponse := []*dto.GetCategoryResponseDto{
+		{ID: 1, DisplayName: "Lanche", SortOrder: 1, Active: true},
+	}
+
+	suite.mockController.EXPECT().
+		List(mock.Anything, true).
+		Return(expectedResponse, nil).
+		Once()
+
+	req := httptest.NewRequest(http.MethodGet, "/v1/category?active=true", nil)
+	w := httptest.NewRecorder()
+
+	// Act
+	suite.router.ServeHTTP(w, req)
+
+	// Assert
+	assert.Equal(suite.T(), http.StatusOK, w.Code)
+
+	var response []*dto.GetCategoryResponseDto
+	assert.NoError(suite.T(), json.NewDecoder(w.Body).Decode(&response))
+	assert.Equal(suite.T(), "Lanche", response[0].DisplayName)
+}
+
+func (suite *CategoryApiControllerTestSuite) TestList_InvalidActive() {
+	// Arrange
+	req := httptest.NewRequest(http.MethodGet, "/v1/category?active=maybe", nil)
+	w := httptest.NewRecorder()
+
+	// Act
+	suite.router.ServeHTTP(w, req)
+
+	// Assert
+	assert.Equal(suite.T(), http.StatusBadRequest, w.Code)
+	assert.Contains(suite.T(), w.Body.String(), "Invalid active parameter")
+}
+
+func (suite *CategoryApiControllerTestSuite) TestGetByID_NotFound() {
+	// Arrange
+	suite.mockController.EXPECT().
+		GetByID(mock.Anything, uint(99)).
+		Return(nil, domainerrors.NewNotFoundError("category", 99)).
+		Once()
+
+	req := httptest.NewRequest(http.MethodGet, "/v1/category/99", nil)
+	w := httptest.NewRecorder()
+
+	// Act
+	suite.router.ServeHTTP(w, req)
+
+	// Assert
+	assert.Equal(suite.T(), http.StatusNotFound, w.Code)
+	assert.Contains(suite.T(), w.Body.String(), "Category not found")
+}
+
+func (suite *CategoryApiControllerTestSuite) TestGetByID_InvalidID() {
+	// Arrange
+	req := httptest.NewRequest(http.MethodGet, "/v1/category/abc", nil)
+	w := httptest.NewRecorder()
+
+	// Act
+	suite.router.ServeHTTP(w, req)
+
+	// Assert
+	assert.Equal(suite.T(), http.StatusBadRequest, w.Code)
+}
+
+func (suite *CategoryApiControllerTestSuite) TestAdd_Success() {
+	// Arrange
+	suite.mockController.EXPECT().
+		Add(mock.Anything, &dto.AddCategoryRequestDto{DisplayName: "Combo", SortOrder: 5}).
+		Return(nil).
+		Once()
+
+	req := httptest.NewRequest(http.MethodPost, "/v1/category", bytes.NewBufferString(`{"display_name":"Combo","sort_order":5}`))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+
+	// Act
+	suite.router.ServeHTTP(w, req)
+
+	// Assert
+	assert.Equal(suite.T(), http.StatusCreated, w.Code)
+}
+
+func (suite *CategoryApiControllerTestSuite) TestAdd_ValidationError() {
+	// Arrange
+	suite.mockController.EXPECT().
+		Add(mock.Anything, mock.Anything).
+		Return(domainerrors.NewValidationError(domainerrors.FieldError{Field: "display_name", Message: "is required"})).
+		Once()
+
+	req := httptest.NewRequest(http.MethodPost, "/v1/category", bytes.NewBufferString(`{}`))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+
+	// Act
+	suite.router.ServeHTTP(w, req)
+
+	// Assert
+	assert.Equal(suite.T(), http.StatusUnprocessableEntity, w.Code)
+	assert.Contains(suite.T(), w.Body.String(), "The category has invalid fields")
+	assert.Contains(suite.T(), w.Body.String(), `"field":"display_name"`)
+}
+
+func (suite *CategoryApiControllerTestSuite) TestAdd_InvalidJSON() {
+	// Arrange
+	req := httptest.NewRequest(http.MethodPost, "/v1/category", bytes.NewBufferString(`{"display_name":`))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+
+	// Act
+	suite.router.ServeHTTP(w, req)
+
+	// Assert
+	assert.Equal(suite.T(), http.StatusBadRequest, w.Code)
+	assert.Contains(suite.T(), w.Body.String(), "Invalid request payload")
+}
+
+func (suite *CategoryApiControllerTestSuite) TestUpdate_Success() {
+	// Arrange
+	requestDto := &dto.UpdateCategoryRequestDto{DisplayName: "Combo", SortOrder: 6, Active: false}
+
+	suite.mockController.EXPECT().
+		Update(mock.Anything, uint(5), requestDto).
+		Return(nil).
+		Once()
+
+	body, _ := json.Marshal(requestDto)
+	req := httptest.NewRequest(http.MethodPut, "/v1/category/5", bytes.NewBuffer(body))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+
+	// Act
+	suite.router.ServeHTTP(w, req)
+
+	// Assert
+	assert.Equal(suite.T(), http.StatusOK, w.Code)
+}
+
+func (suite *CategoryApiControllerTestSuite) TestDelete_Success() {
+	// Arrange
+	suite.mockController.EXPECT().
+		Delete(mock.Anything, uint(5)).
+		Return(nil).
+		Once()
+
+	req := httptest.NewRequest(http.MethodDelete, "/v1/category/5", nil)
+	w := httptest.NewRecorder()
+
+	// Act
+	suite.router.ServeHTTP(w, req)
+
+	// Assert
+	assert.Equal(suite.T(), http.StatusNoContent, w.Code)
+}
+
+func (suite *CategoryApiControllerTestSuite) TestDelete_InUse() {
+	// Arrange
+	suite.mockController.EXPECT().
+		Delete(mock.Anything, uint(1)).
+		Return(domainerrors.NewConflictError("category is still used by products")).
+		Once()
+
+	req := httptest.NewRequest(http.MethodDelete, "/v1/category/1", nil)
+	w := httptest.NewRecorder()
+
+	// Act
+	suite.router.ServeHTTP(w, req)
+
+	// Assert
+	assert.Equal(suite.T(), http.StatusConflict, w.Code)
+	assert.Contains(suite.T(), w.Body.String(), "category is still used by products")
+}
+
+func (suite *CategoryApiControllerTestSuite) TestDelete_ControllerError() {
+	// Arrange
+	suite.mockController.EXPECT().
+		Delete(mock.Anything, uint(1)).
+		Return(errors.New("database error")).
+		Once()
+
+	req := httptest.NewRequest(http.MethodDelete, "/v1/category/1", nil)
+	w := httptest.NewRecorder()
+
+	// Act
+	suite.router.ServeHTTP(w, req)
+
+	// Assert
+	assert.Equal(suite.T(), http.StatusInternalServerError, w.Code)
+}
@@ -1,65 +1,279 @@
 package controller
 
 import (
+	"bytes"
 	"encoding/json"
+	"errors"
+	"mime"
 	"net/http"
+	"slices"
 	"strconv"
+	"strings"
+	"time"
 
 	"github.com/go-chi/chi/v5"
 	productController "github.com/mathefer/tc-fiap-product/internal/product/controller"
+	"github.com/mathefer/tc-fiap-product/internal/product/domain/domainerrors"
 	"github.com/mathefer/tc-fiap-product/internal/product/infrastructure/api/dto"
+	"github.com/mathefer/tc-fiap-product/internal/product/infrastructure/api/middleware"
+	"github.com/mathefer/tc-fiap-product/pkg/rest"
+)
+
+const (
+	productResource = "product"
+	productPath     = "/v1/product"
+
+	// maxLookupIDs bounds the number of IDs accepted by a single batch lookup.
+	maxLookupIDs = 100
+
+	defaultListLimit = 20
+	maxListLimit     = 100
+)
+
+var (
+	// pageParameters turn a request for a category into a paginated listing.
+	pageParameters = []string{"limit", "offset", "cursor", "sort", "order"}
+
+	listSortFields = map[string]bool{"": true, "name": true, "price": true, "created_at": true}
+	listSortOrders = map[string]bool{"": true, "asc": true, "desc": true}
 )
 
 type productApiController struct {
-	controller productController.ProductController
+	controller  productController.ProductController
+	idempotency *middleware.Idempotency
+	// requireIfMatch rejects writes to a product that do not name the
+	// version they replace with If-Match.
+	requireIfMatch bool
+	// cacheControl is sent with every successful product read.
+	cacheControl string
 }
 
-func NewProductController(controller productController.ProductController) *productApiController {
+func NewProductController(controller productController.ProductController, idempotency *middleware.Idempotency, requireIfMatch bool, cacheControl string) *productApiController {
 	return &productApiController{
-		controller: controller,
+		controller:     controller,
+		idempotency:    idempotency,
+		requireIfMatch: requireIfMatch,
+		cacheControl:   cacheControl,
 	}
 }
 
 func (c *productApiController) RegisterRoutes(r chi.Router) {
-	prefix := "/v1/product"
-	r.Get(prefix, c.Get)
-	r.Post(prefix, c.Add)
+	prefix := productPath
+	r.Get(prefix, c.List)
+	r.Get(prefix+"/{id}", c.GetByID)
+	r.With(c.idempotency.Handler).Post(prefix, c.Add)
+	r.Post(prefix+"/lookup", c.Lookup)
 	r.Put(prefix+"/{id}", c.Update)
+	r.Patch(prefix+"/{id}", c.Patch)
 	r.Delete(prefix+"/{id}", c.Delete)
+	r.Post(prefix+"/{id}/restore", c.Restore)
 }
 
-// @Summary     Get products by category
-// @Description Get products by category
+// @Summary     List products
+// @Description List products page by page, optionally filtered by category.
+// @Description Pass next_cursor from the previous response as cursor to fetch the following page.
+// @Description A request with only category (and include_deleted) returns every product of the category as an array, as before pagination existed.
 // @Tags        Product
 // @Accept      json
 // @Produce     json
-// @Param       category query uint true "Category"
-// @Success     200  {object} dto.GetProductResponseDto
+// @Param       category query uint   false "Category"
+// @Param       limit    query int    false "Page size (1-100, default 20)"
+// @Param       offset   query int    false "Number of products to skip"
+// @Param       cursor   query string false "Cursor returned as next_cursor (overrides offset)"
+// @Param       sort     query string false "Sort field" Enums(name, price, created_at)
+// @Param       order    query string false "Sort order" Enums(asc, desc)
+// @Param       include_deleted query bool false "Include soft-deleted products"
+// @Param       If-None-Match header string false "ETag of the copy held by the client"
+// @Param       If-Modified-Since header string false "Last-Modified of the copy held by the client"
+// @Success     200  {object} dto.ListProductsResponseDto
+// @Header      200  {string} ETag "Tag of the page, to send as If-None-Match"
+// @Header      200  {string} Last-Modified "When any product last changed"
+// @Success     304 "The client's copy is current"
 // @Router      /v1/product [get]
-// @Description Category values: 1 - Lanche, 2 - Acompanhamento, 3 - Bebida, 4 - Sobremesa
+// @Description Category is the ID of a category managed under /v1/category
+func (h *productApiController) List(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	if query.Has("category") && !slices.ContainsFunc(pageParameters, query.Has) {
+		h.Get(w, r)
+		return
+	}
+
+	listRequest := dto.ListProductsRequestDto{
+		Sort:  query.Get("sort"),
+		Order: query.Get("order"),
+		Limit: defaultListLimit,
+	}
+
+	if category := query.Get("category"); category != "" {
+		categoryInt, err := strconv.ParseUint(category, 10, 64)
+		if err != nil {
+			rest.WriteError(w, r, http.StatusBadRequest, "Invalid category parameter")
+			return
+		}
+		listRequest.Category = uint(categoryInt)
+	}
+
+	if limit := query.Get("limit"); limit != "" {
+		limitInt, err := strconv.Atoi(limit)
+		if err != nil || limitInt < 1 || limitInt > maxListLimit {
+			rest.WriteError(w, r, http.StatusBadRequest, "Invalid limit parameter")
+			return
+		}
+		listRequest.Limit = limitInt
+	}
+
+	if offset := query.Get("offset"); offset != "" {
+		offsetInt, err := strconv.Atoi(offset)
+		if err != nil || offsetInt < 0 {
+			rest.WriteError(w, r, http.StatusBadRequest, "Invalid offset parameter")
+			return
+		}
+		listRequest.Offset = offsetInt
+	}
+
+	if !listSortFields[listRequest.Sort] || !listSortOrders[listRequest.Order] {
+		rest.WriteError(w, r, http.StatusBadRequest, "Invalid sort parameter")
+		return
+	}
+
+	// A cursor is only valid for the order it was issued in, since it
+	// carries the value of the sort field.
+	if cursor := query.Get("cursor"); cursor != "" {
+		var after dto.ListProductsCursorDto
+		if err := rest.DecodeCursor(cursor, &after); err != nil || after.ID == 0 ||
+			after.Sort != listRequest.Sort || after.Order != listRequest.Order {
+			rest.WriteError(w, r, http.StatusBadRequest, "Invalid cursor parameter")
+			return
+		}
+		listRequest.After = &after
+	}
+
+	includeDeleted, err := parseIncludeDeleted(r)
+	if err != nil {
+		rest.WriteError(w, r, http.StatusBadRequest, "Invalid include_deleted parameter")
+		return
+	}
+	listRequest.IncludeDeleted = includeDeleted
+
+	lastModified, err := h.controller.LastModified(r.Context())
+	if err != nil {
+		writeError(w, r, productResource, err)
+		return
+	}
+
+	response, err := h.controller.List(r.Context(), &listRequest)
+
+	if err != nil {
+		writeError(w, r, productResource, err)
+		return
+	}
+
+	h.writeRead(w, r, response, "", lastModified)
+}
+
+// Get replies to a request for a whole category with every product in it,
+// as a bare array.
 func (h *productApiController) Get(w http.ResponseWriter, r *http.Request) {
 	category := r.URL.Query().Get("category")
 
-	if category == "" {
-		http.Error(w, "Invalid parameter", http.StatusBadRequest)
+	categoryInt, err := strconv.ParseUint(category, 10, 64)
+	if err != nil {
+		rest.WriteError(w, r, http.StatusBadRequest, "Invalid category parameter")
 		return
 	}
 
-	categoryInt, err := strconv.ParseUint(category, 10, 64)
+	includeDeleted, err := parseIncludeDeleted(r)
 	if err != nil {
-		http.Error(w, "Invalid category parameter", http.StatusBadRequest)
+		rest.WriteError(w, r, http.StatusBadRequest, "Invalid include_deleted parameter")
+		return
+	}
+
+	// Read before the products, so that a change made in between makes the
+	// listing look older than it is rather than newer.
+	lastModified, err := h.controller.LastModified(r.Context())
+	if err != nil {
+		writeError(w, r, productResource, err)
+		return
+	}
+
+	products, err := h.controller.Get(r.Context(), uint(categoryInt), includeDeleted)
+
+	if err != nil {
+		writeError(w, r, productResource, err)
+		return
+	}
+
+	h.writeRead(w, r, products, "", lastModified)
+}
+
+// @Summary     Get product by id
+// @Description Get product by id
+// @Tags        Product
+// @Accept      json
+// @Produce     json
+// @Param       id path uint true "Id"
+// @Param       include_deleted query bool false "Also find the product if it was soft-deleted"
+// @Param       If-None-Match header string false "ETag of the copy held by the client"
+// @Param       If-Modified-Since header string false "Last-Modified of the copy held by the client"
+// @Success     200  {object} dto.GetProductResponseDto
+// @Header      200  {string} ETag "Version of the product, to send as If-Match or If-None-Match"
+// @Header      200  {string} Last-Modified "When the product last changed"
+// @Success     304 "The client's copy is current"
+// @Failure     404  {object} rest.Problem
+// @Router      /v1/product/{id} [get]
+func (h *productApiController) GetByID(w http.ResponseWriter, r *http.Request) {
+	id, err := getIDFromPath(r)
+	if err != nil {
+		rest.WriteError(w, r, http.StatusBadRequest, "Invalid parameter")
+		return
+	}
+
+	includeDeleted, err := parseIncludeDeleted(r)
+	if err != nil {
+		rest.WriteError(w, r, http.StatusBadRequest, "Invalid include_deleted parameter")
+		return
+	}
+
+	product, err := h.controller.GetByID(r.Context(), id, includeDeleted)
+
+	if err != nil {
+		writeError(w, r, productResource, err)
+		return
+	}
+
+	h.writeRead(w, r, product, rest.EncodeETag(product.Version), product.UpdatedAt)
+}
+
+// @Summary     Lookup products by ids
+// @Description Get several products in a single request. IDs that do not exist are reported in missing_ids.
+// @Tags        Product
+// @Accept      json
+// @Produce     json
+// @Param       body body dto.LookupProductsRequestDto true "Body"
+// @Success     200  {object} dto.LookupProductsResponseDto
+// @Router      /v1/product/lookup [post]
+func (h *productApiController) Lookup(w http.ResponseWriter, r *http.Request) {
+	var lookupRequest dto.LookupProductsRequestDto
+
+	if err := json.NewDecoder(r.Body).Decode(&lookupRequest); err != nil {
+		rest.WriteError(w, r, http.StatusBadRequest, "Invalid request payload")
+		return
+	}
+
+	if len(lookupRequest.IDs) == 0 || len(lookupRequest.IDs) > maxLookupIDs {
+		rest.WriteError(w, r, http.StatusBadRequest, "Invalid ids parameter")
 		return
 	}
 
-	products, err := h.controller.Get(uint(categoryInt))
+	response, err := h.controller.Lookup(r.Context(), &lookupRequest)
 
 	if err != nil {
-		http.Error(w, "Error processing request", http.StatusInternalServerError)
+		writeError(w, r, productResource, err)
 		return
 	}
 
 	w.WriteHeader(http.StatusOK)
-	json.NewEncoder(w).Encode(products)
+	json.NewEncoder(w).Encode(response)
 }
 
 // @Summary     Add product
@@ -67,87 +281,307 @@ func (h *productApiController) Get(w http.ResponseWriter, r *http.Request) {
 // @Tags        Product
 // @Accept      json
 // @Produce     json
+// @Param       Idempotency-Key header string false "Makes the request safe to retry; repeats replay the first response"
 // @Param       body body dto.AddProductRequestDto true "Body"
-// @Success     201
+// @Success     201  {object} dto.GetProductResponseDto
+// @Header      201  {string} Location "/v1/product/{id}"
+// @Header      201  {string} ETag "Version of the product, to send as If-Match"
+// @Failure     409  {object} rest.Problem "Conflicting product, or a request with the same Idempotency-Key still running"
+// @Failure     422  {object} rest.Problem "Invalid fields, or an Idempotency-Key reused with a different body"
 // @Router      /v1/product [post]
-// @Description Category values: 1 - Lanche, 2 - Acompanhamento, 3 - Bebida, 4 - Sobremesa
+// @Description Category is the ID of a category managed under /v1/category
 func (h *productApiController) Add(w http.ResponseWriter, r *http.Request) {
 	var productRequest dto.AddProductRequestDto
 
 	if err := json.NewDecoder(r.Body).Decode(&productRequest); err != nil {
-		http.Error(w, "Invalid request payload", http.StatusBadRequest)
+		rest.WriteError(w, r, http.StatusBadRequest, "Invalid request payload")
 		return
 	}
 
-	err := h.controller.Add(&productRequest)
+	product, err := h.controller.Add(r.Context(), &productRequest)
 
 	if err != nil {
-		http.Error(w, "Error processing request", http.StatusInternalServerError)
+		writeError(w, r, productResource, err)
 		return
 	}
 
+	w.Header().Set("Location", productLocation(product.ID))
+	w.Header().Set("ETag", rest.EncodeETag(product.Version))
 	w.WriteHeader(http.StatusCreated)
+	json.NewEncoder(w).Encode(product)
 }
 
 // @Summary     Update product
-// @Description Update product
+// @Description Replace every field of the product. Omitted optional fields are cleared.
+// @Description With If-Match the product is only replaced if it is still at that version.
 // @Tags        Product
 // @Accept      json
 // @Produce     json
 // @Param       id path uint true "Id"
+// @Param       If-Match header string false "ETag of the version being replaced"
 // @Param       name body dto.UpdateProductRequestDto true "Name"
-// @Success     200
+// @Success     200  {object} dto.GetProductResponseDto
+// @Header      200  {string} Location "/v1/product/{id}"
+// @Header      200  {string} ETag "New version of the product"
+// @Failure     404  {object} rest.Problem
+// @Failure     409  {object} rest.Problem
+// @Failure     412  {object} rest.Problem "The product changed since the If-Match version"
+// @Failure     422  {object} rest.Problem
+// @Failure     428  {object} rest.Problem "If-Match is required but missing"
 // @Router      /v1/product/{id} [put]
-// @Description Category values: 1 - Lanche, 2 - Acompanhamento, 3 - Bebida, 4 - Sobremesa
+// @Description Category is the ID of a category managed under /v1/category
 func (h *productApiController) Update(w http.ResponseWriter, r *http.Request) {
 	id, err := getIDFromPath(r)
 	if err != nil {
-		http.Error(w, "Invalid parameter", http.StatusBadRequest)
+		rest.WriteError(w, r, http.StatusBadRequest, "Invalid parameter")
+		return
+	}
+
+	version, ok := h.ifMatch(w, r)
+	if !ok {
 		return
 	}
 
 	var productRequest dto.UpdateProductRequestDto
 
 	if err := json.NewDecoder(r.Body).Decode(&productRequest); err != nil {
-		http.Error(w, "Invalid request payload", http.StatusBadRequest)
+		rest.WriteError(w, r, http.StatusBadRequest, "Invalid request payload")
+		return
+	}
+
+	product, err := h.controller.Update(r.Context(), id, version, &productRequest)
+
+	if err != nil {
+		writeError(w, r, productResource, err)
+		return
+	}
+
+	w.Header().Set("Location", productLocation(product.ID))
+	w.Header().Set("ETag", rest.EncodeETag(product.Version))
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(product)
+}
+
+// @Summary     Patch product
+// @Description Partially update a product using JSON Merge Patch (RFC 7396).
+// @Description Omitted fields are left untouched and null clears description or image_link.
+// @Description With If-Match the product is only patched if it is still at that version.
+// @Tags        Product
+// @Accept      application/merge-patch+json
+// @Produce     json
+// @Param       id path uint true "Id"
+// @Param       If-Match header string false "ETag of the version being patched"
+// @Param       body body dto.PatchProductRequestDto true "Merge patch"
+// @Success     200  {object} dto.GetProductResponseDto
+// @Header      200  {string} Location "/v1/product/{id}"
+// @Header      200  {string} ETag "New version of the product"
+// @Failure     404  {object} rest.Problem
+// @Failure     412  {object} rest.Problem "The product changed since the If-Match version"
+// @Failure     415  {object} rest.Problem
+// @Failure     422  {object} rest.Problem
+// @Failure     428  {object} rest.Problem "If-Match is required but missing"
+// @Router      /v1/product/{id} [patch]
+func (h *productApiController) Patch(w http.ResponseWriter, r *http.Request) {
+	id, err := getIDFromPath(r)
+	if err != nil {
+		rest.WriteError(w, r, http.StatusBadRequest, "Invalid parameter")
+		return
+	}
+
+	version, ok := h.ifMatch(w, r)
+	if !ok {
+		return
+	}
+
+	if !isMergePatchContentType(r.Header.Get("Content-Type")) {
+		rest.WriteError(w, r, http.StatusUnsupportedMediaType, "Content-Type must be application/merge-patch+json")
+		return
+	}
+
+	var productRequest dto.PatchProductRequestDto
+
+	if err := json.NewDecoder(r.Body).Decode(&productRequest); err != nil {
+		rest.WriteError(w, r, http.StatusBadRequest, "Invalid request payload")
 		return
 	}
 
-	err = h.controller.Update(id, &productRequest)
+	product, err := h.controller.Patch(r.Context(), id, version, &productRequest)
 
 	if err != nil {
-		http.Error(w, "Error processing request", http.StatusInternalServerError)
+		writeError(w, r, productResource, err)
 		return
 	}
 
+	w.Header().Set("Location", productLocation(product.ID))
+	w.Header().Set("ETag", rest.EncodeETag(product.Version))
 	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(product)
 }
 
 // @Summary     Delete product
-// @Description Delete product
+// @Description Soft delete a product. It is hidden from every endpoint until it is restored.
+// @Description With If-Match the product is only deleted if it is still at that version.
 // @Tags        Product
 // @Accept      json
 // @Produce     json
 // @Param       id path uint true "Id"
+// @Param       If-Match header string false "ETag of the version being deleted"
 // @Success     204
+// @Failure     404  {object} rest.Problem
+// @Failure     412  {object} rest.Problem "The product changed since the If-Match version"
+// @Failure     428  {object} rest.Problem "If-Match is required but missing"
 // @Router      /v1/product/{id} [delete]
 func (h *productApiController) Delete(w http.ResponseWriter, r *http.Request) {
 	id, err := getIDFromPath(r)
 	if err != nil {
-		http.Error(w, "Invalid parameter", http.StatusBadRequest)
+		rest.WriteError(w, r, http.StatusBadRequest, "Invalid parameter")
 		return
 	}
 
-	err = h.controller.Delete(id)
+	version, ok := h.ifMatch(w, r)
+	if !ok {
+		return
+	}
+
+	err = h.controller.Delete(r.Context(), id, version)
 
 	if err != nil {
-		http.Error(w, "Error processing request", http.StatusInternalServerError)
+		writeError(w, r, productResource, err)
 		return
 	}
 
 	w.WriteHeader(http.StatusNoContent)
 }
 
+// @Summary     Restore product
+// @Description Restore a soft-deleted product. Restoring a product that is not deleted has no effect.
+// @Tags        Product
+// @Accept      json
+// @Produce     json
+// @Param       id path uint true "Id"
+// @Success     200  {object} dto.GetProductResponseDto
+// @Failure     404  {object} rest.Problem
+// @Router      /v1/product/{id}/restore [post]
+func (h *productApiController) Restore(w http.ResponseWriter, r *http.Request) {
+	id, err := getIDFromPath(r)
+	if err != nil {
+		rest.WriteError(w, r, http.StatusBadRequest, "Invalid parameter")
+		return
+	}
+
+	product, err := h.controller.Restore(r.Context(), id)
+
+	if err != nil {
+		writeError(w, r, productResource, err)
+		return
+	}
+
+	w.Header().Set("ETag", rest.EncodeETag(product.Version))
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(product)
+}
+
+// writeError translates domain errors into problem responses with the
+// matching HTTP status code, naming resource in the detail. Anything that is
+// not a domain error is reported as an internal failure without leaking its
+// message.
+func writeError(w http.ResponseWriter, r *http.Request, resource string, err error) {
+	var validationErr *domainerrors.ValidationError
+
+	switch {
+	case errors.As(err, &validationErr):
+		problem := rest.NewProblem(http.StatusUnprocessableEntity, "The "+resource+" has invalid fields")
+		for _, field := range validationErr.Fields {
+			problem.Errors = append(problem.Errors, rest.FieldProblem{Field: field.Field, Message: field.Message})
+		}
+		rest.WriteProblem(w, r, problem)
+	case errors.Is(err, domainerrors.ErrNotFound):
+		rest.WriteError(w, r, http.StatusNotFound, strings.ToUpper(resource[:1])+resource[1:]+" not found")
+	case errors.Is(err, domainerrors.ErrInvalidArgument):
+		rest.WriteError(w, r, http.StatusBadRequest, err.Error())
+	case errors.Is(err, domainerrors.ErrConflict):
+		rest.WriteError(w, r, http.StatusConflict, err.Error())
+	case errors.Is(err, domainerrors.ErrPreconditionFailed):
+		rest.WriteError(w, r, http.StatusPreconditionFailed, strings.ToUpper(resource[:1])+resource[1:]+" has been modified; fetch it again and retry")
+	case errors.Is(err, domainerrors.ErrValidation):
+		rest.WriteError(w, r, http.StatusUnprocessableEntity, err.Error())
+	default:
+		rest.WriteError(w, r, http.StatusInternalServerError, "Error processing request")
+	}
+}
+
+// writeRead answers a read with body and its validators, or with 304 Not
+// Modified when the client's copy is current. Without an etag the tag is
+// derived from the encoded body.
+func (h *productApiController) writeRead(w http.ResponseWriter, r *http.Request, body any, etag string, lastModified time.Time) {
+	var encoded bytes.Buffer
+	if err := json.NewEncoder(&encoded).Encode(body); err != nil {
+		rest.WriteError(w, r, http.StatusInternalServerError, "Error processing request")
+		return
+	}
+	if etag == "" {
+		etag = rest.HashETag(encoded.Bytes())
+	}
+
+	header := w.Header()
+	header.Set("ETag", etag)
+	if !lastModified.IsZero() {
+		header.Set("Last-Modified", lastModified.UTC().Format(http.TimeFormat))
+	}
+	if h.cacheControl != "" {
+		header.Set("Cache-Control", h.cacheControl)
+	}
+
+	if rest.NotModified(r, etag, lastModified) {
+		w.WriteHeader(http.StatusNotModified)
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+	w.Write(encoded.Bytes())
+}
+
+// ifMatch returns the version named by the If-Match header, 0 meaning any.
+// It writes the error response and reports false when the header is
+// malformed, or missing while it is required.
+func (h *productApiController) ifMatch(w http.ResponseWriter, r *http.Request) (uint, bool) {
+	header := r.Header.Get("If-Match")
+	if header == "" && h.requireIfMatch {
+		rest.WriteError(w, r, http.StatusPreconditionRequired, "If-Match header is required")
+		return 0, false
+	}
+
+	version, err := rest.ParseIfMatch(header)
+	if err != nil {
+		rest.WriteError(w, r, http.StatusBadRequest, "Invalid If-Match header")
+		return 0, false
+	}
+	return version, true
+}
+
+// isMergePatchContentType accepts the RFC 7396 media type and, for
+// convenience, plain JSON.
+func isMergePatchContentType(contentType string) bool {
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return false
+	}
+	return mediaType == "application/merge-patch+json" || mediaType == "application/json"
+}
+
+// parseIncludeDeleted reads the optional include_deleted query parameter.
+func parseIncludeDeleted(r *http.Request) (bool, error) {
+	value := r.URL.Query().Get("include_deleted")
+	if value == "" {
+		return false, nil
+	}
+	return strconv.ParseBool(value)
+}
+
+// productLocation is the URL of the product with the given ID.
+func productLocation(id uint) string {
+	return productPath + "/" + strconv.FormatUint(uint64(id), 10)
+}
+
 func getIDFromPath(r *http.Request) (uint, error) {
 	vars := chi.URLParam(r, "id")
 	id, err := strconv.ParseUint(vars, 10, 64)
@@ -2,18 +2,26 @@ package controller_test
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"errors"
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
 	"github.com/go-chi/chi/v5"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/suite"
 	apiController "github.com/mathefer/tc-fiap-product/internal/product/infrastructure/api/controller"
 	"github.com/mathefer/tc-fiap-product/internal/product/infrastructure/api/dto"
+	"github.com/mathefer/tc-fiap-product/internal/product/infrastructure/api/middleware"
+	"github.com/mathefer/tc-fiap-product/internal/product/infrastructure/persistence"
+	"github.com/mathefer/tc-fiap-product/internal/product/domain/domainerrors"
 	mockController "github.com/mathefer/tc-fiap-product/mocks/product/controller"
+	"github.com/mathefer/tc-fiap-product/pkg/logging"
+	"github.com/mathefer/tc-fiap-product/pkg/rest"
 )
 
 type ProductApiControllerTestSuite struct {
@@ -24,7 +32,8 @@ type ProductApiControllerTestSuite struct {
 
 func (suite *ProductApiControllerTestSuite) SetupTest() {
 	suite.mockController = mockController.NewMockProductController(suite.T())
-	apiCtrl := apiController.NewProductController(suite.mockController)
+	idempotency := middleware.NewIdempotency(persistence.NewInMemoryIdempotencyKeyRepository(persistence.NewInMemoryStore()), time.Hour, time.Minute, logging.NewNop())
+	apiCtrl := apiController.NewProductController(suite.mockController, idempotency, false, "no-cache")
 	suite.router = chi.NewRouter()
 	apiCtrl.RegisterRoutes(suite.router)
 }
@@ -33,6 +42,15 @@ func TestProductApiControllerTestSuite(t *testing.T) {
 	suite.Run(t, new(ProductApiControllerTestSuite))
 }
 
+var lastModified = time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+
+func (suite *ProductApiControllerTestSuite) expectLastModified() {
+	suite.mockController.EXPECT().
+		LastModified(mock.Anything).
+		Return(lastModified, nil).
+		Once()
+}
+
 func (suite *ProductApiControllerTestSuite) TestGet_Success() {
 	// Arrange
 	category := "1"
@@ -47,8 +65,10 @@ func (suite *ProductApiControllerTestSuite) TestGet_Success() {
 		},
 	}
 
+	suite.expectLastModified()
+
 	suite.mockController.EXPECT().
-		Get(uint(1)).
+		Get(mock.Anything, uint(1), false).
 		Return(expectedResponse, nil).
 		Once()
 
@@ -69,9 +89,569 @@ func (suite *ProductApiControllerTestSuite) TestGet_Success() {
 	assert.Equal(suite.T(), expectedResponse[0].Name, response[0].Name)
 }
 
-func (suite *ProductApiControllerTestSuite) TestGet_InvalidCategory() {
+func (suite *ProductApiControllerTestSuite) TestGet_InvalidCategory() {
+	// Arrange
+	req := httptest.NewRequest(http.MethodGet, "/v1/product?category=invalid", nil)
+	w := httptest.NewRecorder()
+
+	// Act
+	suite.router.ServeHTTP(w, req)
+
+	// Assert
+	assert.Equal(suite.T(), http.StatusBadRequest, w.Code)
+	assert.Equal(suite.T(), rest.ProblemContentType, w.Header().Get("Content-Type"))
+	assert.Contains(suite.T(), w.Body.String(), "Invalid category parameter")
+}
+
+func (suite *ProductApiControllerTestSuite) TestGet_EmptyCategory() {
+	// Arrange
+	req := httptest.NewRequest(http.MethodGet, "/v1/product?category=", nil)
+	w := httptest.NewRecorder()
+
+	// Act
+	suite.router.ServeHTTP(w, req)
+
+	// Assert
+	assert.Equal(suite.T(), http.StatusBadRequest, w.Code)
+	assert.Contains(suite.T(), w.Body.String(), "Invalid category parameter")
+}
+
+func (suite *ProductApiControllerTestSuite) TestGet_ControllerError() {
+	// Arrange
+	category := "1"
+
+	suite.expectLastModified()
+
+	suite.mockController.EXPECT().
+		Get(mock.Anything, uint(1), false).
+		Return(nil, errors.New("database error")).
+		Once()
+
+	req := httptest.NewRequest(http.MethodGet, "/v1/product?category="+category, nil)
+	w := httptest.NewRecorder()
+
+	// Act
+	suite.router.ServeHTTP(w, req)
+
+	// Assert
+	assert.Equal(suite.T(), http.StatusInternalServerError, w.Code)
+	assert.Contains(suite.T(), w.Body.String(), "Error processing request")
+}
+
+func (suite *ProductApiControllerTestSuite) TestGet_NotFound() {
+	// Arrange
+	category := "999"
+
+	suite.expectLastModified()
+
+	suite.mockController.EXPECT().
+		Get(mock.Anything, uint(999), false).
+		Return([]*dto.GetProductResponseDto{}, nil).
+		Once()
+
+	req := httptest.NewRequest(http.MethodGet, "/v1/product?category="+category, nil)
+	w := httptest.NewRecorder()
+
+	// Act
+	suite.router.ServeHTTP(w, req)
+
+	// Assert
+	assert.Equal(suite.T(), http.StatusOK, w.Code)
+
+	var response []*dto.GetProductResponseDto
+	err := json.NewDecoder(w.Body).Decode(&response)
+	assert.NoError(suite.T(), err)
+	assert.Len(suite.T(), response, 0)
+}
+
+func (suite *ProductApiControllerTestSuite) TestGet_IncludeDeleted() {
+	// Arrange
+	suite.expectLastModified()
+
+	suite.mockController.EXPECT().
+		Get(mock.Anything, uint(1), true).
+		Return([]*dto.GetProductResponseDto{}, nil).
+		Once()
+
+	req := httptest.NewRequest(http.MethodGet, "/v1/product?category=1&include_deleted=true", nil)
+	w := httptest.NewRecorder()
+
+	// Act
+	suite.router.ServeHTTP(w, req)
+
+	// Assert
+	assert.Equal(suite.T(), http.StatusOK, w.Code)
+}
+
+func (suite *ProductApiControllerTestSuite) TestGet_CachingHeaders() {
+	// Arrange
+	suite.expectLastModified()
+
+	suite.mockController.EXPECT().
+		Get(mock.Anything, uint(1), false).
+		Return([]*dto.GetProductResponseDto{{ID: 1, Name: "Hamburguer"}}, nil).
+		Once()
+
+	req := httptest.NewRequest(http.MethodGet, "/v1/product?category=1", nil)
+	w := httptest.NewRecorder()
+
+	// Act
+	suite.router.ServeHTTP(w, req)
+
+	// Assert - the tag is derived from the body
+	assert.Equal(suite.T(), http.StatusOK, w.Code)
+	assert.Equal(suite.T(), rest.HashETag(w.Body.Bytes()), w.Header().Get("ETag"))
+	assert.Equal(suite.T(), "Mon, 01 Jan 2024 12:00:00 GMT", w.Header().Get("Last-Modified"))
+	assert.Equal(suite.T(), "no-cache", w.Header().Get("Cache-Control"))
+}
+
+func (suite *ProductApiControllerTestSuite) TestGet_IfNoneMatch() {
+	// Arrange
+	products := []*dto.GetProductResponseDto{{ID: 1, Name: "Hamburguer"}}
+	body, _ := json.Marshal(products)
+	etag := rest.HashETag(append(body, '\n'))
+
+	suite.expectLastModified()
+
+	suite.mockController.EXPECT().
+		Get(mock.Anything, uint(1), false).
+		Return(products, nil).
+		Once()
+
+	req := httptest.NewRequest(http.MethodGet, "/v1/product?category=1", nil)
+	req.Header.Set("If-None-Match", etag)
+	w := httptest.NewRecorder()
+
+	// Act
+	suite.router.ServeHTTP(w, req)
+
+	// Assert
+	assert.Equal(suite.T(), http.StatusNotModified, w.Code)
+	assert.Equal(suite.T(), etag, w.Header().Get("ETag"))
+	assert.Empty(suite.T(), w.Body.String())
+}
+
+func (suite *ProductApiControllerTestSuite) TestGet_LastModifiedError() {
+	// Arrange
+	suite.mockController.EXPECT().
+		LastModified(mock.Anything).
+		Return(time.Time{}, errors.New("database error")).
+		Once()
+
+	req := httptest.NewRequest(http.MethodGet, "/v1/product?category=1", nil)
+	w := httptest.NewRecorder()
+
+	// Act
+	suite.router.ServeHTTP(w, req)
+
+	// Assert
+	assert.Equal(suite.T(), http.StatusInternalServerError, w.Code)
+	assert.Empty(suite.T(), w.Header().Get("Cache-Control"))
+}
+
+func (suite *ProductApiControllerTestSuite) TestGet_InvalidIncludeDeleted() {
+	// Arrange
+	req := httptest.NewRequest(http.MethodGet, "/v1/product?category=1&include_deleted=maybe", nil)
+	w := httptest.NewRecorder()
+
+	// Act
+	suite.router.ServeHTTP(w, req)
+
+	// Assert
+	assert.Equal(suite.T(), http.StatusBadRequest, w.Code)
+	assert.Contains(suite.T(), w.Body.String(), "Invalid include_deleted parameter")
+}
+
+func (suite *ProductApiControllerTestSuite) TestList_Defaults() {
+	// Arrange
+	expectedResponse := &dto.ListProductsResponseDto{
+		Items: []*dto.GetProductResponseDto{{ID: 1, Name: "Hamburguer"}},
+		Total: 1,
+		Limit: 20,
+	}
+
+	suite.expectLastModified()
+
+	suite.mockController.EXPECT().
+		List(mock.Anything, &dto.ListProductsRequestDto{Limit: 20}).
+		Return(expectedResponse, nil).
+		Once()
+
+	req := httptest.NewRequest(http.MethodGet, "/v1/product", nil)
+	w := httptest.NewRecorder()
+
+	// Act
+	suite.router.ServeHTTP(w, req)
+
+	// Assert
+	assert.Equal(suite.T(), http.StatusOK, w.Code)
+
+	var response dto.ListProductsResponseDto
+	err := json.NewDecoder(w.Body).Decode(&response)
+	assert.NoError(suite.T(), err)
+	assert.Len(suite.T(), response.Items, 1)
+	assert.Equal(suite.T(), int64(1), response.Total)
+}
+
+func (suite *ProductApiControllerTestSuite) TestList_AllParameters() {
+	// Arrange
+	suite.expectLastModified()
+
+	suite.mockController.EXPECT().
+		List(mock.Anything, &dto.ListProductsRequestDto{Category: 2, Sort: "price", Order: "desc", IncludeDeleted: true, Limit: 5, Offset: 10}).
+		Return(&dto.ListProductsResponseDto{}, nil).
+		Once()
+
+	req := httptest.NewRequest(http.MethodGet, "/v1/product?category=2&sort=price&order=desc&include_deleted=true&limit=5&offset=10", nil)
+	w := httptest.NewRecorder()
+
+	// Act
+	suite.router.ServeHTTP(w, req)
+
+	// Assert
+	assert.Equal(suite.T(), http.StatusOK, w.Code)
+}
+
+func (suite *ProductApiControllerTestSuite) TestList_CategoryPage() {
+	// Arrange - a page parameter turns the category into a listing
+	suite.expectLastModified()
+
+	suite.mockController.EXPECT().
+		List(mock.Anything, &dto.ListProductsRequestDto{Category: 1, Limit: 5}).
+		Return(&dto.ListProductsResponseDto{}, nil).
+		Once()
+
+	req := httptest.NewRequest(http.MethodGet, "/v1/product?category=1&limit=5", nil)
+	w := httptest.NewRecorder()
+
+	// Act
+	suite.router.ServeHTTP(w, req)
+
+	// Assert
+	assert.Equal(suite.T(), http.StatusOK, w.Code)
+	assert.Contains(suite.T(), w.Body.String(), `"items"`)
+}
+
+func (suite *ProductApiControllerTestSuite) TestList_Cursor() {
+	// Arrange
+	after := &dto.ListProductsCursorDto{Sort: "name", ID: 40, Name: "Hamburguer"}
+	suite.expectLastModified()
+
+	suite.mockController.EXPECT().
+		List(mock.Anything, &dto.ListProductsRequestDto{Sort: "name", Limit: 20, Offset: 5, After: after}).
+		Return(&dto.ListProductsResponseDto{}, nil).
+		Once()
+
+	req := httptest.NewRequest(http.MethodGet, "/v1/product?sort=name&offset=5&cursor="+rest.EncodeCursor(after), nil)
+	w := httptest.NewRecorder()
+
+	// Act
+	suite.router.ServeHTTP(w, req)
+
+	// Assert
+	assert.Equal(suite.T(), http.StatusOK, w.Code)
+}
+
+func (suite *ProductApiControllerTestSuite) TestList_CursorOfAnotherOrder() {
+	// Arrange
+	cursor := rest.EncodeCursor(dto.ListProductsCursorDto{Sort: "name", ID: 1, Name: "Hamburguer"})
+	req := httptest.NewRequest(http.MethodGet, "/v1/product?sort=price&cursor="+cursor, nil)
+	w := httptest.NewRecorder()
+
+	// Act
+	suite.router.ServeHTTP(w, req)
+
+	// Assert
+	assert.Equal(suite.T(), http.StatusBadRequest, w.Code)
+	assert.Contains(suite.T(), w.Body.String(), "Invalid cursor parameter")
+}
+
+func (suite *ProductApiControllerTestSuite) TestList_InvalidParameters() {
+	cases := map[string]string{
+		"category=abc":          "Invalid category parameter",
+		"limit=0":               "Invalid limit parameter",
+		"limit=101":             "Invalid limit parameter",
+		"offset=-1":             "Invalid offset parameter",
+		"cursor=garbage!":       "Invalid cursor parameter",
+		"sort=description":      "Invalid sort parameter",
+		"order=up":              "Invalid sort parameter",
+		"include_deleted=maybe": "Invalid include_deleted parameter",
+	}
+
+	for query, message := range cases {
+		// Arrange
+		req := httptest.NewRequest(http.MethodGet, "/v1/product?"+query, nil)
+		w := httptest.NewRecorder()
+
+		// Act
+		suite.router.ServeHTTP(w, req)
+
+		// Assert
+		assert.Equal(suite.T(), http.StatusBadRequest, w.Code, query)
+		assert.Contains(suite.T(), w.Body.String(), message, query)
+	}
+}
+
+func (suite *ProductApiControllerTestSuite) TestList_IfModifiedSince() {
+	// Arrange
+	suite.expectLastModified()
+
+	suite.mockController.EXPECT().
+		List(mock.Anything, &dto.ListProductsRequestDto{Limit: 20}).
+		Return(&dto.ListProductsResponseDto{}, nil).
+		Once()
+
+	req := httptest.NewRequest(http.MethodGet, "/v1/product", nil)
+	req.Header.Set("If-Modified-Since", lastModified.Format(http.TimeFormat))
+	w := httptest.NewRecorder()
+
+	// Act
+	suite.router.ServeHTTP(w, req)
+
+	// Assert
+	assert.Equal(suite.T(), http.StatusNotModified, w.Code)
+	assert.Empty(suite.T(), w.Body.String())
+}
+
+func (suite *ProductApiControllerTestSuite) TestList_ControllerError() {
+	// Arrange
+	suite.expectLastModified()
+
+	suite.mockController.EXPECT().
+		List(mock.Anything, &dto.ListProductsRequestDto{Limit: 20}).
+		Return(nil, errors.New("database error")).
+		Once()
+
+	req := httptest.NewRequest(http.MethodGet, "/v1/product", nil)
+	w := httptest.NewRecorder()
+
+	// Act
+	suite.router.ServeHTTP(w, req)
+
+	// Assert
+	assert.Equal(suite.T(), http.StatusInternalServerError, w.Code)
+	assert.Contains(suite.T(), w.Body.String(), "Error processing request")
+}
+
+func (suite *ProductApiControllerTestSuite) TestGetByID_Success() {
+	// Arrange
+	id := "1"
+	expectedResponse := &dto.GetProductResponseDto{
+		ID:          1,
+		Name:        "Hamburguer",
+		Category:    1,
+		Price:       34.99,
+		Description: "Hamburguer com salada",
+		ImageLink:   "https://example.com/image.jpg",
+		Version:     4,
+	}
+
+	suite.mockController.EXPECT().
+		GetByID(mock.Anything, uint(1), false).
+		Return(expectedResponse, nil).
+		Once()
+
+	req := httptest.NewRequest(http.MethodGet, "/v1/product/"+id, nil)
+	w := httptest.NewRecorder()
+
+	// Act
+	suite.router.ServeHTTP(w, req)
+
+	// Assert
+	assert.Equal(suite.T(), http.StatusOK, w.Code)
+	assert.Equal(suite.T(), `W/"4"`, w.Header().Get("ETag"))
+
+	var response dto.GetProductResponseDto
+	err := json.NewDecoder(w.Body).Decode(&response)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), expectedResponse.ID, response.ID)
+	assert.Equal(suite.T(), expectedResponse.Name, response.Name)
+}
+
+func (suite *ProductApiControllerTestSuite) TestGetByID_NotModified() {
+	// Arrange
+	suite.mockController.EXPECT().
+		GetByID(mock.Anything, uint(1), false).
+		Return(&dto.GetProductResponseDto{ID: 1, Version: 4, UpdatedAt: lastModified}, nil).
+		Once()
+
+	req := httptest.NewRequest(http.MethodGet, "/v1/product/1", nil)
+	req.Header.Set("If-None-Match", `W/"4"`)
+	w := httptest.NewRecorder()
+
+	// Act
+	suite.router.ServeHTTP(w, req)
+
+	// Assert
+	assert.Equal(suite.T(), http.StatusNotModified, w.Code)
+	assert.Equal(suite.T(), `W/"4"`, w.Header().Get("ETag"))
+	assert.Equal(suite.T(), "Mon, 01 Jan 2024 12:00:00 GMT", w.Header().Get("Last-Modified"))
+	assert.Empty(suite.T(), w.Body.String())
+}
+
+func (suite *ProductApiControllerTestSuite) TestGetByID_PassesRequestContext() {
+	// Arrange
+	type key struct{}
+	fromRequest := mock.MatchedBy(func(ctx context.Context) bool {
+		return ctx.Value(key{}) == "request"
+	})
+	suite.mockController.EXPECT().
+		GetByID(fromRequest, uint(1), false).
+		Return(&dto.GetProductResponseDto{ID: 1}, nil).
+		Once()
+
+	req := httptest.NewRequest(http.MethodGet, "/v1/product/1", nil)
+	req = req.WithContext(context.WithValue(req.Context(), key{}, "request"))
+	w := httptest.NewRecorder()
+
+	// Act
+	suite.router.ServeHTTP(w, req)
+
+	// Assert
+	assert.Equal(suite.T(), http.StatusOK, w.Code)
+}
+
+func (suite *ProductApiControllerTestSuite) TestGetByID_InvalidID() {
+	// Arrange
+	req := httptest.NewRequest(http.MethodGet, "/v1/product/invalid", nil)
+	w := httptest.NewRecorder()
+
+	// Act
+	suite.router.ServeHTTP(w, req)
+
+	// Assert
+	assert.Equal(suite.T(), http.StatusBadRequest, w.Code)
+	assert.Contains(suite.T(), w.Body.String(), "Invalid parameter")
+}
+
+func (suite *ProductApiControllerTestSuite) TestGetByID_IncludeDeleted() {
+	// Arrange
+	suite.mockController.EXPECT().
+		GetByID(mock.Anything, uint(4), true).
+		Return(&dto.GetProductResponseDto{ID: 4, Name: "Milk-shake"}, nil).
+		Once()
+
+	req := httptest.NewRequest(http.MethodGet, "/v1/product/4?include_deleted=true", nil)
+	w := httptest.NewRecorder()
+
+	// Act
+	suite.router.ServeHTTP(w, req)
+
+	// Assert
+	assert.Equal(suite.T(), http.StatusOK, w.Code)
+	assert.Contains(suite.T(), w.Body.String(), `"name":"Milk-shake"`)
+}
+
+func (suite *ProductApiControllerTestSuite) TestGetByID_InvalidIncludeDeleted() {
+	// Arrange
+	req := httptest.NewRequest(http.MethodGet, "/v1/product/4?include_deleted=maybe", nil)
+	w := httptest.NewRecorder()
+
+	// Act
+	suite.router.ServeHTTP(w, req)
+
+	// Assert
+	assert.Equal(suite.T(), http.StatusBadRequest, w.Code)
+	assert.Contains(suite.T(), w.Body.String(), "Invalid include_deleted parameter")
+}
+
+func (suite *ProductApiControllerTestSuite) TestGetByID_NotFound() {
+	// Arrange
+	suite.mockController.EXPECT().
+		GetByID(mock.Anything, uint(999), false).
+		Return(nil, domainerrors.NewNotFoundError("product", 999)).
+		Once()
+
+	req := httptest.NewRequest(http.MethodGet, "/v1/product/999", nil)
+	w := httptest.NewRecorder()
+
+	// Act
+	suite.router.ServeHTTP(w, req)
+
+	// Assert
+	assert.Equal(suite.T(), http.StatusNotFound, w.Code)
+	assert.Equal(suite.T(), rest.ProblemContentType, w.Header().Get("Content-Type"))
+
+	var problem rest.Problem
+	err := json.NewDecoder(w.Body).Decode(&problem)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), "Not Found", problem.Title)
+	assert.Equal(suite.T(), "Product not found", problem.Detail)
+	assert.Equal(suite.T(), "/v1/product/999", problem.Instance)
+}
+
+func (suite *ProductApiControllerTestSuite) TestGetByID_ControllerError() {
+	// Arrange
+	suite.mockController.EXPECT().
+		GetByID(mock.Anything, uint(1), false).
+		Return(nil, errors.New("database error")).
+		Once()
+
+	req := httptest.NewRequest(http.MethodGet, "/v1/product/1", nil)
+	w := httptest.NewRecorder()
+
+	// Act
+	suite.router.ServeHTTP(w, req)
+
+	// Assert
+	assert.Equal(suite.T(), http.StatusInternalServerError, w.Code)
+	assert.Contains(suite.T(), w.Body.String(), "Error processing request")
+}
+
+func (suite *ProductApiControllerTestSuite) TestLookup_Success() {
+	// Arrange
+	requestDto := &dto.LookupProductsRequestDto{IDs: []uint{1, 2}}
+	expectedResponse := &dto.LookupProductsResponseDto{
+		Products:   []*dto.GetProductResponseDto{{ID: 1, Name: "Hamburguer", Category: 1, Price: 34.99}},
+		MissingIDs: []uint{2},
+	}
+
+	suite.mockController.EXPECT().
+		Lookup(mock.Anything, requestDto).
+		Return(expectedResponse, nil).
+		Once()
+
+	body, _ := json.Marshal(requestDto)
+	req := httptest.NewRequest(http.MethodPost, "/v1/product/lookup", bytes.NewBuffer(body))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+
+	// Act
+	suite.router.ServeHTTP(w, req)
+
+	// Assert
+	assert.Equal(suite.T(), http.StatusOK, w.Code)
+
+	var response dto.LookupProductsResponseDto
+	err := json.NewDecoder(w.Body).Decode(&response)
+	assert.NoError(suite.T(), err)
+	assert.Len(suite.T(), response.Products, 1)
+	assert.Equal(suite.T(), []uint{2}, response.MissingIDs)
+}
+
+func (suite *ProductApiControllerTestSuite) TestLookup_EmptyIDs() {
+	// Arrange
+	req := httptest.NewRequest(http.MethodPost, "/v1/product/lookup", bytes.NewBuffer([]byte(`{"ids":[]}`)))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+
+	// Act
+	suite.router.ServeHTTP(w, req)
+
+	// Assert
+	assert.Equal(suite.T(), http.StatusBadRequest, w.Code)
+	assert.Contains(suite.T(), w.Body.String(), "Invalid ids parameter")
+}
+
+func (suite *ProductApiControllerTestSuite) TestLookup_TooManyIDs() {
 	// Arrange
-	req := httptest.NewRequest(http.MethodGet, "/v1/product?category=invalid", nil)
+	ids := make([]uint, 101)
+	for i := range ids {
+		ids[i] = uint(i + 1)
+	}
+	body, _ := json.Marshal(dto.LookupProductsRequestDto{IDs: ids})
+	req := httptest.NewRequest(http.MethodPost, "/v1/product/lookup", bytes.NewBuffer(body))
+	req.Header.Set("Content-Type", "application/json")
 	w := httptest.NewRecorder()
 
 	// Act
@@ -79,12 +659,13 @@ func (suite *ProductApiControllerTestSuite) TestGet_InvalidCategory() {
 
 	// Assert
 	assert.Equal(suite.T(), http.StatusBadRequest, w.Code)
-	assert.Contains(suite.T(), w.Body.String(), "Invalid category parameter")
+	assert.Contains(suite.T(), w.Body.String(), "Invalid ids parameter")
 }
 
-func (suite *ProductApiControllerTestSuite) TestGet_MissingCategory() {
+func (suite *ProductApiControllerTestSuite) TestLookup_InvalidJSON() {
 	// Arrange
-	req := httptest.NewRequest(http.MethodGet, "/v1/product", nil)
+	req := httptest.NewRequest(http.MethodPost, "/v1/product/lookup", bytes.NewBuffer([]byte(`{"ids": [1,`)))
+	req.Header.Set("Content-Type", "application/json")
 	w := httptest.NewRecorder()
 
 	// Act
@@ -92,19 +673,21 @@ func (suite *ProductApiControllerTestSuite) TestGet_MissingCategory() {
 
 	// Assert
 	assert.Equal(suite.T(), http.StatusBadRequest, w.Code)
-	assert.Contains(suite.T(), w.Body.String(), "Invalid parameter")
+	assert.Contains(suite.T(), w.Body.String(), "Invalid request payload")
 }
 
-func (suite *ProductApiControllerTestSuite) TestGet_ControllerError() {
+func (suite *ProductApiControllerTestSuite) TestLookup_ControllerError() {
 	// Arrange
-	category := "1"
+	requestDto := &dto.LookupProductsRequestDto{IDs: []uint{1}}
 
 	suite.mockController.EXPECT().
-		Get(uint(1)).
+		Lookup(mock.Anything, requestDto).
 		Return(nil, errors.New("database error")).
 		Once()
 
-	req := httptest.NewRequest(http.MethodGet, "/v1/product?category="+category, nil)
+	body, _ := json.Marshal(requestDto)
+	req := httptest.NewRequest(http.MethodPost, "/v1/product/lookup", bytes.NewBuffer(body))
+	req.Header.Set("Content-Type", "application/json")
 	w := httptest.NewRecorder()
 
 	// Act
@@ -115,43 +698,113 @@ func (suite *ProductApiControllerTestSuite) TestGet_ControllerError() {
 	assert.Contains(suite.T(), w.Body.String(), "Error processing request")
 }
 
-func (suite *ProductApiControllerTestSuite) TestGet_NotFound() {
+func (suite *ProductApiControllerTestSuite) TestAdd_Success() {
 	// Arrange
-	category := "999"
+	requestDto := &dto.AddProductRequestDto{
+		Name:        "Pizza",
+		Category:    1,
+		Price:       "45.99",
+		Description: "Pizza margherita",
+		ImageLink:   "https://example.com/pizza.jpg",
+	}
 
 	suite.mockController.EXPECT().
-		Get(uint(999)).
-		Return([]*dto.GetProductResponseDto{}, nil).
+		Add(mock.Anything, requestDto).
+		Return(&dto.GetProductResponseDto{ID: 7, Name: "Pizza", Category: 1, Price: 45.99}, nil).
 		Once()
 
-	req := httptest.NewRequest(http.MethodGet, "/v1/product?category="+category, nil)
+	body, _ := json.Marshal(requestDto)
+	req := httptest.NewRequest(http.MethodPost, "/v1/product", bytes.NewBuffer(body))
+	req.Header.Set("Content-Type", "application/json")
 	w := httptest.NewRecorder()
 
 	// Act
 	suite.router.ServeHTTP(w, req)
 
 	// Assert
-	assert.Equal(suite.T(), http.StatusOK, w.Code)
+	assert.Equal(suite.T(), http.StatusCreated, w.Code)
+	assert.Equal(suite.T(), "/v1/product/7", w.Header().Get("Location"))
 
-	var response []*dto.GetProductResponseDto
-	err := json.NewDecoder(w.Body).Decode(&response)
-	assert.NoError(suite.T(), err)
-	assert.Len(suite.T(), response, 0)
+	var response dto.GetProductResponseDto
+	assert.NoError(suite.T(), json.NewDecoder(w.Body).Decode(&response))
+	assert.Equal(suite.T(), uint(7), response.ID)
+	assert.Equal(suite.T(), "Pizza", response.Name)
 }
 
-func (suite *ProductApiControllerTestSuite) TestAdd_Success() {
+func (suite *ProductApiControllerTestSuite) TestAdd_IdempotencyKeyReplaysResponse() {
 	// Arrange
-	requestDto := &dto.AddProductRequestDto{
-		Name:        "Pizza",
-		Category:    1,
-		Price:       45.99,
-		Description: "Pizza margherita",
-		ImageLink:   "https://example.com/pizza.jpg",
+	requestDto := &dto.AddProductRequestDto{Name: "Pizza", Category: 1, Price: "45.99"}
+
+	suite.mockController.EXPECT().
+		Add(mock.Anything, requestDto).
+		Return(&dto.GetProductResponseDto{ID: 7, Name: "Pizza", Category: 1, Price: 45.99}, nil).
+		Once()
+
+	body, _ := json.Marshal(requestDto)
+	send := func() *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodPost, "/v1/product", bytes.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set(middleware.IdempotencyKeyHeader, "order-42")
+		w := httptest.NewRecorder()
+		suite.router.ServeHTTP(w, req)
+		return w
+	}
+
+	// Act
+	first := send()
+	second := send()
+
+	// Assert - the product is created once
+	assert.Equal(suite.T(), http.StatusCreated, first.Code)
+	assert.Equal(suite.T(), http.StatusCreated, second.Code)
+	assert.Equal(suite.T(), "/v1/product/7", second.Header().Get("Location"))
+	assert.Equal(suite.T(), "true", second.Header().Get(middleware.IdempotentReplayedHeader))
+	assert.Equal(suite.T(), first.Body.String(), second.Body.String())
+}
+
+func (suite *ProductApiControllerTestSuite) TestAdd_DomainErrors() {
+	cases := []struct {
+		err    error
+		status int
+	}{
+		{domainerrors.NewInvalidArgumentError("invalid category"), http.StatusBadRequest},
+		{domainerrors.NewConflictError("product already exists"), http.StatusConflict},
+	}
+
+	for _, tc := range cases {
+		// Arrange
+		requestDto := &dto.AddProductRequestDto{Name: "Pizza", Category: 1, Price: "45.99"}
+
+		suite.mockController.EXPECT().
+			Add(mock.Anything, requestDto).
+			Return(nil, tc.err).
+			Once()
+
+		body, _ := json.Marshal(requestDto)
+		req := httptest.NewRequest(http.MethodPost, "/v1/product", bytes.NewBuffer(body))
+		req.Header.Set("Content-Type", "application/json")
+		w := httptest.NewRecorder()
+
+		// Act
+		suite.router.ServeHTTP(w, req)
+
+		// Assert
+		assert.Equal(suite.T(), tc.status, w.Code)
+		assert.Equal(suite.T(), rest.ProblemContentType, w.Header().Get("Content-Type"))
+		assert.Contains(suite.T(), w.Body.String(), tc.err.Error())
 	}
+}
+
+func (suite *ProductApiControllerTestSuite) TestAdd_ValidationError() {
+	// Arrange
+	requestDto := &dto.AddProductRequestDto{Name: "", Category: 1, Price: "-1"}
 
 	suite.mockController.EXPECT().
-		Add(requestDto).
-		Return(nil).
+		Add(mock.Anything, requestDto).
+		Return(nil, domainerrors.NewValidationError(
+			domainerrors.FieldError{Field: "name", Message: "is required"},
+			domainerrors.FieldError{Field: "price", Message: "must be greater than zero"},
+		)).
 		Once()
 
 	body, _ := json.Marshal(requestDto)
@@ -163,7 +816,18 @@ func (suite *ProductApiControllerTestSuite) TestAdd_Success() {
 	suite.router.ServeHTTP(w, req)
 
 	// Assert
-	assert.Equal(suite.T(), http.StatusCreated, w.Code)
+	assert.Equal(suite.T(), http.StatusUnprocessableEntity, w.Code)
+	assert.Equal(suite.T(), rest.ProblemContentType, w.Header().Get("Content-Type"))
+
+	var problem rest.Problem
+	err := json.NewDecoder(w.Body).Decode(&problem)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), http.StatusUnprocessableEntity, problem.Status)
+	assert.Equal(suite.T(), "/v1/product", problem.Instance)
+	assert.Equal(suite.T(), []rest.FieldProblem{
+		{Field: "name", Message: "is required"},
+		{Field: "price", Message: "must be greater than zero"},
+	}, problem.Errors)
 }
 
 func (suite *ProductApiControllerTestSuite) TestAdd_InvalidJSON() {
@@ -204,14 +868,14 @@ func (suite *ProductApiControllerTestSuite) TestAdd_ControllerError() {
 	requestDto := &dto.AddProductRequestDto{
 		Name:        "Pizza",
 		Category:    1,
-		Price:       45.99,
+		Price:       "45.99",
 		Description: "Pizza margherita",
 		ImageLink:   "https://example.com/pizza.jpg",
 	}
 
 	suite.mockController.EXPECT().
-		Add(requestDto).
-		Return(errors.New("validation error")).
+		Add(mock.Anything, requestDto).
+		Return(nil, errors.New("validation error")).
 		Once()
 
 	body, _ := json.Marshal(requestDto)
@@ -233,19 +897,20 @@ func (suite *ProductApiControllerTestSuite) TestUpdate_Success() {
 	requestDto := &dto.UpdateProductRequestDto{
 		Name:        "Hamburguer Atualizado",
 		Category:    1,
-		Price:       39.99,
+		Price:       "39.99",
 		Description: "Hamburguer com bacon",
 		ImageLink:   "https://example.com/updated.jpg",
 	}
 
 	suite.mockController.EXPECT().
-		Update(uint(1), requestDto).
-		Return(nil).
+		Update(mock.Anything, uint(1), uint(2), requestDto).
+		Return(&dto.GetProductResponseDto{ID: 1, Name: "Hamburguer Atualizado", Category: 1, Price: 39.99, Version: 3}, nil).
 		Once()
 
 	body, _ := json.Marshal(requestDto)
 	req := httptest.NewRequest(http.MethodPut, "/v1/product/"+id, bytes.NewBuffer(body))
 	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("If-Match", `W/"2"`)
 	w := httptest.NewRecorder()
 
 	// Act
@@ -253,6 +918,72 @@ func (suite *ProductApiControllerTestSuite) TestUpdate_Success() {
 
 	// Assert
 	assert.Equal(suite.T(), http.StatusOK, w.Code)
+	assert.Equal(suite.T(), "/v1/product/1", w.Header().Get("Location"))
+	assert.Equal(suite.T(), `W/"3"`, w.Header().Get("ETag"))
+
+	var response dto.GetProductResponseDto
+	assert.NoError(suite.T(), json.NewDecoder(w.Body).Decode(&response))
+	assert.Equal(suite.T(), "Hamburguer Atualizado", response.Name)
+}
+
+func (suite *ProductApiControllerTestSuite) TestUpdate_PreconditionFailed() {
+	// Arrange
+	requestDto := &dto.UpdateProductRequestDto{Name: "Pizza", Category: 1, Price: "45.99"}
+
+	suite.mockController.EXPECT().
+		Update(mock.Anything, uint(1), uint(2), requestDto).
+		Return(nil, domainerrors.NewPreconditionFailedError("product", 1)).
+		Once()
+
+	body, _ := json.Marshal(requestDto)
+	req := httptest.NewRequest(http.MethodPut, "/v1/product/1", bytes.NewBuffer(body))
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("If-Match", `W/"2"`)
+	w := httptest.NewRecorder()
+
+	// Act
+	suite.router.ServeHTTP(w, req)
+
+	// Assert
+	assert.Equal(suite.T(), http.StatusPreconditionFailed, w.Code)
+	assert.Contains(suite.T(), w.Body.String(), "Product has been modified")
+}
+
+func (suite *ProductApiControllerTestSuite) TestUpdate_InvalidIfMatch() {
+	// Arrange
+	req := httptest.NewRequest(http.MethodPut, "/v1/product/1", bytes.NewBufferString(`{}`))
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("If-Match", "2")
+	w := httptest.NewRecorder()
+
+	// Act
+	suite.router.ServeHTTP(w, req)
+
+	// Assert - the controller is not called
+	assert.Equal(suite.T(), http.StatusBadRequest, w.Code)
+	assert.Contains(suite.T(), w.Body.String(), "Invalid If-Match header")
+}
+
+func (suite *ProductApiControllerTestSuite) TestUpdate_NotFound() {
+	// Arrange
+	requestDto := &dto.UpdateProductRequestDto{Name: "Hamburguer", Category: 1, Price: "34.99"}
+
+	suite.mockController.EXPECT().
+		Update(mock.Anything, uint(999), uint(0), requestDto).
+		Return(nil, domainerrors.NewNotFoundError("product", 999)).
+		Once()
+
+	body, _ := json.Marshal(requestDto)
+	req := httptest.NewRequest(http.MethodPut, "/v1/product/999", bytes.NewBuffer(body))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+
+	// Act
+	suite.router.ServeHTTP(w, req)
+
+	// Assert
+	assert.Equal(suite.T(), http.StatusNotFound, w.Code)
+	assert.Contains(suite.T(), w.Body.String(), "Product not found")
 }
 
 func (suite *ProductApiControllerTestSuite) TestUpdate_InvalidID() {
@@ -296,14 +1027,14 @@ func (suite *ProductApiControllerTestSuite) TestUpdate_ControllerError() {
 	requestDto := &dto.UpdateProductRequestDto{
 		Name:        "Hamburguer",
 		Category:    1,
-		Price:       34.99,
+		Price:       "34.99",
 		Description: "Hamburguer com salada",
 		ImageLink:   "https://example.com/image.jpg",
 	}
 
 	suite.mockController.EXPECT().
-		Update(uint(1), requestDto).
-		Return(errors.New("product not found")).
+		Update(mock.Anything, uint(1), uint(0), requestDto).
+		Return(nil, errors.New("product not found")).
 		Once()
 
 	body, _ := json.Marshal(requestDto)
@@ -319,12 +1050,130 @@ func (suite *ProductApiControllerTestSuite) TestUpdate_ControllerError() {
 	assert.Contains(suite.T(), w.Body.String(), "Error processing request")
 }
 
+func (suite *ProductApiControllerTestSuite) TestPatch_Success() {
+	// Arrange
+	expectedDto := &dto.PatchProductRequestDto{
+		Price:       dto.Optional[dto.Decimal]{Set: true, Value: "39.99"},
+		Description: dto.Optional[string]{Set: true, Null: true},
+	}
+	patched := &dto.GetProductResponseDto{ID: 1, Name: "Hamburguer", Category: 1, Price: 39.99, Version: 4}
+
+	suite.mockController.EXPECT().
+		Patch(mock.Anything, uint(1), uint(0), expectedDto).
+		Return(patched, nil).
+		Once()
+
+	body := []byte(`{"price":39.99,"description":null}`)
+	req := httptest.NewRequest(http.MethodPatch, "/v1/product/1", bytes.NewBuffer(body))
+	req.Header.Set("Content-Type", "application/merge-patch+json")
+	w := httptest.NewRecorder()
+
+	// Act
+	suite.router.ServeHTTP(w, req)
+
+	// Assert - the patched product comes back with its new version
+	assert.Equal(suite.T(), http.StatusOK, w.Code)
+	assert.Equal(suite.T(), `W/"4"`, w.Header().Get("ETag"))
+	assert.Equal(suite.T(), "/v1/product/1", w.Header().Get("Location"))
+
+	var response dto.GetProductResponseDto
+	assert.NoError(suite.T(), json.Unmarshal(w.Body.Bytes(), &response))
+	assert.Equal(suite.T(), *patched, response)
+}
+
+func (suite *ProductApiControllerTestSuite) TestPatch_AcceptsJSON() {
+	// Arrange
+	expectedDto := &dto.PatchProductRequestDto{
+		Name: dto.Optional[string]{Set: true, Value: "Pizza"},
+	}
+
+	suite.mockController.EXPECT().
+		Patch(mock.Anything, uint(1), uint(0), expectedDto).
+		Return(&dto.GetProductResponseDto{ID: 1, Name: "Pizza", Version: 2}, nil).
+		Once()
+
+	req := httptest.NewRequest(http.MethodPatch, "/v1/product/1", bytes.NewBuffer([]byte(`{"name":"Pizza"}`)))
+	req.Header.Set("Content-Type", "application/json; charset=utf-8")
+	w := httptest.NewRecorder()
+
+	// Act
+	suite.router.ServeHTTP(w, req)
+
+	// Assert
+	assert.Equal(suite.T(), http.StatusOK, w.Code)
+}
+
+func (suite *ProductApiControllerTestSuite) TestPatch_UnsupportedMediaType() {
+	// Arrange
+	req := httptest.NewRequest(http.MethodPatch, "/v1/product/1", bytes.NewBuffer([]byte(`name=Pizza`)))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	w := httptest.NewRecorder()
+
+	// Act
+	suite.router.ServeHTTP(w, req)
+
+	// Assert
+	assert.Equal(suite.T(), http.StatusUnsupportedMediaType, w.Code)
+	assert.Equal(suite.T(), rest.ProblemContentType, w.Header().Get("Content-Type"))
+}
+
+func (suite *ProductApiControllerTestSuite) TestPatch_InvalidID() {
+	// Arrange
+	req := httptest.NewRequest(http.MethodPatch, "/v1/product/invalid", bytes.NewBuffer([]byte(`{}`)))
+	req.Header.Set("Content-Type", "application/merge-patch+json")
+	w := httptest.NewRecorder()
+
+	// Act
+	suite.router.ServeHTTP(w, req)
+
+	// Assert
+	assert.Equal(suite.T(), http.StatusBadRequest, w.Code)
+	assert.Contains(suite.T(), w.Body.String(), "Invalid parameter")
+}
+
+func (suite *ProductApiControllerTestSuite) TestPatch_InvalidJSON() {
+	// Arrange
+	req := httptest.NewRequest(http.MethodPatch, "/v1/product/1", bytes.NewBuffer([]byte(`{"name": "Pizza", "invalid}`)))
+	req.Header.Set("Content-Type", "application/merge-patch+json")
+	w := httptest.NewRecorder()
+
+	// Act
+	suite.router.ServeHTTP(w, req)
+
+	// Assert
+	assert.Equal(suite.T(), http.StatusBadRequest, w.Code)
+	assert.Contains(suite.T(), w.Body.String(), "Invalid request payload")
+}
+
+func (suite *ProductApiControllerTestSuite) TestPatch_NotFound() {
+	// Arrange
+	expectedDto := &dto.PatchProductRequestDto{
+		Name: dto.Optional[string]{Set: true, Value: "Pizza"},
+	}
+
+	suite.mockController.EXPECT().
+		Patch(mock.Anything, uint(999), uint(0), expectedDto).
+		Return(nil, domainerrors.NewNotFoundError("product", 999)).
+		Once()
+
+	req := httptest.NewRequest(http.MethodPatch, "/v1/product/999", bytes.NewBuffer([]byte(`{"name":"Pizza"}`)))
+	req.Header.Set("Content-Type", "application/merge-patch+json")
+	w := httptest.NewRecorder()
+
+	// Act
+	suite.router.ServeHTTP(w, req)
+
+	// Assert
+	assert.Equal(suite.T(), http.StatusNotFound, w.Code)
+	assert.Contains(suite.T(), w.Body.String(), "Product not found")
+}
+
 func (suite *ProductApiControllerTestSuite) TestDelete_Success() {
 	// Arrange
 	id := "1"
 
 	suite.mockController.EXPECT().
-		Delete(uint(1)).
+		Delete(mock.Anything, uint(1), uint(0)).
 		Return(nil).
 		Once()
 
@@ -338,6 +1187,61 @@ func (suite *ProductApiControllerTestSuite) TestDelete_Success() {
 	assert.Equal(suite.T(), http.StatusNoContent, w.Code)
 }
 
+func (suite *ProductApiControllerTestSuite) TestDelete_IfMatch() {
+	// Arrange
+	suite.mockController.EXPECT().
+		Delete(mock.Anything, uint(1), uint(5)).
+		Return(nil).
+		Once()
+
+	req := httptest.NewRequest(http.MethodDelete, "/v1/product/1", nil)
+	req.Header.Set("If-Match", `W/"5"`)
+	w := httptest.NewRecorder()
+
+	// Act
+	suite.router.ServeHTTP(w, req)
+
+	// Assert
+	assert.Equal(suite.T(), http.StatusNoContent, w.Code)
+}
+
+func (suite *ProductApiControllerTestSuite) TestWrites_IfMatchRequired() {
+	// Arrange
+	router := chi.NewRouter()
+	idempotency := middleware.NewIdempotency(persistence.NewInMemoryIdempotencyKeyRepository(persistence.NewInMemoryStore()), time.Hour, time.Minute, logging.NewNop())
+	apiController.NewProductController(suite.mockController, idempotency, true, "no-cache").RegisterRoutes(router)
+
+	for _, method := range []string{http.MethodPut, http.MethodPatch, http.MethodDelete} {
+		req := httptest.NewRequest(method, "/v1/product/1", bytes.NewBufferString(`{}`))
+		req.Header.Set("Content-Type", "application/json")
+		w := httptest.NewRecorder()
+
+		// Act
+		router.ServeHTTP(w, req)
+
+		// Assert - the controller is never called
+		assert.Equal(suite.T(), http.StatusPreconditionRequired, w.Code, method)
+		assert.Contains(suite.T(), w.Body.String(), "If-Match header is required", method)
+	}
+}
+
+func (suite *ProductApiControllerTestSuite) TestDelete_NotFound() {
+	// Arrange
+	suite.mockController.EXPECT().
+		Delete(mock.Anything, uint(999), uint(0)).
+		Return(domainerrors.NewNotFoundError("product", 999)).
+		Once()
+
+	req := httptest.NewRequest(http.MethodDelete, "/v1/product/999", nil)
+	w := httptest.NewRecorder()
+
+	// Act
+	suite.router.ServeHTTP(w, req)
+
+	// Assert
+	assert.Equal(suite.T(), http.StatusNotFound, w.Code)
+}
+
 func (suite *ProductApiControllerTestSuite) TestDelete_InvalidID() {
 	// Arrange
 	id := "invalid"
@@ -359,7 +1263,7 @@ func (suite *ProductApiControllerTestSuite) TestDelete_ControllerError() {
 	id := "1"
 
 	suite.mockController.EXPECT().
-		Delete(uint(1)).
+		Delete(mock.Anything, uint(1), uint(0)).
 		Return(errors.New("database error")).
 		Once()
 
@@ -374,3 +1278,58 @@ func (suite *ProductApiControllerTestSuite) TestDelete_ControllerError() {
 	assert.Contains(suite.T(), w.Body.String(), "Error processing request")
 }
 
+func (suite *ProductApiControllerTestSuite) TestRestore_Success() {
+	// Arrange
+	expectedResponse := &dto.GetProductResponseDto{ID: 1, Name: "Hamburguer", Category: 1}
+
+	suite.mockController.EXPECT().
+		Restore(mock.Anything, uint(1)).
+		Return(expectedResponse, nil).
+		Once()
+
+	req := httptest.NewRequest(http.MethodPost, "/v1/product/1/restore", nil)
+	w := httptest.NewRecorder()
+
+	// Act
+	suite.router.ServeHTTP(w, req)
+
+	// Assert
+	assert.Equal(suite.T(), http.StatusOK, w.Code)
+
+	var response dto.GetProductResponseDto
+	err := json.NewDecoder(w.Body).Decode(&response)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), uint(1), response.ID)
+	assert.Nil(suite.T(), response.DeletedAt)
+}
+
+func (suite *ProductApiControllerTestSuite) TestRestore_NotFound() {
+	// Arrange
+	suite.mockController.EXPECT().
+		Restore(mock.Anything, uint(999)).
+		Return(nil, domainerrors.NewNotFoundError("product", 999)).
+		Once()
+
+	req := httptest.NewRequest(http.MethodPost, "/v1/product/999/restore", nil)
+	w := httptest.NewRecorder()
+
+	// Act
+	suite.router.ServeHTTP(w, req)
+
+	// Assert
+	assert.Equal(suite.T(), http.StatusNotFound, w.Code)
+	assert.Contains(suite.T(), w.Body.String(), "Product not found")
+}
+
+func (suite *ProductApiControllerTestSuite) TestRestore_InvalidID() {
+	// Arrange
+	req := httptest.NewRequest(http.MethodPost, "/v1/product/invalid/restore", nil)
+	w := httptest.NewRecorder()
+
+	// Act
+	suite.router.ServeHTTP(w, req)
+
+	// Assert
+	assert.Equal(suite.T(), http.StatusBadRequest, w.Code)
+	assert.Contains(suite.T(), w.Body.String(), "Invalid parameter")
+}
@@ -0,0 +1,8 @@
+package dto
+
+type AddCategoryRequestDto struct {
+	DisplayName string `json:"display_name" example:"Lanche"`
+	SortOrder   int    `json:"sort_order" example:"1"`
+	// Active defaults to true when omitted.
+	Active *bool `json:"active,omitempty" example:"true"`
+}
@@ -0,0 +1,38 @@
+package dto
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+)
+
+var errInvalidDecimal = errors.New("decimal must be a JSON number or string")
+
+// Decimal is an amount sent either as a JSON number (34.99) or as a decimal
+// string ("34.99"). Numbers are accepted for backward compatibility and kept
+// as written, so no precision is lost to float64.
+type Decimal string
+
+func (d *Decimal) UnmarshalJSON(data []byte) error {
+	if len(data) > 0 && data[0] == '"' {
+		var value string
+		if err := json.Unmarshal(data, &value); err != nil {
+			return err
+		}
+		*d = Decimal(value)
+		return nil
+	}
+
+	var number json.Number
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	if err := decoder.Decode(&number); err != nil {
+		return errInvalidDecimal
+	}
+	*d = Decimal(number)
+	return nil
+}
+
+func (d Decimal) MarshalJSON() ([]byte, error) {
+	return json.Marshal(string(d))
+}
@@ -14,7 +14,7 @@ func TestAddProductRequestDto_JSONMarshal(t *testing.T) {
 	request := dto.AddProductRequestDto{
 		Name:        "Hamburguer",
 		Category:    1,
-		Price:       34.99,
+		Price:       "34.99",
 		Description: "Hamburguer com salada",
 		ImageLink:   "https://example.com/image.jpg",
 	}
@@ -26,7 +26,7 @@ func TestAddProductRequestDto_JSONMarshal(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Contains(t, string(data), `"name":"Hamburguer"`)
 	assert.Contains(t, string(data), `"category":1`)
-	assert.Contains(t, string(data), `"price":34.99`)
+	assert.Contains(t, string(data), `"price":"34.99"`)
 	assert.Contains(t, string(data), `"description":"Hamburguer com salada"`)
 	assert.Contains(t, string(data), `"image_link":"https://example.com/image.jpg"`)
 }
@@ -43,7 +43,7 @@ func TestAddProductRequestDto_JSONUnmarshal(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Equal(t, "Pizza", request.Name)
 	assert.Equal(t, 1, request.Category)
-	assert.Equal(t, 45.99, request.Price)
+	assert.Equal(t, dto.Decimal("45.99"), request.Price)
 	assert.Equal(t, "Pizza margherita", request.Description)
 	assert.Equal(t, "https://example.com/pizza.jpg", request.ImageLink)
 }
@@ -70,7 +70,7 @@ func TestUpdateProductRequestDto_JSONMarshal(t *testing.T) {
 	request := dto.UpdateProductRequestDto{
 		Name:        "Hamburguer Atualizado",
 		Category:    1,
-		Price:       39.99,
+		Price:       "39.99",
 		Description: "Hamburguer com bacon",
 		ImageLink:   "https://example.com/updated.jpg",
 	}
@@ -82,7 +82,7 @@ func TestUpdateProductRequestDto_JSONMarshal(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Contains(t, string(data), `"name":"Hamburguer Atualizado"`)
 	assert.Contains(t, string(data), `"category":1`)
-	assert.Contains(t, string(data), `"price":39.99`)
+	assert.Contains(t, string(data), `"price":"39.99"`)
 	assert.Contains(t, string(data), `"description":"Hamburguer com bacon"`)
 	assert.Contains(t, string(data), `"image_link":"https://example.com/updated.jpg"`)
 }
@@ -99,7 +99,7 @@ func TestUpdateProductRequestDto_JSONUnmarshal(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Equal(t, "Hamburguer Especial", request.Name)
 	assert.Equal(t, 1, request.Category)
-	assert.Equal(t, 49.99, request.Price)
+	assert.Equal(t, dto.Decimal("49.99"), request.Price)
 	assert.Equal(t, "Hamburguer duplo", request.Description)
 	assert.Equal(t, "https://example.com/special.jpg", request.ImageLink)
 }
@@ -116,7 +116,7 @@ func TestUpdateProductRequestDto_JSONUnmarshal_PartialFields(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Equal(t, "Hamburguer", request.Name)
 	assert.Zero(t, request.Category)
-	assert.Equal(t, 34.99, request.Price)
+	assert.Equal(t, dto.Decimal("34.99"), request.Price)
 	assert.Empty(t, request.Description)
 	assert.Empty(t, request.ImageLink)
 }
@@ -130,6 +130,7 @@ func TestGetProductResponseDto_JSONMarshal(t *testing.T) {
 		Name:        "Hamburguer",
 		Category:    1,
 		Price:       34.99,
+		PriceMoney:  dto.MoneyDto{Amount: "34.99", MinorUnits: 3499, Currency: "BRL"},
 		Description: "Hamburguer com salada",
 		ImageLink:   "https://example.com/image.jpg",
 	}
@@ -144,6 +145,7 @@ func TestGetProductResponseDto_JSONMarshal(t *testing.T) {
 	assert.Contains(t, string(data), `"name":"Hamburguer"`)
 	assert.Contains(t, string(data), `"category":1`)
 	assert.Contains(t, string(data), `"price":34.99`)
+	assert.Contains(t, string(data), `"price_money":{"amount":"34.99","minor_units":3499,"currency":"BRL"}`)
 	assert.Contains(t, string(data), `"description":"Hamburguer com salada"`)
 	assert.Contains(t, string(data), `"image_link":"https://example.com/image.jpg"`)
 }
@@ -189,7 +191,7 @@ func TestAddProductRequestDto_JSONMarshal_SpecialCharacters(t *testing.T) {
 	request := dto.AddProductRequestDto{
 		Name:        `Hamburguer "Especial"`,
 		Category:    1,
-		Price:       34.99,
+		Price:       "34.99",
 		Description: "Hamburguer com\nquebra de linha",
 		ImageLink:   "https://example.com/image.jpg?param=value&other=123",
 	}
@@ -208,3 +210,50 @@ func TestAddProductRequestDto_JSONMarshal_SpecialCharacters(t *testing.T) {
 	assert.Equal(t, request.Description, decoded.Description)
 	assert.Equal(t, request.ImageLink, decoded.ImageLink)
 }
+
+func TestPatchProductRequestDto_JSONUnmarshal(t *testing.T) {
+	// Arrange
+	jsonData := `{"price":39.99,"description":null}`
+
+	// Act
+	var request dto.PatchProductRequestDto
+	err := json.Unmarshal([]byte(jsonData), &request)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.False(t, request.Name.Set)
+	assert.Nil(t, request.Name.Ptr())
+	assert.True(t, request.Price.Set)
+	assert.False(t, request.Price.Null)
+	assert.Equal(t, dto.Decimal("39.99"), *request.Price.Ptr())
+	assert.True(t, request.Description.Set)
+	assert.True(t, request.Description.Null)
+	assert.Equal(t, "", *request.Description.Ptr())
+}
+
+func TestDecimal_UnmarshalJSON(t *testing.T) {
+	cases := map[string]dto.Decimal{
+		`{"price":34.99}`:   "34.99",
+		`{"price":"34.99"}`: "34.99",
+		`{"price":10}`:      "10",
+	}
+
+	for jsonData, expected := range cases {
+		// Act
+		var request dto.AddProductRequestDto
+		err := json.Unmarshal([]byte(jsonData), &request)
+
+		// Assert
+		assert.NoError(t, err, jsonData)
+		assert.Equal(t, expected, request.Price, jsonData)
+	}
+}
+
+func TestDecimal_UnmarshalJSON_Invalid(t *testing.T) {
+	// Act
+	var request dto.AddProductRequestDto
+	err := json.Unmarshal([]byte(`{"price":true}`), &request)
+
+	// Assert
+	assert.Error(t, err)
+}
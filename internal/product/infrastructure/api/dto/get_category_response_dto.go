@@ -0,0 +1,11 @@
+package dto
+
+import "time"
+
+type GetCategoryResponseDto struct {
+	ID          uint      `json:"id"`
+	CreatedAt   time.Time `json:"created_at"`
+	DisplayName string    `json:"display_name"`
+	SortOrder   int       `json:"sort_order"`
+	Active      bool      `json:"active"`
+}
@@ -3,12 +3,20 @@ package dto
 import "time"
 
 type GetProductResponseDto struct {
-	ID          uint      `json:"id"`
-	CreatedAt   time.Time `json:"created_at"`
-	Name        string    `json:"name"`
-	Category    int       `json:"category"`
-	Price       float64   `json:"price"`
-	Description string    `json:"description"`
-	ImageLink   string    `json:"image_link"`
+	ID        uint      `json:"id"`
+	CreatedAt time.Time `json:"created_at"`
+	Name      string    `json:"name"`
+	Category  int       `json:"category"`
+	// Price is kept for existing clients; new clients should read PriceMoney.
+	Price       float64  `json:"price"`
+	PriceMoney  MoneyDto `json:"price_money"`
+	Description string   `json:"description"`
+	ImageLink   string   `json:"image_link"`
+	// DeletedAt is only set for soft-deleted products, which are listed
+	// when include_deleted=true is requested.
+	DeletedAt *time.Time `json:"deleted_at,omitempty"`
+	// Version changes on every update and is also sent as the ETag.
+	Version uint `json:"version"`
+	// UpdatedAt is also sent as Last-Modified.
+	UpdatedAt time.Time `json:"updated_at"`
 }
-
@@ -0,0 +1,27 @@
+package dto
+
+import "time"
+
+type ListProductsRequestDto struct {
+	Category       uint
+	Sort           string
+	Order          string
+	IncludeDeleted bool
+	Limit          int
+	Offset         int
+	// After continues the listing after the last product of a previous page
+	// and replaces Offset.
+	After *ListProductsCursorDto
+}
+
+// ListProductsCursorDto is carried by next_cursor: the sort order of the
+// listing and the position of the last product of the page in it. Only the
+// value of the sort field is set.
+type ListProductsCursorDto struct {
+	Sort      string     `json:"sort,omitempty"`
+	Order     string     `json:"order,omitempty"`
+	ID        uint       `json:"id"`
+	Name      string     `json:"name,omitempty"`
+	Price     int64      `json:"price,omitempty"`
+	CreatedAt *time.Time `json:"created_at,omitempty"`
+}
@@ -0,0 +1,9 @@
+package dto
+
+type ListProductsResponseDto struct {
+	Items      []*GetProductResponseDto `json:"items"`
+	Total      int64                    `json:"total"`
+	Limit      int                      `json:"limit"`
+	Offset     int                      `json:"offset"`
+	NextCursor string                   `json:"next_cursor,omitempty"`
+}
@@ -0,0 +1,8 @@
+package dto
+
+type LookupProductsRequestDto struct {
+	IDs []uint `json:"ids" example:"1,2,3"`
+	// IncludeDeleted also resolves soft-deleted products, for orders placed
+	// before they were deleted.
+	IncludeDeleted bool `json:"include_deleted"`
+}
@@ -0,0 +1,6 @@
+package dto
+
+type LookupProductsResponseDto struct {
+	Products   []*GetProductResponseDto `json:"products"`
+	MissingIDs []uint                   `json:"missing_ids"`
+}
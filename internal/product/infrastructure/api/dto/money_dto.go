@@ -0,0 +1,7 @@
+package dto
+
+type MoneyDto struct {
+	Amount     string `json:"amount" example:"34.99"`
+	MinorUnits int64  `json:"minor_units" example:"3499"`
+	Currency   string `json:"currency" example:"BRL"`
+}
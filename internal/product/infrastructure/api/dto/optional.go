@@ -0,0 +1,38 @@
+package dto
+
+import "encoding/json"
+
+// Optional holds a JSON Merge Patch (RFC 7396) member. It tells apart a
+// member that was not sent (Set is false), one sent as null (Null is true)
+// and one sent with a value.
+type Optional[T any] struct {
+	Set   bool
+	Null  bool
+	Value T
+}
+
+func (o *Optional[T]) UnmarshalJSON(data []byte) error {
+	o.Set = true
+	if string(data) == "null" {
+		o.Null = true
+		return nil
+	}
+	return json.Unmarshal(data, &o.Value)
+}
+
+func (o Optional[T]) MarshalJSON() ([]byte, error) {
+	if o.Null || !o.Set {
+		return []byte("null"), nil
+	}
+	return json.Marshal(o.Value)
+}
+
+// Ptr returns nil when the member was not sent, a pointer to the zero value
+// when it was sent as null and a pointer to the value otherwise.
+func (o Optional[T]) Ptr() *T {
+	if !o.Set {
+		return nil
+	}
+	value := o.Value
+	return &value
+}
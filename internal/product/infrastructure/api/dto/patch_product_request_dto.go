@@ -0,0 +1,10 @@
+package dto
+
+type PatchProductRequestDto struct {
+	Name        Optional[string]  `json:"name" swaggertype:"string" example:"Hamburguer"`
+	Category    Optional[int]     `json:"category" swaggertype:"integer" example:"1"`
+	Price       Optional[Decimal] `json:"price" swaggertype:"string" example:"34.99"`
+	Currency    Optional[string]  `json:"currency" swaggertype:"string" example:"BRL"`
+	Description Optional[string]  `json:"description" swaggertype:"string" example:"Hamburguer com bacon"`
+	ImageLink   Optional[string]  `json:"image_link" swaggertype:"string" example:"https://www.google.com/images/branding/googlelogo/2x/googlelogo_color_272x92dp.png"`
+}
@@ -0,0 +1,7 @@
+package dto
+
+type UpdateCategoryRequestDto struct {
+	DisplayName string `json:"display_name" example:"Lanche"`
+	SortOrder   int    `json:"sort_order" example:"1"`
+	Active      bool   `json:"active" example:"true"`
+}
@@ -3,7 +3,8 @@ package dto
 type UpdateProductRequestDto struct {
 	Name        string  `json:"name" example:"Hamburguer"`
 	Category    int     `json:"category" example:"1"`
-	Price       float64 `json:"price" example:"34.99"`
+	Price       Decimal `json:"price" swaggertype:"string" example:"34.99"`
+	Currency    string  `json:"currency,omitempty" example:"BRL"`
 	Description string  `json:"description" example:"Hamburguer com bacon"`
 	ImageLink   string  `json:"image_link" example:"https://www.google.com/images/branding/googlelogo/2x/googlelogo_color_272x92dp.png"`
 }
@@ -0,0 +1,251 @@
+// Package middleware holds the HTTP middlewares specific to the product API.
+package middleware
+
+import (
+	"bytes"
+	"cmp"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"io"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/mathefer/tc-fiap-product/internal/product/domain/domainerrors"
+	"github.com/mathefer/tc-fiap-product/internal/product/domain/entities"
+	"github.com/mathefer/tc-fiap-product/internal/product/domain/repositories"
+	"github.com/mathefer/tc-fiap-product/pkg/rest"
+)
+
+const (
+	// IdempotencyKeyHeader names the header a client sets to make a request
+	// safe to retry.
+	IdempotencyKeyHeader = "Idempotency-Key"
+	// IdempotentReplayedHeader marks a response replayed from an earlier
+	// request with the same key.
+	IdempotentReplayedHeader = "Idempotent-Replayed"
+
+	// maxIdempotencyKeyLength matches the size of the idempotency_key column.
+	maxIdempotencyKeyLength = 255
+
+	// maxRequestBodyBytes bounds the body read into memory to fingerprint
+	// the request.
+	maxRequestBodyBytes = 1 << 20
+
+	// claimAttempts bounds how often a key is claimed again after finding it
+	// expired or released by the request that held it.
+	claimAttempts = 2
+)
+
+// errKeyContended reports a key that kept being claimed by other requests.
+var errKeyContended = errors.New("idempotency key is contended")
+
+// Idempotency makes POST requests that carry an Idempotency-Key header safe
+// to retry. The first request with a key runs and its response is stored;
+// repeats within the TTL get the stored response back without running the
+// handler again. A repeat with a different body is rejected with 422, and
+// one that arrives while the first request is still running with 409.
+//
+// A key stays in progress for at most lease. After that the request holding
+// it is presumed lost, e.g. with the replica that served it, and a retry
+// takes the key over instead of waiting for the TTL.
+type Idempotency struct {
+	repository repositories.IdempotencyKeyRepository
+	ttl        time.Duration
+	lease      time.Duration
+	logger     *slog.Logger
+	now        func() time.Time
+}
+
+func NewIdempotency(repository repositories.IdempotencyKeyRepository, ttl, lease time.Duration, logger *slog.Logger) *Idempotency {
+	return &Idempotency{repository: repository, ttl: ttl, lease: lease, logger: logger, now: time.Now}
+}
+
+// Handler wraps next. Requests without the header pass straight through.
+func (m *Idempotency) Handler(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		key := r.Header.Get(IdempotencyKeyHeader)
+		if key == "" {
+			next.ServeHTTP(w, r)
+			return
+		}
+		if len(key) > maxIdempotencyKeyLength {
+			rest.WriteError(w, r, http.StatusBadRequest, "Idempotency-Key must be at most 255 characters")
+			return
+		}
+
+		body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxRequestBodyBytes))
+		var tooLarge *http.MaxBytesError
+		if errors.As(err, &tooLarge) {
+			rest.WriteError(w, r, http.StatusRequestEntityTooLarge, "Request payload too large")
+			return
+		}
+		if err != nil {
+			rest.WriteError(w, r, http.StatusBadRequest, "Invalid request payload")
+			return
+		}
+		r.Body = io.NopCloser(bytes.NewReader(body))
+
+		ctx := r.Context()
+		record := &entities.IdempotencyKey{Key: key, RequestHash: requestHash(r, body), CreatedAt: m.now()}
+		stored, err := m.claim(ctx, record)
+		switch {
+		case errors.Is(err, errKeyContended):
+			writeInProgress(w, r)
+		case err != nil:
+			m.logger.ErrorContext(ctx, "Failed to claim idempotency key", "error", err)
+			rest.WriteError(w, r, http.StatusInternalServerError, "Error processing request")
+		case stored != nil:
+			m.replay(w, r, record, stored)
+		default:
+			m.serve(w, r, next, record)
+		}
+	})
+}
+
+// DeleteExpired removes the keys that can no longer be replayed.
+func (m *Idempotency) DeleteExpired(ctx context.Context) error {
+	deleted, err := m.repository.DeleteExpired(ctx, m.now().Add(-m.ttl))
+	if err != nil {
+		return err
+	}
+	if deleted > 0 {
+		m.logger.InfoContext(ctx, "Deleted expired idempotency keys", "count", deleted)
+	}
+	return nil
+}
+
+// claim stores record and returns nil when the request now holds the key,
+// or the key stored by an earlier request. An expired key, or one left in
+// progress past its lease, is deleted and claimed afresh.
+func (m *Idempotency) claim(ctx context.Context, record *entities.IdempotencyKey) (*entities.IdempotencyKey, error) {
+	for range claimAttempts {
+		err := m.repository.Create(ctx, record)
+		if err == nil {
+			return nil, nil
+		}
+		if !errors.Is(err, domainerrors.ErrConflict) {
+			return nil, err
+		}
+
+		stored, err := m.repository.GetByKey(ctx, record.Key)
+		switch {
+		case errors.Is(err, domainerrors.ErrNotFound):
+			// Released by a request that failed; try again.
+			continue
+		case err != nil:
+			return nil, err
+		case !stored.Completed() && stored.Expired(record.CreatedAt, m.lease):
+			m.logger.WarnContext(ctx, "Taking over abandoned idempotency key", "claimed_at", stored.CreatedAt)
+			if err := m.repository.DeleteAbandoned(ctx, record.Key, record.CreatedAt.Add(-m.lease)); err != nil {
+				return nil, err
+			}
+			continue
+		case !stored.Expired(record.CreatedAt, m.ttl):
+			return stored, nil
+		}
+
+		// Only keys older than the TTL go, so a key that a concurrent
+		// request has just claimed afresh is kept.
+		if _, err := m.repository.DeleteExpired(ctx, record.CreatedAt.Add(-m.ttl)); err != nil {
+			return nil, err
+		}
+	}
+	return nil, errKeyContended
+}
+
+// replay answers a repeated request from the stored key.
+func (m *Idempotency) replay(w http.ResponseWriter, r *http.Request, record, stored *entities.IdempotencyKey) {
+	switch {
+	case stored.RequestHash != record.RequestHash:
+		rest.WriteError(w, r, http.StatusUnprocessableEntity, "Idempotency-Key was already used with a different request")
+	case !stored.Completed():
+		writeInProgress(w, r)
+	default:
+		m.logger.InfoContext(r.Context(), "Replaying idempotent response", "status", stored.StatusCode)
+		if stored.ContentType != "" {
+			w.Header().Set("Content-Type", stored.ContentType)
+		}
+		if stored.Location != "" {
+			w.Header().Set("Location", stored.Location)
+		}
+		if stored.ETag != "" {
+			w.Header().Set("ETag", stored.ETag)
+		}
+		w.Header().Set(IdempotentReplayedHeader, "true")
+		w.WriteHeader(stored.StatusCode)
+		w.Write(stored.ResponseBody)
+	}
+}
+
+// serve runs next for the request holding the key and stores its response.
+// Server errors are not stored: the key is released so the client can
+// retry, as it is when next panics.
+func (m *Idempotency) serve(w http.ResponseWriter, r *http.Request, next http.Handler, record *entities.IdempotencyKey) {
+	// The outcome is recorded even when the client has gone away.
+	ctx := context.WithoutCancel(r.Context())
+	recorder := &responseRecorder{ResponseWriter: w}
+	completed := false
+	defer func() {
+		if completed {
+			return
+		}
+		if err := m.repository.Delete(ctx, record.Key); err != nil {
+			m.logger.ErrorContext(ctx, "Failed to release idempotency key", "error", err)
+		}
+	}()
+
+	next.ServeHTTP(recorder, r)
+
+	record.StatusCode = cmp.Or(recorder.status, http.StatusOK)
+	if record.StatusCode >= http.StatusInternalServerError {
+		return
+	}
+	record.ContentType = w.Header().Get("Content-Type")
+	record.Location = w.Header().Get("Location")
+	record.ETag = w.Header().Get("ETag")
+	record.ResponseBody = recorder.body.Bytes()
+	if err := m.repository.Complete(ctx, record); err != nil {
+		m.logger.ErrorContext(ctx, "Failed to store idempotent response", "error", err)
+		return
+	}
+	completed = true
+}
+
+func writeInProgress(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Retry-After", "1")
+	rest.WriteError(w, r, http.StatusConflict, "A request with this Idempotency-Key is still being processed")
+}
+
+// requestHash fingerprints the request a key was first used with, so that
+// reusing the key for a different request can be detected.
+func requestHash(r *http.Request, body []byte) string {
+	hash := sha256.New()
+	io.WriteString(hash, r.Method+" "+r.URL.Path+"\n")
+	hash.Write(body)
+	return hex.EncodeToString(hash.Sum(nil))
+}
+
+// responseRecorder passes the response through while keeping a copy.
+type responseRecorder struct {
+	http.ResponseWriter
+	status int
+	body   bytes.Buffer
+}
+
+func (r *responseRecorder) WriteHeader(status int) {
+	if r.status == 0 {
+		r.status = status
+	}
+	r.ResponseWriter.WriteHeader(status)
+}
+
+func (r *responseRecorder) Write(b []byte) (int, error) {
+	if r.status == 0 {
+		r.status = http.StatusOK
+	}
+	r.body.Write(b)
+	return r.ResponseWriter.Write(b)
+}
@@ -0,0 +1,243 @@
+package middleware_test
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/mathefer/tc-fiap-product/internal/product/domain/domainerrors"
+	"github.com/mathefer/tc-fiap-product/internal/product/domain/entities"
+	"github.com/mathefer/tc-fiap-product/internal/product/infrastructure/api/middleware"
+	"github.com/mathefer/tc-fiap-product/internal/product/infrastructure/persistence"
+	"github.com/mathefer/tc-fiap-product/pkg/logging"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+)
+
+type IdempotencyTestSuite struct {
+	suite.Suite
+	repository  *persistence.InMemoryIdempotencyKeyRepository
+	idempotency *middleware.Idempotency
+	calls       atomic.Int32
+	status      int
+	handler     http.Handler
+}
+
+func (suite *IdempotencyTestSuite) SetupTest() {
+	suite.repository = persistence.NewInMemoryIdempotencyKeyRepository(persistence.NewInMemoryStore())
+	suite.idempotency = middleware.NewIdempotency(suite.repository, time.Hour, time.Minute, logging.NewNop())
+	suite.calls.Store(0)
+	suite.status = http.StatusCreated
+	suite.handler = suite.idempotency.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := suite.calls.Add(1)
+		body, _ := io.ReadAll(r.Body)
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("Location", "/v1/product/7")
+		w.Header().Set("ETag", `W/"1"`)
+		w.WriteHeader(suite.status)
+		fmt.Fprintf(w, `{"call":%d,"body":%s}`, n, body)
+	}))
+}
+
+func TestIdempotencyTestSuite(t *testing.T) {
+	suite.Run(t, new(IdempotencyTestSuite))
+}
+
+func (suite *IdempotencyTestSuite) post(key, body string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodPost, "/v1/product", strings.NewReader(body))
+	if key != "" {
+		req.Header.Set(middleware.IdempotencyKeyHeader, key)
+	}
+	w := httptest.NewRecorder()
+	suite.handler.ServeHTTP(w, req)
+	return w
+}
+
+func (suite *IdempotencyTestSuite) TestWithoutKey_RunsEveryTime() {
+	// Act
+	first := suite.post("", `{"name":"Pizza"}`)
+	second := suite.post("", `{"name":"Pizza"}`)
+
+	// Assert
+	assert.Equal(suite.T(), http.StatusCreated, first.Code)
+	assert.Equal(suite.T(), http.StatusCreated, second.Code)
+	assert.Equal(suite.T(), int32(2), suite.calls.Load())
+}
+
+func (suite *IdempotencyTestSuite) TestRepeat_ReplaysFirstResponse() {
+	// Act
+	first := suite.post("abc", `{"name":"Pizza"}`)
+	second := suite.post("abc", `{"name":"Pizza"}`)
+
+	// Assert
+	assert.Equal(suite.T(), int32(1), suite.calls.Load())
+	assert.Equal(suite.T(), http.StatusCreated, second.Code)
+	assert.Equal(suite.T(), first.Body.String(), second.Body.String())
+	assert.Equal(suite.T(), "application/json", second.Header().Get("Content-Type"))
+	assert.Equal(suite.T(), "/v1/product/7", second.Header().Get("Location"))
+	assert.Equal(suite.T(), `W/"1"`, second.Header().Get("ETag"))
+	assert.Equal(suite.T(), "true", second.Header().Get(middleware.IdempotentReplayedHeader))
+	assert.Empty(suite.T(), first.Header().Get(middleware.IdempotentReplayedHeader))
+}
+
+func (suite *IdempotencyTestSuite) TestRepeat_DifferentBody() {
+	// Arrange
+	suite.post("abc", `{"name":"Pizza"}`)
+
+	// Act
+	w := suite.post("abc", `{"name":"Hamburguer"}`)
+
+	// Assert
+	assert.Equal(suite.T(), http.StatusUnprocessableEntity, w.Code)
+	assert.Contains(suite.T(), w.Body.String(), "already used with a different request")
+	assert.Equal(suite.T(), int32(1), suite.calls.Load())
+}
+
+func (suite *IdempotencyTestSuite) TestServerError_ReleasesKey() {
+	// Arrange
+	suite.status = http.StatusInternalServerError
+	suite.post("abc", `{"name":"Pizza"}`)
+	suite.status = http.StatusCreated
+
+	// Act
+	w := suite.post("abc", `{"name":"Pizza"}`)
+
+	// Assert - the retry ran again instead of replaying the failure
+	assert.Equal(suite.T(), http.StatusCreated, w.Code)
+	assert.Equal(suite.T(), int32(2), suite.calls.Load())
+}
+
+func (suite *IdempotencyTestSuite) TestClientError_IsReplayed() {
+	// Arrange
+	suite.status = http.StatusUnprocessableEntity
+	suite.post("abc", `{"name":""}`)
+
+	// Act
+	w := suite.post("abc", `{"name":""}`)
+
+	// Assert
+	assert.Equal(suite.T(), http.StatusUnprocessableEntity, w.Code)
+	assert.Equal(suite.T(), int32(1), suite.calls.Load())
+}
+
+func (suite *IdempotencyTestSuite) TestExpiredKey_RunsAgain() {
+	// Arrange
+	require.NoError(suite.T(), suite.repository.Create(context.Background(), &entities.IdempotencyKey{
+		Key: "abc", RequestHash: "other", StatusCode: http.StatusCreated, CreatedAt: time.Now().Add(-2 * time.Hour),
+	}))
+
+	// Act
+	w := suite.post("abc", `{"name":"Pizza"}`)
+
+	// Assert
+	assert.Equal(suite.T(), http.StatusCreated, w.Code)
+	assert.Empty(suite.T(), w.Header().Get(middleware.IdempotentReplayedHeader))
+	assert.Equal(suite.T(), int32(1), suite.calls.Load())
+}
+
+func (suite *IdempotencyTestSuite) TestAbandonedKey_RunsAgain() {
+	// Arrange - claimed by a request that never finished
+	require.NoError(suite.T(), suite.repository.Create(context.Background(), &entities.IdempotencyKey{
+		Key: "abc", RequestHash: "other", CreatedAt: time.Now().Add(-2 * time.Minute),
+	}))
+
+	// Act
+	w := suite.post("abc", `{"name":"Pizza"}`)
+
+	// Assert
+	assert.Equal(suite.T(), http.StatusCreated, w.Code)
+	assert.Equal(suite.T(), int32(1), suite.calls.Load())
+}
+
+func (suite *IdempotencyTestSuite) TestInProgressKey_WithinLease() {
+	// Arrange
+	require.NoError(suite.T(), suite.repository.Create(context.Background(), &entities.IdempotencyKey{
+		Key: "abc", RequestHash: "other", CreatedAt: time.Now().Add(-30 * time.Second),
+	}))
+
+	// Act
+	w := suite.post("abc", `{"name":"Pizza"}`)
+
+	// Assert
+	assert.Equal(suite.T(), http.StatusUnprocessableEntity, w.Code)
+	assert.Equal(suite.T(), int32(0), suite.calls.Load())
+}
+
+func (suite *IdempotencyTestSuite) TestBodyTooLarge() {
+	// Act
+	w := suite.post("abc", `{"name":"`+strings.Repeat("x", 1<<20)+`"}`)
+
+	// Assert
+	assert.Equal(suite.T(), http.StatusRequestEntityTooLarge, w.Code)
+	assert.Equal(suite.T(), int32(0), suite.calls.Load())
+}
+
+func (suite *IdempotencyTestSuite) TestKeyTooLong() {
+	// Act
+	w := suite.post(strings.Repeat("k", 256), `{"name":"Pizza"}`)
+
+	// Assert
+	assert.Equal(suite.T(), http.StatusBadRequest, w.Code)
+	assert.Equal(suite.T(), int32(0), suite.calls.Load())
+}
+
+func (suite *IdempotencyTestSuite) TestConcurrentDuplicates_RunOnce() {
+	// Arrange - the first request blocks until every duplicate has answered
+	release := make(chan struct{})
+	suite.handler = suite.idempotency.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		suite.calls.Add(1)
+		<-release
+		w.WriteHeader(http.StatusCreated)
+	}))
+
+	first := make(chan int)
+	go func() { first <- suite.post("abc", `{"name":"Pizza"}`).Code }()
+	require.Eventually(suite.T(), func() bool { return suite.calls.Load() == 1 }, time.Second, time.Millisecond)
+
+	// Act
+	var wg sync.WaitGroup
+	duplicates := make([]*httptest.ResponseRecorder, 10)
+	for i := range duplicates {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			duplicates[i] = suite.post("abc", `{"name":"Pizza"}`)
+		}()
+	}
+	wg.Wait()
+	close(release)
+
+	// Assert
+	assert.Equal(suite.T(), http.StatusCreated, <-first)
+	for _, w := range duplicates {
+		assert.Equal(suite.T(), http.StatusConflict, w.Code)
+		assert.Equal(suite.T(), "1", w.Header().Get("Retry-After"))
+	}
+	assert.Equal(suite.T(), int32(1), suite.calls.Load())
+	assert.Equal(suite.T(), http.StatusCreated, suite.post("abc", `{"name":"Pizza"}`).Code)
+}
+
+func (suite *IdempotencyTestSuite) TestDeleteExpired() {
+	// Arrange
+	ctx := context.Background()
+	require.NoError(suite.T(), suite.repository.Create(ctx, &entities.IdempotencyKey{Key: "old", CreatedAt: time.Now().Add(-2 * time.Hour)}))
+	require.NoError(suite.T(), suite.repository.Create(ctx, &entities.IdempotencyKey{Key: "new", CreatedAt: time.Now()}))
+
+	// Act
+	err := suite.idempotency.DeleteExpired(ctx)
+
+	// Assert
+	assert.NoError(suite.T(), err)
+	_, err = suite.repository.GetByKey(ctx, "old")
+	assert.ErrorIs(suite.T(), err, domainerrors.ErrNotFound)
+	_, err = suite.repository.GetByKey(ctx, "new")
+	assert.NoError(suite.T(), err)
+}
@@ -0,0 +1,101 @@
+package persistence
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	"github.com/mathefer/tc-fiap-product/internal/product/domain/domainerrors"
+	"github.com/mathefer/tc-fiap-product/internal/product/domain/entities"
+	"github.com/mathefer/tc-fiap-product/internal/product/domain/repositories"
+	"gorm.io/gorm"
+)
+
+var (
+	_ repositories.CategoryRepository = (*CategoryRepositoryImpl)(nil)
+)
+
+const categoryResource = "category"
+
+type CategoryRepositoryImpl struct {
+	db     *gorm.DB
+	logger *slog.Logger
+}
+
+func NewCategoryRepositoryImpl(db *gorm.DB, logger *slog.Logger) *CategoryRepositoryImpl {
+	return &CategoryRepositoryImpl{db: db, logger: logger}
+}
+
+func (r *CategoryRepositoryImpl) List(ctx context.Context, activeOnly bool) ([]*entities.Category, error) {
+	query := r.db.WithContext(ctx).Model(&entities.Category{})
+	if activeOnly {
+		query = query.Where("active = ?", true)
+	}
+
+	var categories []*entities.Category
+	if err := query.Order("sort_order").Order("id").Find(&categories).Error; err != nil {
+		return []*entities.Category{}, err
+	}
+	return categories, nil
+}
+
+func (r *CategoryRepositoryImpl) GetByID(ctx context.Context, id uint) (*entities.Category, error) {
+	var category entities.Category
+	if err := r.db.WithContext(ctx).First(&category, id).Error; err != nil {
+		return nil, translateCategoryError(err, id)
+	}
+	return &category, nil
+}
+
+func (r *CategoryRepositoryImpl) Add(ctx context.Context, category *entities.Category) error {
+	if err := r.db.WithContext(ctx).Create(category).Error; err != nil {
+		return r.translate(ctx, err, category.ID)
+	}
+	return nil
+}
+
+// Update replaces every editable column of the category, including false and
+// zero values.
+func (r *CategoryRepositoryImpl) Update(ctx context.Context, category *entities.Category) error {
+	result := r.db.WithContext(ctx).Model(&entities.Category{}).
+		Where("id = ?", category.ID).
+		Select("display_name", "sort_order", "active").
+		Updates(category)
+	if result.Error != nil {
+		return r.translate(ctx, result.Error, category.ID)
+	}
+	if result.RowsAffected == 0 {
+		return domainerrors.NewNotFoundError(categoryResource, category.ID)
+	}
+	return nil
+}
+
+func (r *CategoryRepositoryImpl) Delete(ctx context.Context, id uint) error {
+	result := r.db.WithContext(ctx).Delete(&entities.Category{}, id)
+	if result.Error != nil {
+		return r.translate(ctx, result.Error, id)
+	}
+	if result.RowsAffected == 0 {
+		return domainerrors.NewNotFoundError(categoryResource, id)
+	}
+	return nil
+}
+
+// translate converts err into a domain error, logging the database's own
+// message for conflicts as ProductRepositoryImpl does.
+func (r *CategoryRepositoryImpl) translate(ctx context.Context, err error, id uint) error {
+	translated := translateCategoryError(err, id)
+	if errors.Is(translated, domainerrors.ErrConflict) {
+		r.logger.WarnContext(ctx, "Category write rejected by the database", "error", err)
+	}
+	return translated
+}
+
+// translateCategoryError reports a foreign key violation as a category that
+// is still in use; everything else is translated like any other resource.
+func translateCategoryError(err error, id uint) error {
+	if errors.Is(err, gorm.ErrForeignKeyViolated) {
+		return domainerrors.NewConflictError("category is still used by products")
+	}
+	return translateError(err, categoryResource, id)
+}
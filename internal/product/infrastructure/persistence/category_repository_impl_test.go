@@ -0,0 +1,251 @@
+package persistence_test
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"github.com/mathefer/tc-fiap-product/internal/product/domain/domainerrors"
+	"github.com/mathefer/tc-fiap-product/internal/product/domain/entities"
+	"github.com/mathefer/tc-fiap-product/internal/product/infrastructure/persistence"
+	"github.com/mathefer/tc-fiap-product/pkg/logging"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+)
+
+type CategoryRepositoryTestSuite struct {
+	suite.Suite
+	mockDB     sqlmock.Sqlmock
+	db         *gorm.DB
+	repository *persistence.CategoryRepositoryImpl
+}
+
+func (suite *CategoryRepositoryTestSuite) SetupTest() {
+	var err error
+	var sqlDB *sql.DB
+	sqlDB, suite.mockDB, err = sqlmock.New()
+	if err != nil {
+		suite.T().Fatalf("Failed to open mock sql db, got error: %v", err)
+	}
+
+	suite.db, err = gorm.Open(postgres.New(postgres.Config{
+		Conn: sqlDB,
+	}), &gorm.Config{})
+	if err != nil {
+		suite.T().Fatalf("Failed to open gorm db, got error: %v", err)
+	}
+
+	suite.repository = persistence.NewCategoryRepositoryImpl(suite.db, logging.NewNop())
+}
+
+func TestCategoryRepositoryTestSuite(t *testing.T) {
+	suite.Run(t, new(CategoryRepositoryTestSuite))
+}
+
+func (suite *CategoryRepositoryTestSuite) TestList_All() {
+	// Arrange
+	now := time.Now()
+	rows := sqlmock.NewRows([]string{"id", "created_at", "display_name", "sort_order", "active"}).
+		AddRow(1, now, "Lanche", 1, true).
+		AddRow(5, now, "Combo", 2, false)
+
+	suite.mockDB.ExpectQuery(`SELECT \* FROM "category" ORDER BY sort_order,id`).
+		WillReturnRows(rows)
+
+	// Act
+	categories, err := suite.repository.List(context.Background(), false)
+
+	// Assert
+	assert.NoError(suite.T(), err)
+	assert.Len(suite.T(), categories, 2)
+	assert.Equal(suite.T(), "Lanche", categories[0].DisplayName)
+	assert.False(suite.T(), categories[1].Active)
+	assert.NoError(suite.T(), suite.mockDB.ExpectationsWereMet())
+}
+
+func (suite *CategoryRepositoryTestSuite) TestList_ActiveOnly() {
+	// Arrange
+	rows := sqlmock.NewRows([]string{"id", "created_at", "display_name", "sort_order", "active"}).
+		AddRow(1, time.Now(), "Lanche", 1, true)
+
+	suite.mockDB.ExpectQuery(`SELECT \* FROM "category" WHERE active = \$1 ORDER BY sort_order,id`).
+		WithArgs(true).
+		WillReturnRows(rows)
+
+	// Act
+	categories, err := suite.repository.List(context.Background(), true)
+
+	// Assert
+	assert.NoError(suite.T(), err)
+	assert.Len(suite.T(), categories, 1)
+	assert.NoError(suite.T(), suite.mockDB.ExpectationsWereMet())
+}
+
+func (suite *CategoryRepositoryTestSuite) TestList_DatabaseError() {
+	// Arrange
+	suite.mockDB.ExpectQuery(`SELECT \* FROM "category"`).
+		WillReturnError(errors.New("database connection error"))
+
+	// Act
+	categories, err := suite.repository.List(context.Background(), false)
+
+	// Assert
+	assert.Error(suite.T(), err)
+	assert.NotNil(suite.T(), categories)
+	assert.Len(suite.T(), categories, 0)
+}
+
+func (suite *CategoryRepositoryTestSuite) TestGetByID_Success() {
+	// Arrange
+	rows := sqlmock.NewRows([]string{"id", "created_at", "display_name", "sort_order", "active"}).
+		AddRow(3, time.Now(), "Bebida", 3, true)
+
+	suite.mockDB.ExpectQuery(`SELECT \* FROM "category" WHERE "category"."id" = \$1 ORDER BY "category"."id" LIMIT \$2`).
+		WithArgs(3, 1).
+		WillReturnRows(rows)
+
+	// Act
+	category, err := suite.repository.GetByID(context.Background(), 3)
+
+	// Assert
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), uint(3), category.ID)
+	assert.Equal(suite.T(), "Bebida", category.DisplayName)
+	assert.NoError(suite.T(), suite.mockDB.ExpectationsWereMet())
+}
+
+func (suite *CategoryRepositoryTestSuite) TestGetByID_NotFound() {
+	// Arrange
+	suite.mockDB.ExpectQuery(`SELECT \* FROM "category"`).
+		WithArgs(99, 1).
+		WillReturnError(gorm.ErrRecordNotFound)
+
+	// Act
+	category, err := suite.repository.GetByID(context.Background(), 99)
+
+	// Assert
+	assert.Nil(suite.T(), category)
+	assert.ErrorIs(suite.T(), err, domainerrors.ErrNotFound)
+}
+
+func (suite *CategoryRepositoryTestSuite) TestAdd_Success() {
+	// Arrange
+	category := &entities.Category{DisplayName: "Combo", SortOrder: 5, Active: false}
+
+	suite.mockDB.ExpectBegin()
+	suite.mockDB.ExpectQuery(`INSERT INTO "category"`).
+		WithArgs(category.DisplayName, category.SortOrder, category.Active).
+		WillReturnRows(sqlmock.NewRows([]string{"created_at", "id"}).AddRow(time.Now(), 5))
+	suite.mockDB.ExpectCommit()
+
+	// Act
+	err := suite.repository.Add(context.Background(), category)
+
+	// Assert
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), uint(5), category.ID)
+	assert.NoError(suite.T(), suite.mockDB.ExpectationsWereMet())
+}
+
+func (suite *CategoryRepositoryTestSuite) TestAdd_Duplicated() {
+	// Arrange
+	category := &entities.Category{DisplayName: "Lanche", SortOrder: 1, Active: true}
+
+	suite.mockDB.ExpectBegin()
+	suite.mockDB.ExpectQuery(`INSERT INTO "category"`).
+		WillReturnError(gorm.ErrDuplicatedKey)
+	suite.mockDB.ExpectRollback()
+
+	// Act
+	err := suite.repository.Add(context.Background(), category)
+
+	// Assert
+	assert.ErrorIs(suite.T(), err, domainerrors.ErrConflict)
+}
+
+func (suite *CategoryRepositoryTestSuite) TestUpdate_Success() {
+	// Arrange
+	category := &entities.Category{ID: 5, DisplayName: "Combo", SortOrder: 5, Active: false}
+
+	suite.mockDB.ExpectBegin()
+	// Update selects every editable column, so false and zero values are written too
+	suite.mockDB.ExpectExec(`UPDATE "category" SET`).
+		WithArgs(category.DisplayName, category.SortOrder, category.Active, category.ID).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	suite.mockDB.ExpectCommit()
+
+	// Act
+	err := suite.repository.Update(context.Background(), category)
+
+	// Assert
+	assert.NoError(suite.T(), err)
+	assert.NoError(suite.T(), suite.mockDB.ExpectationsWereMet())
+}
+
+func (suite *CategoryRepositoryTestSuite) TestUpdate_NotFound() {
+	// Arrange
+	category := &entities.Category{ID: 99, DisplayName: "Combo", SortOrder: 5, Active: true}
+
+	suite.mockDB.ExpectBegin()
+	suite.mockDB.ExpectExec(`UPDATE "category" SET`).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	suite.mockDB.ExpectCommit()
+
+	// Act
+	err := suite.repository.Update(context.Background(), category)
+
+	// Assert
+	assert.ErrorIs(suite.T(), err, domainerrors.ErrNotFound)
+}
+
+func (suite *CategoryRepositoryTestSuite) TestDelete_Success() {
+	// Arrange
+	suite.mockDB.ExpectBegin()
+	suite.mockDB.ExpectExec(`DELETE FROM "category"`).
+		WithArgs(5).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	suite.mockDB.ExpectCommit()
+
+	// Act
+	err := suite.repository.Delete(context.Background(), 5)
+
+	// Assert
+	assert.NoError(suite.T(), err)
+	assert.NoError(suite.T(), suite.mockDB.ExpectationsWereMet())
+}
+
+func (suite *CategoryRepositoryTestSuite) TestDelete_NotFound() {
+	// Arrange
+	suite.mockDB.ExpectBegin()
+	suite.mockDB.ExpectExec(`DELETE FROM "category"`).
+		WithArgs(99).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	suite.mockDB.ExpectCommit()
+
+	// Act
+	err := suite.repository.Delete(context.Background(), 99)
+
+	// Assert
+	assert.ErrorIs(suite.T(), err, domainerrors.ErrNotFound)
+}
+
+func (suite *CategoryRepositoryTestSuite) TestDelete_StillInUse() {
+	// Arrange
+	suite.mockDB.ExpectBegin()
+	suite.mockDB.ExpectExec(`DELETE FROM "category"`).
+		WithArgs(1).
+		WillReturnError(gorm.ErrForeignKeyViolated)
+	suite.mockDB.ExpectRollback()
+
+	// Act
+	err := suite.repository.Delete(context.Background(), 1)
+
+	// Assert
+	assert.ErrorIs(suite.T(), err, domainerrors.ErrConflict)
+	assert.Contains(suite.T(), err.Error(), "still used by products")
+}
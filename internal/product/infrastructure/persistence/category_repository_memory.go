@@ -0,0 +1,131 @@
+package persistence
+
+import (
+	"cmp"
+	"context"
+	"slices"
+	"time"
+
+	"github.com/mathefer/tc-fiap-product/internal/product/domain/domainerrors"
+	"github.com/mathefer/tc-fiap-product/internal/product/domain/entities"
+	"github.com/mathefer/tc-fiap-product/internal/product/domain/repositories"
+)
+
+var (
+	_ repositories.CategoryRepository = (*InMemoryCategoryRepository)(nil)
+)
+
+// InMemoryCategoryRepository keeps categories in an InMemoryStore and
+// behaves like CategoryRepositoryImpl, including the unique display name and
+// the restriction on deleting categories that products still use.
+type InMemoryCategoryRepository struct {
+	store *InMemoryStore
+}
+
+func NewInMemoryCategoryRepository(store *InMemoryStore) *InMemoryCategoryRepository {
+	return &InMemoryCategoryRepository{store: store}
+}
+
+func (r *InMemoryCategoryRepository) List(ctx context.Context, activeOnly bool) ([]*entities.Category, error) {
+	categories := []*entities.Category{}
+	err := r.store.read(ctx, func() error {
+		for _, stored := range r.store.categories {
+			if !activeOnly || stored.Active {
+				category := stored
+				categories = append(categories, &category)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return []*entities.Category{}, err
+	}
+
+	slices.SortFunc(categories, func(a, b *entities.Category) int {
+		if c := cmp.Compare(a.SortOrder, b.SortOrder); c != 0 {
+			return c
+		}
+		return cmp.Compare(a.ID, b.ID)
+	})
+	return categories, nil
+}
+
+func (r *InMemoryCategoryRepository) GetByID(ctx context.Context, id uint) (*entities.Category, error) {
+	var category *entities.Category
+	err := r.store.read(ctx, func() error {
+		stored, ok := r.store.categories[id]
+		if !ok {
+			return domainerrors.NewNotFoundError(categoryResource, id)
+		}
+		category = &stored
+		return nil
+	})
+	return category, err
+}
+
+func (r *InMemoryCategoryRepository) Add(ctx context.Context, category *entities.Category) error {
+	return r.store.write(ctx, func() error {
+		if category.ID == 0 {
+			category.ID = r.store.nextCategoryID
+		}
+		if _, ok := r.store.categories[category.ID]; ok || r.displayNameTaken(category) {
+			return domainerrors.NewConflictError(categoryResource + " already exists")
+		}
+		if category.CreatedAt.IsZero() {
+			category.CreatedAt = time.Now()
+		}
+
+		r.store.categories[category.ID] = *category
+		r.store.nextCategoryID = max(r.store.nextCategoryID, category.ID+1)
+		return nil
+	})
+}
+
+// Update replaces every editable column of the category, including false and
+// zero values.
+func (r *InMemoryCategoryRepository) Update(ctx context.Context, category *entities.Category) error {
+	return r.store.write(ctx, func() error {
+		stored, ok := r.store.categories[category.ID]
+		if !ok {
+			return domainerrors.NewNotFoundError(categoryResource, category.ID)
+		}
+		if r.displayNameTaken(category) {
+			return domainerrors.NewConflictError(categoryResource + " already exists")
+		}
+
+		stored.DisplayName = category.DisplayName
+		stored.SortOrder = category.SortOrder
+		stored.Active = category.Active
+		r.store.categories[category.ID] = stored
+		return nil
+	})
+}
+
+func (r *InMemoryCategoryRepository) Delete(ctx context.Context, id uint) error {
+	return r.store.write(ctx, func() error {
+		if _, ok := r.store.categories[id]; !ok {
+			return domainerrors.NewNotFoundError(categoryResource, id)
+		}
+		// Soft-deleted products keep their row, so they still hold the
+		// category like the foreign key does.
+		for _, product := range r.store.products {
+			if product.Category == int(id) {
+				return domainerrors.NewConflictError("category is still used by products")
+			}
+		}
+
+		delete(r.store.categories, id)
+		return nil
+	})
+}
+
+// displayNameTaken reports whether another category already uses the display
+// name. The caller must hold the store's lock.
+func (r *InMemoryCategoryRepository) displayNameTaken(category *entities.Category) bool {
+	for _, stored := range r.store.categories {
+		if stored.ID != category.ID && stored.DisplayName == category.DisplayName {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,108 @@
+package persistence_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"github.com/mathefer/tc-fiap-product/internal/product/domain/domainerrors"
+	"github.com/mathefer/tc-fiap-product/internal/product/domain/entities"
+	"github.com/mathefer/tc-fiap-product/internal/product/infrastructure/persistence"
+)
+
+type InMemoryCategoryRepositoryTestSuite struct {
+	suite.Suite
+	ctx        context.Context
+	products   *persistence.InMemoryProductRepository
+	repository *persistence.InMemoryCategoryRepository
+}
+
+func (suite *InMemoryCategoryRepositoryTestSuite) SetupTest() {
+	suite.ctx = context.Background()
+	store := persistence.NewInMemoryStore()
+	suite.products = persistence.NewInMemoryProductRepository(store)
+	suite.repository = persistence.NewInMemoryCategoryRepository(store)
+}
+
+func TestInMemoryCategoryRepositoryTestSuite(t *testing.T) {
+	suite.Run(t, new(InMemoryCategoryRepositoryTestSuite))
+}
+
+func (suite *InMemoryCategoryRepositoryTestSuite) TestList_SeededWithDefaults() {
+	// Act
+	categories, err := suite.repository.List(suite.ctx, false)
+
+	// Assert
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), entities.DefaultCategories(), categories)
+}
+
+func (suite *InMemoryCategoryRepositoryTestSuite) TestList_ActiveOnlyBySortOrder() {
+	// Arrange
+	assert.NoError(suite.T(), suite.repository.Add(suite.ctx, &entities.Category{DisplayName: "Combo", SortOrder: 0, Active: true}))
+	assert.NoError(suite.T(), suite.repository.Add(suite.ctx, &entities.Category{DisplayName: "Antigos", SortOrder: 0, Active: false}))
+
+	// Act
+	categories, err := suite.repository.List(suite.ctx, true)
+
+	// Assert
+	assert.NoError(suite.T(), err)
+	assert.Len(suite.T(), categories, 5)
+	assert.Equal(suite.T(), "Combo", categories[0].DisplayName)
+}
+
+func (suite *InMemoryCategoryRepositoryTestSuite) TestAdd_AssignsIDAfterDefaults() {
+	// Arrange
+	category := &entities.Category{DisplayName: "Combo", SortOrder: 5, Active: true}
+
+	// Act
+	err := suite.repository.Add(suite.ctx, category)
+	found, _ := suite.repository.GetByID(suite.ctx, category.ID)
+
+	// Assert
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), uint(5), category.ID)
+	assert.Equal(suite.T(), "Combo", found.DisplayName)
+}
+
+func (suite *InMemoryCategoryRepositoryTestSuite) TestAdd_DuplicateDisplayName() {
+	// Act
+	err := suite.repository.Add(suite.ctx, &entities.Category{DisplayName: "Bebida", SortOrder: 5})
+
+	// Assert
+	assert.ErrorIs(suite.T(), err, domainerrors.ErrConflict)
+}
+
+func (suite *InMemoryCategoryRepositoryTestSuite) TestUpdate() {
+	// Act
+	err := suite.repository.Update(suite.ctx, &entities.Category{ID: 3, DisplayName: "Bebidas", SortOrder: 9, Active: false})
+	updated, _ := suite.repository.GetByID(suite.ctx, 3)
+	notFound := suite.repository.Update(suite.ctx, &entities.Category{ID: 99, DisplayName: "X"})
+	conflict := suite.repository.Update(suite.ctx, &entities.Category{ID: 3, DisplayName: "Lanche"})
+
+	// Assert
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), "Bebidas", updated.DisplayName)
+	assert.False(suite.T(), updated.Active)
+	assert.ErrorIs(suite.T(), notFound, domainerrors.ErrNotFound)
+	assert.ErrorIs(suite.T(), conflict, domainerrors.ErrConflict)
+}
+
+func (suite *InMemoryCategoryRepositoryTestSuite) TestDelete_RestrictedWhileUsed() {
+	// Arrange - even a soft-deleted product keeps its category in use
+	product := &entities.Product{Name: "Agua", Category: 3, Price: entities.NewMoney(400, "BRL")}
+	assert.NoError(suite.T(), suite.products.Add(suite.ctx, product))
+	assert.NoError(suite.T(), suite.products.Delete(suite.ctx, product.ID, 0))
+
+	// Act
+	inUse := suite.repository.Delete(suite.ctx, 3)
+	unused := suite.repository.Delete(suite.ctx, 4)
+	_, err := suite.repository.GetByID(suite.ctx, 4)
+
+	// Assert
+	assert.ErrorIs(suite.T(), inUse, domainerrors.ErrConflict)
+	assert.NoError(suite.T(), unused)
+	assert.ErrorIs(suite.T(), err, domainerrors.ErrNotFound)
+	assert.ErrorIs(suite.T(), suite.repository.Delete(suite.ctx, 4), domainerrors.ErrNotFound)
+}
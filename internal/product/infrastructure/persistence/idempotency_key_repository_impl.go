@@ -0,0 +1,82 @@
+package persistence
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/mathefer/tc-fiap-product/internal/product/domain/domainerrors"
+	"github.com/mathefer/tc-fiap-product/internal/product/domain/entities"
+	"github.com/mathefer/tc-fiap-product/internal/product/domain/repositories"
+	"gorm.io/gorm"
+)
+
+var (
+	_ repositories.IdempotencyKeyRepository = (*IdempotencyKeyRepositoryImpl)(nil)
+)
+
+type IdempotencyKeyRepositoryImpl struct {
+	db *gorm.DB
+}
+
+func NewIdempotencyKeyRepositoryImpl(db *gorm.DB) *IdempotencyKeyRepositoryImpl {
+	return &IdempotencyKeyRepositoryImpl{db: db}
+}
+
+// Create inserts the key, relying on the primary key to reject a key that
+// is already taken, even by a concurrent request.
+func (r *IdempotencyKeyRepositoryImpl) Create(ctx context.Context, key *entities.IdempotencyKey) error {
+	err := r.db.WithContext(ctx).Create(key).Error
+	if errors.Is(err, gorm.ErrDuplicatedKey) {
+		return domainerrors.NewConflictError("idempotency key already exists")
+	}
+	return err
+}
+
+func (r *IdempotencyKeyRepositoryImpl) GetByKey(ctx context.Context, key string) (*entities.IdempotencyKey, error) {
+	var stored entities.IdempotencyKey
+	err := r.db.WithContext(ctx).Where("key = ?", key).First(&stored).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, idempotencyKeyNotFound(key)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &stored, nil
+}
+
+func (r *IdempotencyKeyRepositoryImpl) Complete(ctx context.Context, key *entities.IdempotencyKey) error {
+	result := r.db.WithContext(ctx).Model(&entities.IdempotencyKey{}).
+		Where("key = ?", key.Key).
+		Select("status_code", "content_type", "location", "etag", "response_body").
+		Updates(key)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return idempotencyKeyNotFound(key.Key)
+	}
+	return nil
+}
+
+func (r *IdempotencyKeyRepositoryImpl) Delete(ctx context.Context, key string) error {
+	return r.db.WithContext(ctx).Where("key = ?", key).Delete(&entities.IdempotencyKey{}).Error
+}
+
+func (r *IdempotencyKeyRepositoryImpl) DeleteAbandoned(ctx context.Context, key string, before time.Time) error {
+	return r.db.WithContext(ctx).
+		Where("key = ? AND status_code = 0 AND created_at < ?", key, before).
+		Delete(&entities.IdempotencyKey{}).Error
+}
+
+func (r *IdempotencyKeyRepositoryImpl) DeleteExpired(ctx context.Context, before time.Time) (int64, error) {
+	result := r.db.WithContext(ctx).Where("created_at < ?", before).Delete(&entities.IdempotencyKey{})
+	return result.RowsAffected, result.Error
+}
+
+// idempotencyKeyNotFound reports an unknown key. Keys are strings, so the
+// error does not use domainerrors.NotFoundError.
+func idempotencyKeyNotFound(key string) error {
+	return fmt.Errorf("idempotency key %q: %w", key, domainerrors.ErrNotFound)
+}
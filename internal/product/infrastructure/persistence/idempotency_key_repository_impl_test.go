@@ -0,0 +1,152 @@
+package persistence_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/mathefer/tc-fiap-product/internal/product/domain/domainerrors"
+	"github.com/mathefer/tc-fiap-product/internal/product/domain/entities"
+	"github.com/mathefer/tc-fiap-product/internal/product/domain/repositories"
+	"github.com/mathefer/tc-fiap-product/internal/product/infrastructure/persistence"
+	"github.com/mathefer/tc-fiap-product/pkg/storage/sqlite"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+)
+
+// IdempotencyKeyRepositoryTestSuite runs against both implementations, on
+// SQLite for the GORM one so that the primary key really rejects duplicates.
+// The in-memory runner lives in idempotency_key_repository_memory_test.go.
+type IdempotencyKeyRepositoryTestSuite struct {
+	suite.Suite
+	ctx           context.Context
+	newRepository func(t *testing.T) repositories.IdempotencyKeyRepository
+	repository    repositories.IdempotencyKeyRepository
+}
+
+func (suite *IdempotencyKeyRepositoryTestSuite) SetupTest() {
+	suite.ctx = context.Background()
+	suite.repository = suite.newRepository(suite.T())
+}
+
+func TestIdempotencyKeyRepositoryImplTestSuite(t *testing.T) {
+	suite.Run(t, &IdempotencyKeyRepositoryTestSuite{newRepository: func(t *testing.T) repositories.IdempotencyKeyRepository {
+		db, err := sqlite.NewDB(sqlite.MemoryPath)
+		require.NoError(t, err)
+		require.NoError(t, persistence.AutoMigrate(context.Background(), db))
+		return persistence.NewIdempotencyKeyRepositoryImpl(db)
+	}})
+}
+
+func (suite *IdempotencyKeyRepositoryTestSuite) create(key string, createdAt time.Time) {
+	require.NoError(suite.T(), suite.repository.Create(suite.ctx, &entities.IdempotencyKey{Key: key, RequestHash: "hash", CreatedAt: createdAt}))
+}
+
+func (suite *IdempotencyKeyRepositoryTestSuite) TestCreate_DuplicateKey() {
+	// Arrange
+	suite.create("abc", time.Now())
+
+	// Act
+	err := suite.repository.Create(suite.ctx, &entities.IdempotencyKey{Key: "abc", RequestHash: "other", CreatedAt: time.Now()})
+
+	// Assert
+	assert.ErrorIs(suite.T(), err, domainerrors.ErrConflict)
+}
+
+func (suite *IdempotencyKeyRepositoryTestSuite) TestCompleteAndGetByKey() {
+	// Arrange
+	suite.create("abc", time.Now())
+
+	// Act
+	err := suite.repository.Complete(suite.ctx, &entities.IdempotencyKey{
+		Key:          "abc",
+		StatusCode:   http.StatusCreated,
+		ContentType:  "application/json",
+		Location:     "/v1/product/7",
+		ETag:         `W/"1"`,
+		ResponseBody: []byte(`{"id":7}`),
+	})
+	stored, getErr := suite.repository.GetByKey(suite.ctx, "abc")
+
+	// Assert
+	assert.NoError(suite.T(), err)
+	require.NoError(suite.T(), getErr)
+	assert.True(suite.T(), stored.Completed())
+	assert.Equal(suite.T(), "hash", stored.RequestHash)
+	assert.Equal(suite.T(), http.StatusCreated, stored.StatusCode)
+	assert.Equal(suite.T(), "application/json", stored.ContentType)
+	assert.Equal(suite.T(), "/v1/product/7", stored.Location)
+	assert.Equal(suite.T(), `W/"1"`, stored.ETag)
+	assert.Equal(suite.T(), []byte(`{"id":7}`), stored.ResponseBody)
+}
+
+func (suite *IdempotencyKeyRepositoryTestSuite) TestGetByKey_NotFound() {
+	// Act
+	stored, err := suite.repository.GetByKey(suite.ctx, "missing")
+
+	// Assert
+	assert.ErrorIs(suite.T(), err, domainerrors.ErrNotFound)
+	assert.Nil(suite.T(), stored)
+}
+
+func (suite *IdempotencyKeyRepositoryTestSuite) TestComplete_NotFound() {
+	// Act
+	err := suite.repository.Complete(suite.ctx, &entities.IdempotencyKey{Key: "missing", StatusCode: http.StatusCreated})
+
+	// Assert
+	assert.ErrorIs(suite.T(), err, domainerrors.ErrNotFound)
+}
+
+func (suite *IdempotencyKeyRepositoryTestSuite) TestDelete_ReleasesKey() {
+	// Arrange
+	suite.create("abc", time.Now())
+
+	// Act
+	err := suite.repository.Delete(suite.ctx, "abc")
+
+	// Assert - the key can be claimed again
+	assert.NoError(suite.T(), err)
+	assert.NoError(suite.T(), suite.repository.Create(suite.ctx, &entities.IdempotencyKey{Key: "abc", RequestHash: "hash", CreatedAt: time.Now()}))
+}
+
+func (suite *IdempotencyKeyRepositoryTestSuite) TestDeleteAbandoned() {
+	// Arrange
+	now := time.Now()
+	suite.create("abandoned", now.Add(-2*time.Minute))
+	suite.create("running", now)
+	suite.create("completed", now.Add(-2*time.Minute))
+	require.NoError(suite.T(), suite.repository.Complete(suite.ctx, &entities.IdempotencyKey{Key: "completed", StatusCode: http.StatusCreated}))
+
+	// Act
+	for _, key := range []string{"abandoned", "running", "completed"} {
+		require.NoError(suite.T(), suite.repository.DeleteAbandoned(suite.ctx, key, now.Add(-time.Minute)))
+	}
+
+	// Assert - only the key still in progress past the cutoff is released
+	_, err := suite.repository.GetByKey(suite.ctx, "abandoned")
+	assert.ErrorIs(suite.T(), err, domainerrors.ErrNotFound)
+	_, err = suite.repository.GetByKey(suite.ctx, "running")
+	assert.NoError(suite.T(), err)
+	_, err = suite.repository.GetByKey(suite.ctx, "completed")
+	assert.NoError(suite.T(), err)
+}
+
+func (suite *IdempotencyKeyRepositoryTestSuite) TestDeleteExpired() {
+	// Arrange
+	now := time.Now()
+	suite.create("old", now.Add(-2*time.Hour))
+	suite.create("new", now)
+
+	// Act
+	deleted, err := suite.repository.DeleteExpired(suite.ctx, now.Add(-time.Hour))
+
+	// Assert
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), int64(1), deleted)
+	_, err = suite.repository.GetByKey(suite.ctx, "old")
+	assert.ErrorIs(suite.T(), err, domainerrors.ErrNotFound)
+	_, err = suite.repository.GetByKey(suite.ctx, "new")
+	assert.NoError(suite.T(), err)
+}
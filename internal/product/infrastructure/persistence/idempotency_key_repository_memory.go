@@ -0,0 +1,103 @@
+package persistence
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"github.com/mathefer/tc-fiap-product/internal/product/domain/domainerrors"
+	"github.com/mathefer/tc-fiap-product/internal/product/domain/entities"
+	"github.com/mathefer/tc-fiap-product/internal/product/domain/repositories"
+)
+
+var (
+	_ repositories.IdempotencyKeyRepository = (*InMemoryIdempotencyKeyRepository)(nil)
+)
+
+// InMemoryIdempotencyKeyRepository keeps idempotency keys in an
+// InMemoryStore and behaves like IdempotencyKeyRepositoryImpl.
+type InMemoryIdempotencyKeyRepository struct {
+	store *InMemoryStore
+}
+
+func NewInMemoryIdempotencyKeyRepository(store *InMemoryStore) *InMemoryIdempotencyKeyRepository {
+	return &InMemoryIdempotencyKeyRepository{store: store}
+}
+
+func (r *InMemoryIdempotencyKeyRepository) Create(ctx context.Context, key *entities.IdempotencyKey) error {
+	return r.store.write(ctx, func() error {
+		if _, ok := r.store.idempotencyKeys[key.Key]; ok {
+			return domainerrors.NewConflictError("idempotency key already exists")
+		}
+		if key.CreatedAt.IsZero() {
+			key.CreatedAt = time.Now()
+		}
+
+		stored := *key
+		stored.ResponseBody = slices.Clone(key.ResponseBody)
+		r.store.idempotencyKeys[key.Key] = stored
+		return nil
+	})
+}
+
+func (r *InMemoryIdempotencyKeyRepository) GetByKey(ctx context.Context, key string) (*entities.IdempotencyKey, error) {
+	var found *entities.IdempotencyKey
+	err := r.store.read(ctx, func() error {
+		stored, ok := r.store.idempotencyKeys[key]
+		if !ok {
+			return idempotencyKeyNotFound(key)
+		}
+		stored.ResponseBody = slices.Clone(stored.ResponseBody)
+		found = &stored
+		return nil
+	})
+	return found, err
+}
+
+func (r *InMemoryIdempotencyKeyRepository) Complete(ctx context.Context, key *entities.IdempotencyKey) error {
+	return r.store.write(ctx, func() error {
+		stored, ok := r.store.idempotencyKeys[key.Key]
+		if !ok {
+			return idempotencyKeyNotFound(key.Key)
+		}
+
+		stored.StatusCode = key.StatusCode
+		stored.ContentType = key.ContentType
+		stored.Location = key.Location
+		stored.ETag = key.ETag
+		stored.ResponseBody = slices.Clone(key.ResponseBody)
+		r.store.idempotencyKeys[key.Key] = stored
+		return nil
+	})
+}
+
+func (r *InMemoryIdempotencyKeyRepository) Delete(ctx context.Context, key string) error {
+	return r.store.write(ctx, func() error {
+		delete(r.store.idempotencyKeys, key)
+		return nil
+	})
+}
+
+func (r *InMemoryIdempotencyKeyRepository) DeleteAbandoned(ctx context.Context, key string, before time.Time) error {
+	return r.store.write(ctx, func() error {
+		stored, ok := r.store.idempotencyKeys[key]
+		if ok && !stored.Completed() && stored.CreatedAt.Before(before) {
+			delete(r.store.idempotencyKeys, key)
+		}
+		return nil
+	})
+}
+
+func (r *InMemoryIdempotencyKeyRepository) DeleteExpired(ctx context.Context, before time.Time) (int64, error) {
+	var deleted int64
+	err := r.store.write(ctx, func() error {
+		for key, stored := range r.store.idempotencyKeys {
+			if stored.CreatedAt.Before(before) {
+				delete(r.store.idempotencyKeys, key)
+				deleted++
+			}
+		}
+		return nil
+	})
+	return deleted, err
+}
@@ -0,0 +1,15 @@
+package persistence_test
+
+import (
+	"testing"
+
+	"github.com/mathefer/tc-fiap-product/internal/product/domain/repositories"
+	"github.com/mathefer/tc-fiap-product/internal/product/infrastructure/persistence"
+	"github.com/stretchr/testify/suite"
+)
+
+func TestInMemoryIdempotencyKeyRepositoryTestSuite(t *testing.T) {
+	suite.Run(t, &IdempotencyKeyRepositoryTestSuite{newRepository: func(*testing.T) repositories.IdempotencyKeyRepository {
+		return persistence.NewInMemoryIdempotencyKeyRepository(persistence.NewInMemoryStore())
+	}})
+}
@@ -0,0 +1,59 @@
+package persistence
+
+import (
+	"context"
+	"sync"
+
+	"github.com/mathefer/tc-fiap-product/internal/product/domain/entities"
+)
+
+// InMemoryStore holds the products, categories and idempotency keys of the
+// in-memory repositories. The repositories share one store so that the
+// rules the database enforces across tables, such as a product's category
+// having to exist, hold here too. Its contents are lost when the process exits.
+type InMemoryStore struct {
+	mu              sync.RWMutex
+	products        map[uint]entities.Product
+	categories      map[uint]entities.Category
+	idempotencyKeys map[string]entities.IdempotencyKey
+	nextProductID   uint
+	nextCategoryID  uint
+}
+
+// NewInMemoryStore creates a store seeded with the default categories, like
+// a freshly migrated database.
+func NewInMemoryStore() *InMemoryStore {
+	store := &InMemoryStore{
+		products:        map[uint]entities.Product{},
+		categories:      map[uint]entities.Category{},
+		idempotencyKeys: map[string]entities.IdempotencyKey{},
+		nextProductID:   1,
+		nextCategoryID:  1,
+	}
+	for _, category := range entities.DefaultCategories() {
+		store.categories[category.ID] = *category
+		store.nextCategoryID = max(store.nextCategoryID, category.ID+1)
+	}
+	return store
+}
+
+// read runs fn under the read lock unless ctx is already done, as a query
+// on a canceled context would fail.
+func (s *InMemoryStore) read(ctx context.Context, fn func() error) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return fn()
+}
+
+// write runs fn under the write lock unless ctx is already done.
+func (s *InMemoryStore) write(ctx context.Context, fn func() error) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return fn()
+}
@@ -0,0 +1,199 @@
+package persistence
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/mathefer/tc-fiap-product/internal/product/domain/entities"
+	"github.com/mathefer/tc-fiap-product/internal/product/domain/repositories"
+	"github.com/mathefer/tc-fiap-product/pkg/cache"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	_ repositories.ProductRepository = (*CachedProductRepository)(nil)
+)
+
+const productLastModifiedKey = "product:last_modified"
+
+// CachedProductRepository decorates a ProductRepository with a read-through
+// cache of the menu queries: products by category, by ID and the catalog's
+// last modification. Every write invalidates the entries it may have
+// changed, whether it succeeded or not. The cache never fails a request: its
+// errors are logged and the decorated repository answers instead.
+//
+// An entry filled by a read that overlapped a write may survive its
+// invalidation, so entries are kept for a short ttl.
+type CachedProductRepository struct {
+	repository repositories.ProductRepository
+	cache      cache.Cache
+	ttl        time.Duration
+	requests   *prometheus.CounterVec
+	logger     *slog.Logger
+}
+
+func NewCachedProductRepository(repository repositories.ProductRepository, cache cache.Cache, ttl time.Duration, registerer prometheus.Registerer, logger *slog.Logger) *CachedProductRepository {
+	requests := promauto.With(registerer).NewCounterVec(prometheus.CounterOpts{
+		Name: "product_cache_requests_total",
+		Help: "Product cache lookups by query and result: hit, miss or error.",
+	}, []string{"query", "result"})
+	return &CachedProductRepository{repository: repository, cache: cache, ttl: ttl, requests: requests, logger: logger}
+}
+
+func (r *CachedProductRepository) Get(ctx context.Context, category uint, includeDeleted bool) ([]*entities.Product, error) {
+	var products []*entities.Product
+	err := r.read(ctx, "get", productCategoryKey(category, includeDeleted), &products, func() (err error) {
+		products, err = r.repository.Get(ctx, category, includeDeleted)
+		return err
+	})
+	if err != nil {
+		return []*entities.Product{}, err
+	}
+	return products, nil
+}
+
+func (r *CachedProductRepository) GetByID(ctx context.Context, id uint, includeDeleted bool) (*entities.Product, error) {
+	var product *entities.Product
+	err := r.read(ctx, "get_by_id", productIDKey(id, includeDeleted), &product, func() (err error) {
+		product, err = r.repository.GetByID(ctx, id, includeDeleted)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return product, nil
+}
+
+// GetByIDForUpdate always reads the decorated repository: an entry that
+// outlived its invalidation would otherwise fail the write with a stale
+// version.
+func (r *CachedProductRepository) GetByIDForUpdate(ctx context.Context, id uint) (*entities.Product, error) {
+	return r.repository.GetByIDForUpdate(ctx, id)
+}
+
+func (r *CachedProductRepository) GetByIDs(ctx context.Context, ids []uint, includeDeleted bool) ([]*entities.Product, error) {
+	return r.repository.GetByIDs(ctx, ids, includeDeleted)
+}
+
+func (r *CachedProductRepository) List(ctx context.Context, options repositories.ProductListOptions) ([]*entities.Product, int64, error) {
+	return r.repository.List(ctx, options)
+}
+
+func (r *CachedProductRepository) CountByCategory(ctx context.Context) (map[int]int64, error) {
+	return r.repository.CountByCategory(ctx)
+}
+
+func (r *CachedProductRepository) LastModified(ctx context.Context) (time.Time, error) {
+	var lastModified time.Time
+	err := r.read(ctx, "last_modified", productLastModifiedKey, &lastModified, func() (err error) {
+		lastModified, err = r.repository.LastModified(ctx)
+		return err
+	})
+	if err != nil {
+		return time.Time{}, err
+	}
+	return lastModified, nil
+}
+
+func (r *CachedProductRepository) Add(ctx context.Context, product *entities.Product) error {
+	err := r.repository.Add(ctx, product)
+	r.invalidate(ctx, product.ID, product.Category)
+	return err
+}
+
+// Update also invalidates the category the product is moved out of, which
+// it reads before writing.
+func (r *CachedProductRepository) Update(ctx context.Context, product *entities.Product) error {
+	categories := []int{product.Category}
+	if previous, err := r.repository.GetByID(ctx, product.ID, false); err == nil {
+		categories = append(categories, previous.Category)
+	}
+
+	err := r.repository.Update(ctx, product)
+	r.invalidate(ctx, product.ID, categories...)
+	return err
+}
+
+func (r *CachedProductRepository) Delete(ctx context.Context, id uint, version uint) error {
+	var categories []int
+	if previous, err := r.repository.GetByID(ctx, id, false); err == nil {
+		categories = append(categories, previous.Category)
+	}
+
+	err := r.repository.Delete(ctx, id, version)
+	r.invalidate(ctx, id, categories...)
+	return err
+}
+
+// Restore invalidates the category of the product once it is visible
+// again, as soft-deleted products cannot be read by ID.
+func (r *CachedProductRepository) Restore(ctx context.Context, id uint) error {
+	err := r.repository.Restore(ctx, id)
+
+	var categories []int
+	if restored, getErr := r.repository.GetByID(ctx, id, false); getErr == nil {
+		categories = append(categories, restored.Category)
+	}
+	r.invalidate(ctx, id, categories...)
+	return err
+}
+
+// read decodes the entry under key into value, or calls load to fill value
+// from the repository and stores it under key.
+func (r *CachedProductRepository) read(ctx context.Context, query, key string, value any, load func() error) error {
+	data, found, err := r.cache.Get(ctx, key)
+	switch {
+	case err != nil:
+		r.requests.WithLabelValues(query, "error").Inc()
+		r.logger.WarnContext(ctx, "Product cache read failed", "key", key, "error", err)
+	case found && json.Unmarshal(data, value) == nil:
+		r.requests.WithLabelValues(query, "hit").Inc()
+		return nil
+	default:
+		r.requests.WithLabelValues(query, "miss").Inc()
+	}
+
+	if err := load(); err != nil {
+		return err
+	}
+
+	data, err = json.Marshal(value)
+	if err == nil {
+		err = r.cache.Set(ctx, key, data, r.ttl)
+	}
+	if err != nil {
+		r.logger.WarnContext(ctx, "Product cache write failed", "key", key, "error", err)
+	}
+	return nil
+}
+
+// invalidate removes the entries of the product with the given ID and of
+// the given categories, and the last modification. It runs even if the
+// request was canceled, since the write may have been applied regardless.
+func (r *CachedProductRepository) invalidate(ctx context.Context, id uint, categories ...int) {
+	ctx = context.WithoutCancel(ctx)
+
+	keys := []string{productLastModifiedKey}
+	if id != 0 {
+		keys = append(keys, productIDKey(id, false), productIDKey(id, true))
+	}
+	for _, category := range categories {
+		keys = append(keys, productCategoryKey(uint(category), false), productCategoryKey(uint(category), true))
+	}
+
+	if err := r.cache.Delete(ctx, keys...); err != nil {
+		r.logger.ErrorContext(ctx, "Product cache invalidation failed", "keys", keys, "error", err)
+	}
+}
+
+func productIDKey(id uint, includeDeleted bool) string {
+	return fmt.Sprintf("product:id:%d:%t", id, includeDeleted)
+}
+
+func productCategoryKey(category uint, includeDeleted bool) string {
+	return fmt.Sprintf("product:category:%d:%t", category, includeDeleted)
+}
@@ -0,0 +1,307 @@
+package persistence_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/mathefer/tc-fiap-product/internal/product/domain/domainerrors"
+	"github.com/mathefer/tc-fiap-product/internal/product/domain/entities"
+	"github.com/mathefer/tc-fiap-product/internal/product/infrastructure/persistence"
+	mockRepositories "github.com/mathefer/tc-fiap-product/mocks/product/domain/repositories"
+	"github.com/mathefer/tc-fiap-product/pkg/cache"
+	"github.com/mathefer/tc-fiap-product/pkg/logging"
+	"github.com/mathefer/tc-fiap-product/pkg/metrics"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+// failingCache is a cache.Cache whose server is unreachable.
+type failingCache struct{}
+
+func (failingCache) Get(ctx context.Context, key string) ([]byte, bool, error) {
+	return nil, false, errors.New("connection refused")
+}
+
+func (failingCache) Set(ctx context.Context, key string, value []byte, ttl time.Duration) error {
+	return errors.New("connection refused")
+}
+
+func (failingCache) Delete(ctx context.Context, keys ...string) error {
+	return errors.New("connection refused")
+}
+
+type CachedProductRepositoryTestSuite struct {
+	suite.Suite
+	ctx            context.Context
+	mockRepository *mockRepositories.MockProductRepository
+	registry       *prometheus.Registry
+	repository     *persistence.CachedProductRepository
+}
+
+func (suite *CachedProductRepositoryTestSuite) SetupTest() {
+	suite.ctx = context.Background()
+	suite.mockRepository = mockRepositories.NewMockProductRepository(suite.T())
+	suite.registry = metrics.NewRegistry()
+	suite.repository = persistence.NewCachedProductRepository(suite.mockRepository, cache.NewLRU(100), time.Minute, suite.registry, logging.NewNop())
+}
+
+func TestCachedProductRepositoryTestSuite(t *testing.T) {
+	suite.Run(t, new(CachedProductRepositoryTestSuite))
+}
+
+func (suite *CachedProductRepositoryTestSuite) metrics() string {
+	w := httptest.NewRecorder()
+	metrics.NewController(suite.registry).Metrics(w, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+	return w.Body.String()
+}
+
+func (suite *CachedProductRepositoryTestSuite) expectGet(category uint, products ...*entities.Product) {
+	suite.mockRepository.EXPECT().
+		Get(mock.Anything, category, false).
+		Return(products, nil).
+		Once()
+}
+
+func (suite *CachedProductRepositoryTestSuite) expectGetByID(product *entities.Product) {
+	suite.mockRepository.EXPECT().
+		GetByID(mock.Anything, product.ID, false).
+		Return(product, nil).
+		Once()
+}
+
+func (suite *CachedProductRepositoryTestSuite) TestGet_ReadThrough() {
+	// Arrange - the repository is only asked once
+	product := &entities.Product{ID: 1, Name: "Hamburguer", Category: 1, Price: entities.NewMoney(3499, "BRL"), Version: 2}
+	suite.expectGet(1, product)
+
+	// Act
+	first, firstErr := suite.repository.Get(suite.ctx, 1, false)
+	second, secondErr := suite.repository.Get(suite.ctx, 1, false)
+
+	// Assert
+	assert.NoError(suite.T(), firstErr)
+	assert.NoError(suite.T(), secondErr)
+	assert.Equal(suite.T(), []*entities.Product{product}, first)
+	assert.Equal(suite.T(), first, second)
+	assert.Contains(suite.T(), suite.metrics(), `product_cache_requests_total{query="get",result="miss"} 1`)
+	assert.Contains(suite.T(), suite.metrics(), `product_cache_requests_total{query="get",result="hit"} 1`)
+}
+
+func (suite *CachedProductRepositoryTestSuite) TestGetByID_ReturnsCopies() {
+	// Arrange
+	suite.expectGetByID(&entities.Product{ID: 1, Name: "Hamburguer", Category: 1})
+	cached, _ := suite.repository.GetByID(suite.ctx, 1, false)
+
+	// Act - callers may modify what they read
+	hit, _ := suite.repository.GetByID(suite.ctx, 1, false)
+	hit.Name = "X-Burguer"
+	again, err := suite.repository.GetByID(suite.ctx, 1, false)
+
+	// Assert
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), cached, again)
+	assert.Equal(suite.T(), "Hamburguer", again.Name)
+}
+
+func (suite *CachedProductRepositoryTestSuite) TestGetByIDForUpdate_BypassesCache() {
+	// Arrange - the cached entry is older than the stored product
+	suite.expectGetByID(&entities.Product{ID: 1, Name: "Hamburguer", Category: 1, Version: 2})
+	suite.repository.GetByID(suite.ctx, 1, false)
+	stored := &entities.Product{ID: 1, Name: "X-Burguer", Category: 1, Version: 3}
+	suite.mockRepository.EXPECT().
+		GetByIDForUpdate(mock.Anything, uint(1)).
+		Return(stored, nil).
+		Twice()
+
+	// Act
+	first, firstErr := suite.repository.GetByIDForUpdate(suite.ctx, 1)
+	second, secondErr := suite.repository.GetByIDForUpdate(suite.ctx, 1)
+
+	// Assert - every read reaches the repository
+	assert.NoError(suite.T(), firstErr)
+	assert.NoError(suite.T(), secondErr)
+	assert.Equal(suite.T(), stored, first)
+	assert.Equal(suite.T(), stored, second)
+}
+
+func (suite *CachedProductRepositoryTestSuite) TestGetByID_ErrorsAreNotCached() {
+	// Arrange
+	suite.mockRepository.EXPECT().
+		GetByID(mock.Anything, uint(9), false).
+		Return(nil, domainerrors.NewNotFoundError("product", 9)).
+		Twice()
+
+	// Act
+	_, firstErr := suite.repository.GetByID(suite.ctx, 9, false)
+	_, secondErr := suite.repository.GetByID(suite.ctx, 9, false)
+
+	// Assert
+	assert.ErrorIs(suite.T(), firstErr, domainerrors.ErrNotFound)
+	assert.ErrorIs(suite.T(), secondErr, domainerrors.ErrNotFound)
+}
+
+func (suite *CachedProductRepositoryTestSuite) TestGetByID_IncludeDeletedIsCachedApart() {
+	// Arrange - only the read that includes deleted products finds it
+	suite.mockRepository.EXPECT().
+		GetByID(mock.Anything, uint(4), false).
+		Return(nil, domainerrors.NewNotFoundError("product", 4)).
+		Once()
+	suite.mockRepository.EXPECT().
+		GetByID(mock.Anything, uint(4), true).
+		Return(&entities.Product{ID: 4, Name: "Milk-shake"}, nil).
+		Once()
+
+	// Act
+	deleted, deletedErr := suite.repository.GetByID(suite.ctx, 4, true)
+	_, err := suite.repository.GetByID(suite.ctx, 4, false)
+
+	// Assert
+	assert.NoError(suite.T(), deletedErr)
+	assert.Equal(suite.T(), "Milk-shake", deleted.Name)
+	assert.ErrorIs(suite.T(), err, domainerrors.ErrNotFound)
+}
+
+func (suite *CachedProductRepositoryTestSuite) TestLastModified_ReadThrough() {
+	// Arrange
+	lastModified := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	suite.mockRepository.EXPECT().
+		LastModified(mock.Anything).
+		Return(lastModified, nil).
+		Once()
+
+	// Act
+	suite.repository.LastModified(suite.ctx)
+	cached, err := suite.repository.LastModified(suite.ctx)
+
+	// Assert
+	assert.NoError(suite.T(), err)
+	assert.True(suite.T(), lastModified.Equal(cached))
+}
+
+func (suite *CachedProductRepositoryTestSuite) TestAdd_InvalidatesCategory() {
+	// Arrange
+	suite.expectGet(1)
+	suite.repository.Get(suite.ctx, 1, false)
+	product := &entities.Product{Name: "Hamburguer", Category: 1}
+	suite.mockRepository.EXPECT().Add(mock.Anything, product).Return(nil).Once()
+
+	// Act
+	err := suite.repository.Add(suite.ctx, product)
+
+	// Assert - the category is read again
+	assert.NoError(suite.T(), err)
+	suite.expectGet(1, product)
+	products, _ := suite.repository.Get(suite.ctx, 1, false)
+	assert.Len(suite.T(), products, 1)
+}
+
+func (suite *CachedProductRepositoryTestSuite) TestUpdate_InvalidatesProductAndBothCategories() {
+	// Arrange - the product moves from category 1 to 2
+	previous := &entities.Product{ID: 5, Name: "Hamburguer", Category: 1}
+	updated := &entities.Product{ID: 5, Name: "Hamburguer", Category: 2}
+	suite.expectGet(1, previous)
+	suite.expectGet(2)
+	suite.expectGetByID(previous)
+	suite.repository.Get(suite.ctx, 1, false)
+	suite.repository.Get(suite.ctx, 2, false)
+	suite.repository.GetByID(suite.ctx, 5, false)
+
+	suite.expectGetByID(previous)
+	suite.mockRepository.EXPECT().Update(mock.Anything, updated).Return(nil).Once()
+
+	// Act
+	err := suite.repository.Update(suite.ctx, updated)
+
+	// Assert - every entry is read again
+	assert.NoError(suite.T(), err)
+	suite.expectGet(1)
+	suite.expectGet(2, updated)
+	suite.expectGetByID(updated)
+	fromCategory, _ := suite.repository.Get(suite.ctx, 1, false)
+	toCategory, _ := suite.repository.Get(suite.ctx, 2, false)
+	product, _ := suite.repository.GetByID(suite.ctx, 5, false)
+	assert.Empty(suite.T(), fromCategory)
+	assert.Len(suite.T(), toCategory, 1)
+	assert.Equal(suite.T(), 2, product.Category)
+}
+
+func (suite *CachedProductRepositoryTestSuite) TestDelete_InvalidatesEvenWhenItFails() {
+	// Arrange
+	product := &entities.Product{ID: 5, Name: "Hamburguer", Category: 1, Version: 2}
+	suite.expectGet(1, product)
+	suite.repository.Get(suite.ctx, 1, false)
+	suite.expectGetByID(product)
+	suite.mockRepository.EXPECT().
+		Delete(mock.Anything, uint(5), uint(1)).
+		Return(domainerrors.NewPreconditionFailedError("product", 5)).
+		Once()
+
+	// Act
+	err := suite.repository.Delete(suite.ctx, 5, 1)
+
+	// Assert
+	assert.ErrorIs(suite.T(), err, domainerrors.ErrPreconditionFailed)
+	suite.expectGet(1, product)
+	suite.repository.Get(suite.ctx, 1, false)
+}
+
+func (suite *CachedProductRepositoryTestSuite) TestRestore_InvalidatesCategory() {
+	// Arrange
+	suite.expectGet(3)
+	suite.repository.Get(suite.ctx, 3, false)
+	restored := &entities.Product{ID: 7, Name: "Refrigerante", Category: 3}
+	suite.mockRepository.EXPECT().Restore(mock.Anything, uint(7)).Return(nil).Once()
+	suite.expectGetByID(restored)
+
+	// Act
+	err := suite.repository.Restore(suite.ctx, 7)
+
+	// Assert
+	assert.NoError(suite.T(), err)
+	suite.expectGet(3, restored)
+	products, _ := suite.repository.Get(suite.ctx, 3, false)
+	assert.Len(suite.T(), products, 1)
+}
+
+func (suite *CachedProductRepositoryTestSuite) TestUnavailableCache_FallsBackToRepository() {
+	// Arrange
+	suite.registry = metrics.NewRegistry()
+	repository := persistence.NewCachedProductRepository(suite.mockRepository, failingCache{}, time.Minute, suite.registry, logging.NewNop())
+	product := &entities.Product{ID: 1, Name: "Hamburguer", Category: 1}
+	suite.mockRepository.EXPECT().
+		Get(mock.Anything, uint(1), false).
+		Return([]*entities.Product{product}, nil).
+		Twice()
+	suite.mockRepository.EXPECT().Add(mock.Anything, product).Return(nil).Once()
+
+	// Act
+	first, firstErr := repository.Get(suite.ctx, 1, false)
+	second, secondErr := repository.Get(suite.ctx, 1, false)
+	addErr := repository.Add(suite.ctx, product)
+
+	// Assert
+	assert.NoError(suite.T(), firstErr)
+	assert.NoError(suite.T(), secondErr)
+	assert.NoError(suite.T(), addErr)
+	assert.Equal(suite.T(), first, second)
+	assert.Contains(suite.T(), suite.metrics(), `product_cache_requests_total{query="get",result="error"} 2`)
+}
+
+func (suite *CachedProductRepositoryTestSuite) TestPassThrough() {
+	// Arrange
+	suite.mockRepository.EXPECT().CountByCategory(mock.Anything).Return(map[int]int64{1: 2}, nil).Twice()
+
+	// Act
+	suite.repository.CountByCategory(suite.ctx)
+	counts, err := suite.repository.CountByCategory(suite.ctx)
+
+	// Assert - only the menu queries are cached
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), map[int]int64{1: 2}, counts)
+}
@@ -1,6 +1,13 @@
 package persistence
 
 import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/mathefer/tc-fiap-product/internal/product/domain/domainerrors"
 	"github.com/mathefer/tc-fiap-product/internal/product/domain/entities"
 	"github.com/mathefer/tc-fiap-product/internal/product/domain/repositories"
 	"gorm.io/gorm"
@@ -10,43 +17,285 @@ var (
 	_ repositories.ProductRepository = (*ProductRepositoryImpl)(nil)
 )
 
+const productResource = "product"
+
+// sortColumns whitelists the columns a listing may be ordered by.
+var sortColumns = map[string]string{
+	repositories.ProductSortByName:      "name",
+	repositories.ProductSortByPrice:     "price_amount",
+	repositories.ProductSortByCreatedAt: "created_at",
+}
+
 type ProductRepositoryImpl struct {
-	db *gorm.DB
+	db     *gorm.DB
+	logger *slog.Logger
 }
 
-func NewProductRepositoryImpl(db *gorm.DB) *ProductRepositoryImpl {
-	return &ProductRepositoryImpl{db: db}
+func NewProductRepositoryImpl(db *gorm.DB, logger *slog.Logger) *ProductRepositoryImpl {
+	return &ProductRepositoryImpl{db: db, logger: logger}
 }
 
-func (r *ProductRepositoryImpl) Get(category uint) ([]*entities.Product, error) {
+func (r *ProductRepositoryImpl) Get(ctx context.Context, category uint, includeDeleted bool) ([]*entities.Product, error) {
+	query := r.db.WithContext(ctx)
+	if includeDeleted {
+		query = query.Unscoped()
+	}
+
 	var products []*entities.Product
-	if err := r.db.Where("category = ?", category).Find(&products).Error; err != nil {
+	if err := query.Where("category = ?", category).Find(&products).Error; err != nil {
 		return []*entities.Product{}, err
 	}
 	return products, nil
 }
 
-func (r *ProductRepositoryImpl) Add(product *entities.Product) error {
-	if err := r.db.Create(product).Error; err != nil {
-		return err
+func (r *ProductRepositoryImpl) GetByID(ctx context.Context, id uint, includeDeleted bool) (*entities.Product, error) {
+	query := r.db.WithContext(ctx)
+	if includeDeleted {
+		query = query.Unscoped()
+	}
+
+	var product entities.Product
+	if err := query.First(&product, id).Error; err != nil {
+		return nil, r.translate(ctx, err, id)
+	}
+	return &product, nil
+}
+
+func (r *ProductRepositoryImpl) GetByIDForUpdate(ctx context.Context, id uint) (*entities.Product, error) {
+	return r.GetByID(ctx, id, false)
+}
+
+func (r *ProductRepositoryImpl) GetByIDs(ctx context.Context, ids []uint, includeDeleted bool) ([]*entities.Product, error) {
+	query := r.db.WithContext(ctx)
+	if includeDeleted {
+		query = query.Unscoped()
+	}
+
+	var products []*entities.Product
+	if err := query.Where("id IN ?", ids).Find(&products).Error; err != nil {
+		return []*entities.Product{}, err
+	}
+	return products, nil
+}
+
+func (r *ProductRepositoryImpl) List(ctx context.Context, options repositories.ProductListOptions) ([]*entities.Product, int64, error) {
+	query := r.db.WithContext(ctx).Model(&entities.Product{})
+	if options.IncludeDeleted {
+		query = query.Unscoped()
+	}
+	if options.Category != 0 {
+		query = query.Where("category = ?", options.Category)
+	}
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return []*entities.Product{}, 0, err
+	}
+
+	direction, comparison := "ASC", ">"
+	if options.Descending {
+		direction, comparison = "DESC", "<"
+	}
+	column, sorted := sortColumns[options.SortBy]
+	if sorted {
+		query = query.Order(fmt.Sprintf("%s %s", column, direction)).Order("id")
+	} else {
+		query = query.Order("id " + direction)
+	}
+
+	// Ties on the sort column are ordered by ascending ID in either
+	// direction, so the page continues after the position in that order.
+	if after := options.After; after != nil {
+		if sorted {
+			value := r.sortValue(options.SortBy, after)
+			query = query.Where(fmt.Sprintf("%s %s ? OR (%s = ? AND id > ?)", column, comparison, column), value, value, after.ID)
+		} else {
+			query = query.Where(fmt.Sprintf("id %s ?", comparison), after.ID)
+		}
+		options.Offset = 0
+	}
+
+	var products []*entities.Product
+	if err := query.Limit(options.Limit).Offset(options.Offset).Find(&products).Error; err != nil {
+		return []*entities.Product{}, 0, err
+	}
+	return products, total, nil
+}
+
+// sortValue returns the value of the sort column at position.
+func (r *ProductRepositoryImpl) sortValue(sortBy string, position *repositories.ProductListPosition) any {
+	switch sortBy {
+	case repositories.ProductSortByName:
+		return position.Name
+	case repositories.ProductSortByPrice:
+		return position.PriceAmount
+	default:
+		// SQLite keeps timestamps as text, and created_at as written by its
+		// CURRENT_TIMESTAMP default has neither fraction nor zone, so a
+		// bound time.Time would never compare equal to it.
+		if r.db.Dialector.Name() == "sqlite" {
+			return position.CreatedAt.UTC().Format(time.DateTime)
+		}
+		return position.CreatedAt
+	}
+}
+
+// CountByCategory returns the number of products in each category that has
+// any, ignoring soft-deleted products.
+func (r *ProductRepositoryImpl) CountByCategory(ctx context.Context) (map[int]int64, error) {
+	var rows []struct {
+		Category int
+		Count    int64
+	}
+	err := r.db.WithContext(ctx).Model(&entities.Product{}).
+		Select("category, COUNT(*) AS count").
+		Group("category").
+		Find(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+
+	counts := make(map[int]int64, len(rows))
+	for _, row := range rows {
+		counts[row.Category] = row.Count
+	}
+	return counts, nil
+}
+
+func (r *ProductRepositoryImpl) Add(ctx context.Context, product *entities.Product) error {
+	if product.Version == 0 {
+		product.Version = 1
+	}
+	if err := r.db.WithContext(ctx).Create(product).Error; err != nil {
+		return r.translate(ctx, err, product.ID)
 	}
 	return nil
 }
 
-func (r *ProductRepositoryImpl) Update(product *entities.Product) error {
-	result := r.db.Model(&entities.Product{}).Where("id = ?", product.ID).Updates(product)
+// Update replaces every editable column of the product, including empty
+// values, when product.Version is still the stored version, and increments
+// the version.
+func (r *ProductRepositoryImpl) Update(ctx context.Context, product *entities.Product) error {
+	query := r.db.WithContext(ctx).Model(&entities.Product{}).Where("id = ?", product.ID)
+	if product.Version != 0 {
+		query = query.Where("version = ?", product.Version)
+	}
+
+	result := query.Updates(map[string]any{
+		"name":           product.Name,
+		"category":       product.Category,
+		"price_amount":   product.Price.Amount,
+		"price_currency": product.Price.Currency,
+		"description":    product.Description,
+		"image_link":     product.ImageLink,
+		"version":        gorm.Expr("version + 1"),
+	})
+	if result.Error != nil {
+		return r.translate(ctx, result.Error, product.ID)
+	}
+	if result.RowsAffected == 0 {
+		return r.unchanged(ctx, product.ID, product.Version)
+	}
+	return nil
+}
+
+// Delete soft deletes the given version of the product; it disappears from
+// every query but can be brought back with Restore. The deletion mark is set
+// as an update rather than through GORM's soft delete so that updated_at
+// and the version advance with it: the product reads differently with
+// include_deleted, so its ETag must change.
+func (r *ProductRepositoryImpl) Delete(ctx context.Context, id uint, version uint) error {
+	query := r.db.WithContext(ctx).Model(&entities.Product{}).Where("id = ?", id)
+	if version != 0 {
+		query = query.Where("version = ?", version)
+	}
+
+	result := query.Updates(map[string]any{
+		"deleted_at": time.Now(),
+		"version":    gorm.Expr("version + 1"),
+	})
 	if result.Error != nil {
-		return result.Error
+		return r.translate(ctx, result.Error, id)
 	}
 	if result.RowsAffected == 0 {
-		return gorm.ErrRecordNotFound
+		return r.unchanged(ctx, id, version)
 	}
 	return nil
 }
 
-func (r *ProductRepositoryImpl) Delete(id uint) error {
-	if err := r.db.Delete(&entities.Product{}, id).Error; err != nil {
+// unchanged explains why a write to the product matched no row: either the
+// product does not exist or it is no longer at the expected version.
+func (r *ProductRepositoryImpl) unchanged(ctx context.Context, id uint, version uint) error {
+	if version == 0 {
+		return domainerrors.NewNotFoundError(productResource, id)
+	}
+
+	var count int64
+	if err := r.db.WithContext(ctx).Model(&entities.Product{}).Where("id = ?", id).Count(&count).Error; err != nil {
 		return err
 	}
+	if count == 0 {
+		return domainerrors.NewNotFoundError(productResource, id)
+	}
+	return domainerrors.NewPreconditionFailedError(productResource, id)
+}
+
+// Restore clears the deletion mark of a soft-deleted product and increments
+// its version. It reports not found when there is no deleted product with
+// the given ID.
+func (r *ProductRepositoryImpl) Restore(ctx context.Context, id uint) error {
+	result := r.db.WithContext(ctx).Unscoped().Model(&entities.Product{}).
+		Where("id = ? AND deleted_at IS NOT NULL", id).
+		Updates(map[string]any{
+			"deleted_at": nil,
+			"version":    gorm.Expr("version + 1"),
+		})
+	if result.Error != nil {
+		return r.translate(ctx, result.Error, id)
+	}
+	if result.RowsAffected == 0 {
+		return domainerrors.NewNotFoundError(productResource, id)
+	}
 	return nil
 }
+
+// LastModified returns the most recent updated_at of any product, including
+// deleted ones, so that removing a product also counts as a change.
+func (r *ProductRepositoryImpl) LastModified(ctx context.Context) (time.Time, error) {
+	var latest entities.Product
+	err := r.db.WithContext(ctx).Unscoped().
+		Select("updated_at").
+		Order("updated_at DESC").
+		Limit(1).
+		Find(&latest).Error
+	if err != nil {
+		return time.Time{}, err
+	}
+	return latest.UpdatedAt, nil
+}
+
+// translate converts err into a domain error. The database's own message is
+// logged for conflicts because it names the violated constraint, which the
+// domain error leaves out.
+func (r *ProductRepositoryImpl) translate(ctx context.Context, err error, id uint) error {
+	translated := translateError(err, productResource, id)
+	if errors.Is(translated, domainerrors.ErrConflict) {
+		r.logger.WarnContext(ctx, "Product write rejected by the database", "error", err)
+	}
+	return translated
+}
+
+// translateError converts GORM errors into domain errors so that callers
+// never need to know about the persistence library.
+func translateError(err error, resource string, id uint) error {
+	switch {
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		return domainerrors.NewNotFoundError(resource, id)
+	case errors.Is(err, gorm.ErrDuplicatedKey):
+		return domainerrors.NewConflictError(resource + " already exists")
+	case errors.Is(err, gorm.ErrForeignKeyViolated):
+		return domainerrors.NewConflictError(resource + " references a resource that does not exist")
+	default:
+		return err
+	}
+}
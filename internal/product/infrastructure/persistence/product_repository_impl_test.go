@@ -1,6 +1,7 @@
 package persistence_test
 
 import (
+	"context"
 	"database/sql"
 	"errors"
 	"testing"
@@ -9,8 +10,11 @@ import (
 	"github.com/DATA-DOG/go-sqlmock"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/suite"
+	"github.com/mathefer/tc-fiap-product/internal/product/domain/domainerrors"
 	"github.com/mathefer/tc-fiap-product/internal/product/domain/entities"
+	"github.com/mathefer/tc-fiap-product/internal/product/domain/repositories"
 	"github.com/mathefer/tc-fiap-product/internal/product/infrastructure/persistence"
+	"github.com/mathefer/tc-fiap-product/pkg/logging"
 	"gorm.io/driver/postgres"
 	"gorm.io/gorm"
 )
@@ -41,7 +45,7 @@ func (suite *ProductRepositoryTestSuite) SetupTest() {
 		suite.T().Fatalf("Failed to open gorm db, got error: %v", err)
 	}
 
-	suite.repository = persistence.NewProductRepositoryImpl(suite.db)
+	suite.repository = persistence.NewProductRepositoryImpl(suite.db, logging.NewNop())
 }
 
 func (suite *ProductRepositoryTestSuite) TearDownTest() {
@@ -59,16 +63,16 @@ func (suite *ProductRepositoryTestSuite) TestGet_Success() {
 	category := uint(1)
 	now := time.Now()
 
-	rows := sqlmock.NewRows([]string{"id", "created_at", "name", "category", "price", "description", "image_link"}).
-		AddRow(1, now, "Hamburguer", 1, 34.99, "Hamburguer com salada", "https://example.com/image.jpg").
-		AddRow(2, now, "Cheeseburguer", 1, 39.99, "Hamburguer com queijo", "https://example.com/cheese.jpg")
+	rows := sqlmock.NewRows([]string{"id", "created_at", "name", "category", "price_amount", "price_currency", "description", "image_link"}).
+		AddRow(1, now, "Hamburguer", 1, 3499, "BRL", "Hamburguer com salada", "https://example.com/image.jpg").
+		AddRow(2, now, "Cheeseburguer", 1, 3999, "BRL", "Hamburguer com queijo", "https://example.com/cheese.jpg")
 
 	suite.mockDB.ExpectQuery(`SELECT \* FROM "product" WHERE category = \$1`).
 		WithArgs(1).
 		WillReturnRows(rows)
 
 	// Act
-	products, err := suite.repository.Get(category)
+	products, err := suite.repository.Get(context.Background(), category, false)
 
 	// Assert
 	assert.NoError(suite.T(), err)
@@ -77,7 +81,7 @@ func (suite *ProductRepositoryTestSuite) TestGet_Success() {
 	assert.Equal(suite.T(), uint(1), products[0].ID)
 	assert.Equal(suite.T(), "Hamburguer", products[0].Name)
 	assert.Equal(suite.T(), 1, products[0].Category)
-	assert.Equal(suite.T(), 34.99, products[0].Price)
+	assert.Equal(suite.T(), entities.NewMoney(3499, "BRL"), products[0].Price)
 	suite.mockDB.ExpectationsWereMet()
 }
 
@@ -85,14 +89,14 @@ func (suite *ProductRepositoryTestSuite) TestGet_EmptyResult() {
 	// Arrange
 	category := uint(2)
 
-	rows := sqlmock.NewRows([]string{"id", "created_at", "name", "category", "price", "description", "image_link"})
+	rows := sqlmock.NewRows([]string{"id", "created_at", "name", "category", "price_amount", "price_currency", "description", "image_link"})
 
 	suite.mockDB.ExpectQuery(`SELECT \* FROM "product" WHERE category = \$1`).
 		WithArgs(2).
 		WillReturnRows(rows)
 
 	// Act
-	products, err := suite.repository.Get(category)
+	products, err := suite.repository.Get(context.Background(), category, false)
 
 	// Assert
 	assert.NoError(suite.T(), err)
@@ -111,7 +115,7 @@ func (suite *ProductRepositoryTestSuite) TestGet_DatabaseError() {
 		WillReturnError(expectedError)
 
 	// Act
-	products, err := suite.repository.Get(category)
+	products, err := suite.repository.Get(context.Background(), category, false)
 
 	// Assert
 	assert.Error(suite.T(), err)
@@ -120,12 +124,271 @@ func (suite *ProductRepositoryTestSuite) TestGet_DatabaseError() {
 	suite.mockDB.ExpectationsWereMet()
 }
 
+func (suite *ProductRepositoryTestSuite) TestGet_IncludeDeleted() {
+	// Arrange
+	category := uint(1)
+	now := time.Now()
+
+	rows := sqlmock.NewRows([]string{"id", "created_at", "name", "category", "price_amount", "price_currency", "description", "image_link", "deleted_at"}).
+		AddRow(1, now, "Hamburguer", 1, 3499, "BRL", "Hamburguer com salada", "https://example.com/image.jpg", now)
+
+	suite.mockDB.ExpectQuery(`SELECT \* FROM "product" WHERE category = \$1$`).
+		WithArgs(1).
+		WillReturnRows(rows)
+
+	// Act
+	products, err := suite.repository.Get(context.Background(), category, true)
+
+	// Assert
+	assert.NoError(suite.T(), err)
+	assert.Len(suite.T(), products, 1)
+	assert.True(suite.T(), products[0].DeletedAt.Valid)
+	suite.mockDB.ExpectationsWereMet()
+}
+
+func (suite *ProductRepositoryTestSuite) TestGetByID_Success() {
+	// Arrange
+	id := uint(1)
+	now := time.Now()
+
+	rows := sqlmock.NewRows([]string{"id", "created_at", "name", "category", "price_amount", "price_currency", "description", "image_link"}).
+		AddRow(1, now, "Hamburguer", 1, 3499, "BRL", "Hamburguer com salada", "https://example.com/image.jpg")
+
+	suite.mockDB.ExpectQuery(`SELECT \* FROM "product" WHERE "product"."id" = \$1 AND "product"."deleted_at" IS NULL ORDER BY "product"."id" LIMIT \$2`).
+		WithArgs(1, 1).
+		WillReturnRows(rows)
+
+	// Act
+	product, err := suite.repository.GetByID(context.Background(), id, false)
+
+	// Assert
+	assert.NoError(suite.T(), err)
+	assert.NotNil(suite.T(), product)
+	assert.Equal(suite.T(), uint(1), product.ID)
+	assert.Equal(suite.T(), "Hamburguer", product.Name)
+	suite.mockDB.ExpectationsWereMet()
+}
+
+func (suite *ProductRepositoryTestSuite) TestGetByID_NotFound() {
+	// Arrange
+	id := uint(999)
+
+	rows := sqlmock.NewRows([]string{"id", "created_at", "name", "category", "price_amount", "price_currency", "description", "image_link"})
+
+	suite.mockDB.ExpectQuery(`SELECT \* FROM "product" WHERE "product"."id" = \$1`).
+		WithArgs(999, 1).
+		WillReturnRows(rows)
+
+	// Act
+	product, err := suite.repository.GetByID(context.Background(), id, false)
+
+	// Assert
+	assert.Error(suite.T(), err)
+	assert.ErrorIs(suite.T(), err, domainerrors.ErrNotFound)
+	assert.Nil(suite.T(), product)
+	suite.mockDB.ExpectationsWereMet()
+}
+
+func (suite *ProductRepositoryTestSuite) TestGetByID_IncludeDeleted() {
+	// Arrange
+	deletedAt := time.Now()
+	rows := sqlmock.NewRows([]string{"id", "name", "deleted_at"}).
+		AddRow(4, "Milk-shake", deletedAt)
+
+	suite.mockDB.ExpectQuery(`SELECT \* FROM "product" WHERE "product"."id" = \$1 ORDER BY "product"."id" LIMIT \$2`).
+		WithArgs(4, 1).
+		WillReturnRows(rows)
+
+	// Act
+	product, err := suite.repository.GetByID(context.Background(), 4, true)
+
+	// Assert
+	assert.NoError(suite.T(), err)
+	assert.True(suite.T(), product.DeletedAt.Valid)
+	assert.NoError(suite.T(), suite.mockDB.ExpectationsWereMet())
+}
+
+func (suite *ProductRepositoryTestSuite) TestGetByIDs_Success() {
+	// Arrange
+	ids := []uint{1, 2, 3}
+	now := time.Now()
+
+	rows := sqlmock.NewRows([]string{"id", "created_at", "name", "category", "price_amount", "price_currency", "description", "image_link"}).
+		AddRow(1, now, "Hamburguer", 1, 3499, "BRL", "Hamburguer com salada", "https://example.com/image.jpg").
+		AddRow(3, now, "Refrigerante", 3, 650, "BRL", "Lata 350ml", "https://example.com/soda.jpg")
+
+	suite.mockDB.ExpectQuery(`SELECT \* FROM "product" WHERE id IN \(\$1,\$2,\$3\)`).
+		WithArgs(1, 2, 3).
+		WillReturnRows(rows)
+
+	// Act
+	products, err := suite.repository.GetByIDs(context.Background(), ids, false)
+
+	// Assert
+	assert.NoError(suite.T(), err)
+	assert.Len(suite.T(), products, 2)
+	assert.Equal(suite.T(), uint(1), products[0].ID)
+	assert.Equal(suite.T(), uint(3), products[1].ID)
+	suite.mockDB.ExpectationsWereMet()
+}
+
+func (suite *ProductRepositoryTestSuite) TestGetByIDs_IncludeDeleted() {
+	// Arrange
+	rows := sqlmock.NewRows([]string{"id", "name"}).AddRow(4, "Milk-shake")
+
+	suite.mockDB.ExpectQuery(`SELECT \* FROM "product" WHERE id IN \(\$1\)$`).
+		WithArgs(4).
+		WillReturnRows(rows)
+
+	// Act
+	products, err := suite.repository.GetByIDs(context.Background(), []uint{4}, true)
+
+	// Assert
+	assert.NoError(suite.T(), err)
+	assert.Len(suite.T(), products, 1)
+	assert.NoError(suite.T(), suite.mockDB.ExpectationsWereMet())
+}
+
+func (suite *ProductRepositoryTestSuite) TestGetByIDs_DatabaseError() {
+	// Arrange
+	expectedError := errors.New("database connection error")
+
+	suite.mockDB.ExpectQuery(`SELECT \* FROM "product" WHERE id IN`).
+		WithArgs(1).
+		WillReturnError(expectedError)
+
+	// Act
+	products, err := suite.repository.GetByIDs(context.Background(), []uint{1}, false)
+
+	// Assert
+	assert.Error(suite.T(), err)
+	assert.NotNil(suite.T(), products)
+	assert.Len(suite.T(), products, 0)
+	suite.mockDB.ExpectationsWereMet()
+}
+
+func (suite *ProductRepositoryTestSuite) TestList_WithCategoryAndSort() {
+	// Arrange
+	now := time.Now()
+	options := repositories.ProductListOptions{
+		Category:   1,
+		SortBy:     repositories.ProductSortByPrice,
+		Descending: true,
+		Limit:      2,
+		Offset:     2,
+	}
+
+	suite.mockDB.ExpectQuery(`SELECT count\(\*\) FROM "product" WHERE category = \$1`).
+		WithArgs(1).
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(5))
+
+	rows := sqlmock.NewRows([]string{"id", "created_at", "name", "category", "price_amount", "price_currency", "description", "image_link"}).
+		AddRow(4, now, "Hamburguer", 1, 3499, "BRL", "Hamburguer com salada", "https://example.com/image.jpg").
+		AddRow(2, now, "Cheeseburguer", 1, 2999, "BRL", "Hamburguer com queijo", "https://example.com/cheese.jpg")
+
+	suite.mockDB.ExpectQuery(`SELECT \* FROM "product" WHERE category = \$1 AND "product"."deleted_at" IS NULL ORDER BY price_amount DESC,id LIMIT \$2 OFFSET \$3`).
+		WithArgs(1, 2, 2).
+		WillReturnRows(rows)
+
+	// Act
+	products, total, err := suite.repository.List(context.Background(), options)
+
+	// Assert
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), int64(5), total)
+	assert.Len(suite.T(), products, 2)
+	assert.Equal(suite.T(), uint(4), products[0].ID)
+	suite.mockDB.ExpectationsWereMet()
+}
+
+func (suite *ProductRepositoryTestSuite) TestList_DefaultOrder() {
+	// Arrange
+	options := repositories.ProductListOptions{Limit: 20}
+
+	suite.mockDB.ExpectQuery(`SELECT count\(\*\) FROM "product"`).
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(0))
+	suite.mockDB.ExpectQuery(`SELECT \* FROM "product" WHERE "product"."deleted_at" IS NULL ORDER BY id ASC LIMIT \$1`).
+		WithArgs(20).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}))
+
+	// Act
+	products, total, err := suite.repository.List(context.Background(), options)
+
+	// Assert
+	assert.NoError(suite.T(), err)
+	assert.Zero(suite.T(), total)
+	assert.Len(suite.T(), products, 0)
+	suite.mockDB.ExpectationsWereMet()
+}
+
+func (suite *ProductRepositoryTestSuite) TestList_AfterPosition() {
+	// Arrange
+	options := repositories.ProductListOptions{
+		SortBy:     repositories.ProductSortByPrice,
+		Descending: true,
+		Limit:      2,
+		Offset:     4,
+		After:      &repositories.ProductListPosition{ID: 7, PriceAmount: 3499},
+	}
+
+	// The count ignores the position, and so does the offset
+	suite.mockDB.ExpectQuery(`SELECT count\(\*\) FROM "product" WHERE "product"."deleted_at" IS NULL$`).
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(5))
+	suite.mockDB.ExpectQuery(`SELECT \* FROM "product" WHERE "product"."deleted_at" IS NULL AND \(price_amount < \$1 OR \(price_amount = \$2 AND id > \$3\)\) ORDER BY price_amount DESC,id LIMIT \$4$`).
+		WithArgs(int64(3499), int64(3499), uint(7), 2).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(8))
+
+	// Act
+	products, total, err := suite.repository.List(context.Background(), options)
+
+	// Assert
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), int64(5), total)
+	assert.Len(suite.T(), products, 1)
+	assert.NoError(suite.T(), suite.mockDB.ExpectationsWereMet())
+}
+
+func (suite *ProductRepositoryTestSuite) TestList_AfterID() {
+	// Arrange
+	options := repositories.ProductListOptions{Limit: 20, After: &repositories.ProductListPosition{ID: 7}}
+
+	suite.mockDB.ExpectQuery(`SELECT count\(\*\) FROM "product"`).
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(0))
+	suite.mockDB.ExpectQuery(`SELECT \* FROM "product" WHERE "product"."deleted_at" IS NULL AND id > \$1 ORDER BY id ASC LIMIT \$2$`).
+		WithArgs(uint(7), 20).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}))
+
+	// Act
+	_, _, err := suite.repository.List(context.Background(), options)
+
+	// Assert
+	assert.NoError(suite.T(), err)
+	assert.NoError(suite.T(), suite.mockDB.ExpectationsWereMet())
+}
+
+func (suite *ProductRepositoryTestSuite) TestList_CountError() {
+	// Arrange
+	expectedError := errors.New("database connection error")
+
+	suite.mockDB.ExpectQuery(`SELECT count\(\*\) FROM "product"`).
+		WillReturnError(expectedError)
+
+	// Act
+	products, total, err := suite.repository.List(context.Background(), repositories.ProductListOptions{Limit: 20})
+
+	// Assert
+	assert.Error(suite.T(), err)
+	assert.Zero(suite.T(), total)
+	assert.Len(suite.T(), products, 0)
+	suite.mockDB.ExpectationsWereMet()
+}
+
 func (suite *ProductRepositoryTestSuite) TestAdd_Success() {
 	// Arrange
 	product := &entities.Product{
 		Name:        "Hamburguer",
 		Category:    1,
-		Price:       34.99,
+		Price:       entities.NewMoney(3499, "BRL"),
 		Description: "Hamburguer com salada",
 		ImageLink:   "https://example.com/image.jpg",
 	}
@@ -135,15 +398,16 @@ func (suite *ProductRepositoryTestSuite) TestAdd_Success() {
 	// The RETURNING clause includes created_at and id
 	now := time.Now()
 	suite.mockDB.ExpectQuery(`INSERT INTO "product"`).
-		WithArgs(product.Name, product.Category, product.Price, product.Description, product.ImageLink).
+		WithArgs(product.Name, product.Category, product.Price.Amount, product.Price.Currency, product.Description, product.ImageLink, sqlmock.AnyArg(), uint(1), nil).
 		WillReturnRows(sqlmock.NewRows([]string{"created_at", "id"}).AddRow(now, 1))
 	suite.mockDB.ExpectCommit()
 
 	// Act
-	err := suite.repository.Add(product)
+	err := suite.repository.Add(context.Background(), product)
 
 	// Assert
 	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), uint(1), product.Version)
 	suite.mockDB.ExpectationsWereMet()
 }
 
@@ -152,7 +416,7 @@ func (suite *ProductRepositoryTestSuite) TestAdd_DatabaseError() {
 	product := &entities.Product{
 		Name:        "Hamburguer",
 		Category:    1,
-		Price:       34.99,
+		Price:       entities.NewMoney(3499, "BRL"),
 		Description: "Hamburguer com salada",
 		ImageLink:   "https://example.com/image.jpg",
 	}
@@ -162,38 +426,60 @@ func (suite *ProductRepositoryTestSuite) TestAdd_DatabaseError() {
 	suite.mockDB.ExpectBegin()
 	// GORM doesn't include created_at in INSERT - it's handled by database default
 	suite.mockDB.ExpectQuery(`INSERT INTO "product"`).
-		WithArgs(product.Name, product.Category, product.Price, product.Description, product.ImageLink).
+		WithArgs(product.Name, product.Category, product.Price.Amount, product.Price.Currency, product.Description, product.ImageLink, sqlmock.AnyArg(), uint(1), nil).
 		WillReturnError(expectedError)
 	suite.mockDB.ExpectRollback()
 
 	// Act
-	err := suite.repository.Add(product)
+	err := suite.repository.Add(context.Background(), product)
 
 	// Assert
 	assert.Error(suite.T(), err)
 	suite.mockDB.ExpectationsWereMet()
 }
 
+func (suite *ProductRepositoryTestSuite) TestAdd_DuplicatedKey() {
+	// Arrange
+	product := &entities.Product{
+		Name:     "Hamburguer",
+		Category: 1,
+		Price:    entities.NewMoney(3499, "BRL"),
+	}
+
+	suite.mockDB.ExpectBegin()
+	suite.mockDB.ExpectQuery(`INSERT INTO "product"`).
+		WillReturnError(gorm.ErrDuplicatedKey)
+	suite.mockDB.ExpectRollback()
+
+	// Act
+	err := suite.repository.Add(context.Background(), product)
+
+	// Assert
+	assert.Error(suite.T(), err)
+	assert.ErrorIs(suite.T(), err, domainerrors.ErrConflict)
+	suite.mockDB.ExpectationsWereMet()
+}
+
 func (suite *ProductRepositoryTestSuite) TestUpdate_Success() {
 	// Arrange
 	product := &entities.Product{
 		ID:          1,
 		Name:        "Hamburguer Atualizado",
 		Category:    1,
-		Price:       39.99,
+		Price:       entities.NewMoney(3999, "BRL"),
 		Description: "Hamburguer com bacon",
 		ImageLink:   "https://example.com/updated.jpg",
 	}
 
 	suite.mockDB.ExpectBegin()
-	// GORM Updates() includes all fields including ID in SET, and ID in WHERE
-	suite.mockDB.ExpectExec(`UPDATE "product" SET`).
-		WithArgs(sqlmock.AnyArg(), product.Name, product.Category, product.Price, product.Description, product.ImageLink, product.ID).
+	// Update writes every editable column in SET, sorted by name, so empty values are written too, increments the version and filters by ID
+	suite.mockDB.ExpectExec(`UPDATE "product" SET .*"version"=version \+ 1,"updated_at"=\$7 WHERE id = \$8`).
+		WithArgs(product.Category, product.Description, product.ImageLink, product.Name, product.Price.Amount, product.Price.Currency, sqlmock.AnyArg(), product.ID).
 		WillReturnResult(sqlmock.NewResult(0, 1))
 	suite.mockDB.ExpectCommit()
 
 	// Act
-	err := suite.repository.Update(product)
+	err := suite.repository.Update(context.Background(), product)
 
 	// Assert
 	assert.NoError(suite.T(), err)
@@ -206,34 +492,77 @@ func (suite *ProductRepositoryTestSuite) TestUpdate_ProductNotFound() {
 		ID:          999,
 		Name:        "Non-existent Product",
 		Category:    1,
-		Price:       10.0,
+		Price:       entities.NewMoney(1000, "BRL"),
 		Description: "Description",
 		ImageLink:   "https://example.com/image.jpg",
 	}
 
 	suite.mockDB.ExpectBegin()
-	// GORM Updates() includes all fields including ID in SET, and ID in WHERE
-	suite.mockDB.ExpectExec(`UPDATE "product" SET`).
-		WithArgs(sqlmock.AnyArg(), product.Name, product.Category, product.Price, product.Description, product.ImageLink, product.ID).
+	// Update writes every editable column in SET, sorted by name, so empty values are written too, increments the version and filters by ID
+	suite.mockDB.ExpectExec(`UPDATE "product" SET .*"version"=version \+ 1,"updated_at"=\$7 WHERE id = \$8`).
+		WithArgs(product.Category, product.Description, product.ImageLink, product.Name, product.Price.Amount, product.Price.Currency, sqlmock.AnyArg(), product.ID).
 		WillReturnResult(sqlmock.NewResult(0, 0))
 	suite.mockDB.ExpectCommit()
 
 	// Act
-	err := suite.repository.Update(product)
+	err := suite.repository.Update(context.Background(), product)
 
 	// Assert
 	assert.Error(suite.T(), err)
-	assert.Equal(suite.T(), gorm.ErrRecordNotFound, err)
+	assert.ErrorIs(suite.T(), err, domainerrors.ErrNotFound)
 	suite.mockDB.ExpectationsWereMet()
 }
 
+func (suite *ProductRepositoryTestSuite) TestUpdate_VersionChanged() {
+	// Arrange
+	product := &entities.Product{ID: 1, Name: "Hamburguer", Category: 1, Price: entities.NewMoney(3499, "BRL"), Version: 2}
+
+	suite.mockDB.ExpectBegin()
+	suite.mockDB.ExpectExec(`UPDATE "product" SET .*"updated_at"=\$7 WHERE id = \$8 AND version = \$9`).
+		WithArgs(product.Category, product.Description, product.ImageLink, product.Name, product.Price.Amount, product.Price.Currency, sqlmock.AnyArg(), product.ID, product.Version).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	suite.mockDB.ExpectCommit()
+	// The product still exists, so it is at another version
+	suite.mockDB.ExpectQuery(`SELECT count\(\*\) FROM "product" WHERE id = \$1`).
+		WithArgs(product.ID).
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+
+	// Act
+	err := suite.repository.Update(context.Background(), product)
+
+	// Assert
+	assert.ErrorIs(suite.T(), err, domainerrors.ErrPreconditionFailed)
+	assert.NoError(suite.T(), suite.mockDB.ExpectationsWereMet())
+}
+
+func (suite *ProductRepositoryTestSuite) TestUpdate_VersionedProductNotFound() {
+	// Arrange
+	product := &entities.Product{ID: 999, Name: "Hamburguer", Category: 1, Price: entities.NewMoney(3499, "BRL"), Version: 2}
+
+	suite.mockDB.ExpectBegin()
+	suite.mockDB.ExpectExec(`UPDATE "product" SET .*"updated_at"=\$7 WHERE id = \$8 AND version = \$9`).
+		WithArgs(product.Category, product.Description, product.ImageLink, product.Name, product.Price.Amount, product.Price.Currency, sqlmock.AnyArg(), product.ID, product.Version).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	suite.mockDB.ExpectCommit()
+	suite.mockDB.ExpectQuery(`SELECT count\(\*\) FROM "product" WHERE id = \$1`).
+		WithArgs(product.ID).
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(0))
+
+	// Act
+	err := suite.repository.Update(context.Background(), product)
+
+	// Assert
+	assert.ErrorIs(suite.T(), err, domainerrors.ErrNotFound)
+	assert.NoError(suite.T(), suite.mockDB.ExpectationsWereMet())
+}
+
 func (suite *ProductRepositoryTestSuite) TestUpdate_DatabaseError() {
 	// Arrange
 	product := &entities.Product{
 		ID:          1,
 		Name:        "Hamburguer",
 		Category:    1,
-		Price:       34.99,
+		Price:       entities.NewMoney(3499, "BRL"),
 		Description: "Hamburguer com salada",
 		ImageLink:   "https://example.com/image.jpg",
 	}
@@ -241,53 +570,217 @@ func (suite *ProductRepositoryTestSuite) TestUpdate_DatabaseError() {
 	expectedError := errors.New("database update error")
 
 	suite.mockDB.ExpectBegin()
-	// GORM Updates() includes all fields including ID in SET, and ID in WHERE
-	suite.mockDB.ExpectExec(`UPDATE "product" SET`).
-		WithArgs(sqlmock.AnyArg(), product.Name, product.Category, product.Price, product.Description, product.ImageLink, product.ID).
+	// Update writes every editable column in SET, sorted by name, so empty values are written too, increments the version and filters by ID
+	suite.mockDB.ExpectExec(`UPDATE "product" SET .*"version"=version \+ 1,"updated_at"=\$7 WHERE id = \$8`).
+		WithArgs(product.Category, product.Description, product.ImageLink, product.Name, product.Price.Amount, product.Price.Currency, sqlmock.AnyArg(), product.ID).
 		WillReturnError(expectedError)
 	suite.mockDB.ExpectRollback()
 
 	// Act
-	err := suite.repository.Update(product)
+	err := suite.repository.Update(context.Background(), product)
 
 	// Assert
 	assert.Error(suite.T(), err)
 	suite.mockDB.ExpectationsWereMet()
 }
 
+func (suite *ProductRepositoryTestSuite) TestList_IncludeDeleted() {
+	// Arrange
+	options := repositories.ProductListOptions{IncludeDeleted: true, Limit: 20}
+
+	suite.mockDB.ExpectQuery(`SELECT count\(\*\) FROM "product"$`).
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(0))
+	suite.mockDB.ExpectQuery(`SELECT \* FROM "product" ORDER BY id ASC LIMIT \$1`).
+		WithArgs(20).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}))
+
+	// Act
+	products, total, err := suite.repository.List(context.Background(), options)
+
+	// Assert
+	assert.NoError(suite.T(), err)
+	assert.Zero(suite.T(), total)
+	assert.Len(suite.T(), products, 0)
+	suite.mockDB.ExpectationsWereMet()
+}
+
 func (suite *ProductRepositoryTestSuite) TestDelete_Success() {
 	// Arrange
 	id := uint(1)
 
 	suite.mockDB.ExpectBegin()
-	suite.mockDB.ExpectExec(`DELETE FROM "product"`).
-		WithArgs(id).
+	suite.mockDB.ExpectExec(`UPDATE "product" SET "deleted_at"=\$1,"version"=version \+ 1,"updated_at"=\$2 WHERE id = \$3 AND "product"."deleted_at" IS NULL`).
+		WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), id).
 		WillReturnResult(sqlmock.NewResult(0, 1))
 	suite.mockDB.ExpectCommit()
 
 	// Act
-	err := suite.repository.Delete(id)
+	err := suite.repository.Delete(context.Background(), id, 0)
 
 	// Assert
 	assert.NoError(suite.T(), err)
 	suite.mockDB.ExpectationsWereMet()
 }
 
+func (suite *ProductRepositoryTestSuite) TestDelete_VersionChanged() {
+	// Arrange
+	id := uint(1)
+
+	suite.mockDB.ExpectBegin()
+	suite.mockDB.ExpectExec(`UPDATE "product" SET "deleted_at"=\$1,"version"=version \+ 1,"updated_at"=\$2 WHERE id = \$3 AND version = \$4 AND "product"."deleted_at" IS NULL`).
+		WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), id, uint(2)).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	suite.mockDB.ExpectCommit()
+	suite.mockDB.ExpectQuery(`SELECT count\(\*\) FROM "product" WHERE id = \$1`).
+		WithArgs(id).
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+
+	// Act
+	err := suite.repository.Delete(context.Background(), id, 2)
+
+	// Assert
+	assert.ErrorIs(suite.T(), err, domainerrors.ErrPreconditionFailed)
+	assert.NoError(suite.T(), suite.mockDB.ExpectationsWereMet())
+}
+
+func (suite *ProductRepositoryTestSuite) TestDelete_ProductNotFound() {
+	// Arrange
+	id := uint(999)
+
+	suite.mockDB.ExpectBegin()
+	suite.mockDB.ExpectExec(`UPDATE "product" SET "deleted_at"=\$1,"version"=version \+ 1,"updated_at"=\$2 WHERE id = \$3 AND "product"."deleted_at" IS NULL`).
+		WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), id).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	suite.mockDB.ExpectCommit()
+
+	// Act
+	err := suite.repository.Delete(context.Background(), id, 0)
+
+	// Assert
+	assert.Error(suite.T(), err)
+	assert.ErrorIs(suite.T(), err, domainerrors.ErrNotFound)
+	suite.mockDB.ExpectationsWereMet()
+}
+
 func (suite *ProductRepositoryTestSuite) TestDelete_DatabaseError() {
 	// Arrange
 	id := uint(1)
 	expectedError := errors.New("database delete error")
 
 	suite.mockDB.ExpectBegin()
-	suite.mockDB.ExpectExec(`DELETE FROM "product"`).
-		WithArgs(id).
+	suite.mockDB.ExpectExec(`UPDATE "product" SET "deleted_at"=\$1,"version"=version \+ 1,"updated_at"=\$2 WHERE id = \$3 AND "product"."deleted_at" IS NULL`).
+		WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), id).
 		WillReturnError(expectedError)
 	suite.mockDB.ExpectRollback()
 
 	// Act
-	err := suite.repository.Delete(id)
+	err := suite.repository.Delete(context.Background(), id, 0)
 
 	// Assert
 	assert.Error(suite.T(), err)
 	suite.mockDB.ExpectationsWereMet()
 }
+
+func (suite *ProductRepositoryTestSuite) TestRestore_Success() {
+	// Arrange
+	id := uint(1)
+
+	suite.mockDB.ExpectBegin()
+	suite.mockDB.ExpectExec(`UPDATE "product" SET "deleted_at"=\$1,"version"=version \+ 1,"updated_at"=\$2 WHERE id = \$3 AND deleted_at IS NOT NULL`).
+		WithArgs(nil, sqlmock.AnyArg(), id).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	suite.mockDB.ExpectCommit()
+
+	// Act
+	err := suite.repository.Restore(context.Background(), id)
+
+	// Assert
+	assert.NoError(suite.T(), err)
+	assert.NoError(suite.T(), suite.mockDB.ExpectationsWereMet())
+}
+
+func (suite *ProductRepositoryTestSuite) TestRestore_ProductNotDeleted() {
+	// Arrange
+	id := uint(999)
+
+	suite.mockDB.ExpectBegin()
+	suite.mockDB.ExpectExec(`UPDATE "product" SET "deleted_at"=\$1,"version"=version \+ 1,"updated_at"=\$2 WHERE id = \$3 AND deleted_at IS NOT NULL`).
+		WithArgs(nil, sqlmock.AnyArg(), id).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	suite.mockDB.ExpectCommit()
+
+	// Act
+	err := suite.repository.Restore(context.Background(), id)
+
+	// Assert
+	assert.ErrorIs(suite.T(), err, domainerrors.ErrNotFound)
+	assert.NoError(suite.T(), suite.mockDB.ExpectationsWereMet())
+}
+
+func (suite *ProductRepositoryTestSuite) TestLastModified_Success() {
+	// Arrange - deleted products are included
+	updatedAt := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	suite.mockDB.ExpectQuery(`SELECT "updated_at" FROM "product" ORDER BY updated_at DESC LIMIT \$1`).
+		WithArgs(1).
+		WillReturnRows(sqlmock.NewRows([]string{"updated_at"}).AddRow(updatedAt))
+
+	// Act
+	lastModified, err := suite.repository.LastModified(context.Background())
+
+	// Assert
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), updatedAt, lastModified)
+	assert.NoError(suite.T(), suite.mockDB.ExpectationsWereMet())
+}
+
+func (suite *ProductRepositoryTestSuite) TestLastModified_NoProducts() {
+	// Arrange
+	suite.mockDB.ExpectQuery(`SELECT "updated_at" FROM "product"`).
+		WillReturnRows(sqlmock.NewRows([]string{"updated_at"}))
+
+	// Act
+	lastModified, err := suite.repository.LastModified(context.Background())
+
+	// Assert
+	assert.NoError(suite.T(), err)
+	assert.True(suite.T(), lastModified.IsZero())
+}
+
+func (suite *ProductRepositoryTestSuite) TestLastModified_DatabaseError() {
+	// Arrange
+	suite.mockDB.ExpectQuery(`SELECT "updated_at" FROM "product"`).
+		WillReturnError(errors.New("database error"))
+
+	// Act
+	_, err := suite.repository.LastModified(context.Background())
+
+	// Assert
+	assert.Error(suite.T(), err)
+}
+
+func (suite *ProductRepositoryTestSuite) TestCountByCategory_Success() {
+	// Arrange
+	suite.mockDB.ExpectQuery(`SELECT category, COUNT\(\*\) AS count FROM "product" WHERE "product"."deleted_at" IS NULL GROUP BY "category"`).
+		WillReturnRows(sqlmock.NewRows([]string{"category", "count"}).AddRow(1, 3).AddRow(4, 1))
+
+	// Act
+	counts, err := suite.repository.CountByCategory(context.Background())
+
+	// Assert
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), map[int]int64{1: 3, 4: 1}, counts)
+	assert.NoError(suite.T(), suite.mockDB.ExpectationsWereMet())
+}
+
+func (suite *ProductRepositoryTestSuite) TestCountByCategory_DatabaseError() {
+	// Arrange
+	suite.mockDB.ExpectQuery(`SELECT category, COUNT\(\*\) AS count FROM "product"`).
+		WillReturnError(errors.New("database error"))
+
+	// Act
+	counts, err := suite.repository.CountByCategory(context.Background())
+
+	// Assert
+	assert.Error(suite.T(), err)
+	assert.Nil(suite.T(), counts)
+}
@@ -0,0 +1,284 @@
+package persistence
+
+import (
+	"cmp"
+	"context"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/mathefer/tc-fiap-product/internal/product/domain/domainerrors"
+	"github.com/mathefer/tc-fiap-product/internal/product/domain/entities"
+	"github.com/mathefer/tc-fiap-product/internal/product/domain/repositories"
+	"gorm.io/gorm"
+)
+
+var (
+	_ repositories.ProductRepository = (*InMemoryProductRepository)(nil)
+)
+
+// InMemoryProductRepository keeps products in an InMemoryStore and behaves
+// like ProductRepositoryImpl, including soft deletion.
+type InMemoryProductRepository struct {
+	store *InMemoryStore
+}
+
+func NewInMemoryProductRepository(store *InMemoryStore) *InMemoryProductRepository {
+	return &InMemoryProductRepository{store: store}
+}
+
+func (r *InMemoryProductRepository) Get(ctx context.Context, category uint, includeDeleted bool) ([]*entities.Product, error) {
+	products := []*entities.Product{}
+	err := r.store.read(ctx, func() error {
+		products = r.filter(func(p *entities.Product) bool {
+			return (includeDeleted || !p.DeletedAt.Valid) && p.Category == int(category)
+		})
+		return nil
+	})
+	return products, err
+}
+
+func (r *InMemoryProductRepository) GetByID(ctx context.Context, id uint, includeDeleted bool) (*entities.Product, error) {
+	var product *entities.Product
+	err := r.store.read(ctx, func() error {
+		stored, ok := r.store.products[id]
+		if !ok || (stored.DeletedAt.Valid && !includeDeleted) {
+			return domainerrors.NewNotFoundError(productResource, id)
+		}
+		product = &stored
+		return nil
+	})
+	return product, err
+}
+
+func (r *InMemoryProductRepository) GetByIDForUpdate(ctx context.Context, id uint) (*entities.Product, error) {
+	return r.GetByID(ctx, id, false)
+}
+
+func (r *InMemoryProductRepository) GetByIDs(ctx context.Context, ids []uint, includeDeleted bool) ([]*entities.Product, error) {
+	products := []*entities.Product{}
+	err := r.store.read(ctx, func() error {
+		products = r.filter(func(p *entities.Product) bool {
+			return (includeDeleted || !p.DeletedAt.Valid) && slices.Contains(ids, p.ID)
+		})
+		return nil
+	})
+	return products, err
+}
+
+func (r *InMemoryProductRepository) List(ctx context.Context, options repositories.ProductListOptions) ([]*entities.Product, int64, error) {
+	products := []*entities.Product{}
+	var total int64
+	err := r.store.read(ctx, func() error {
+		matching := r.filter(func(p *entities.Product) bool {
+			return (options.IncludeDeleted || !p.DeletedAt.Valid) &&
+				(options.Category == 0 || p.Category == int(options.Category))
+		})
+		total = int64(len(matching))
+
+		// Order like the SQL query: by the sort column in the requested
+		// direction, then by ascending ID; without a sort column by ID alone.
+		compare, sorted := productComparators[options.SortBy]
+		order := func(a, b *entities.Product) int {
+			if !sorted {
+				return direction(cmp.Compare(a.ID, b.ID), options.Descending)
+			}
+			if c := direction(compare(a, b), options.Descending); c != 0 {
+				return c
+			}
+			return cmp.Compare(a.ID, b.ID)
+		}
+		slices.SortStableFunc(matching, order)
+
+		start := min(max(options.Offset, 0), len(matching))
+		if after := options.After; after != nil {
+			position := &entities.Product{
+				ID:        after.ID,
+				Name:      after.Name,
+				Price:     entities.Money{Amount: after.PriceAmount},
+				CreatedAt: after.CreatedAt,
+			}
+			start = len(matching)
+			for i, product := range matching {
+				if order(product, position) > 0 {
+					start = i
+					break
+				}
+			}
+		}
+		end := len(matching)
+		if options.Limit >= 0 {
+			end = min(start+options.Limit, end)
+		}
+		products = matching[start:end]
+		return nil
+	})
+	if err != nil {
+		return []*entities.Product{}, 0, err
+	}
+	return products, total, nil
+}
+
+// CountByCategory returns the number of products in each category that has
+// any, ignoring soft-deleted products.
+func (r *InMemoryProductRepository) CountByCategory(ctx context.Context) (map[int]int64, error) {
+	counts := map[int]int64{}
+	err := r.store.read(ctx, func() error {
+		for _, product := range r.store.products {
+			if !product.DeletedAt.Valid {
+				counts[product.Category]++
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return counts, nil
+}
+
+func (r *InMemoryProductRepository) Add(ctx context.Context, product *entities.Product) error {
+	return r.store.write(ctx, func() error {
+		if product.ID == 0 {
+			product.ID = r.store.nextProductID
+		}
+		if _, ok := r.store.products[product.ID]; ok {
+			return domainerrors.NewConflictError(productResource + " already exists")
+		}
+		if err := r.checkCategory(product.Category); err != nil {
+			return err
+		}
+		if product.CreatedAt.IsZero() {
+			product.CreatedAt = time.Now()
+		}
+		product.UpdatedAt = product.CreatedAt
+		if product.Version == 0 {
+			product.Version = 1
+		}
+
+		r.store.products[product.ID] = *product
+		r.store.nextProductID = max(r.store.nextProductID, product.ID+1)
+		return nil
+	})
+}
+
+// Update replaces every editable column of the product, including empty
+// values, when product.Version is still the stored version, and increments
+// the version.
+func (r *InMemoryProductRepository) Update(ctx context.Context, product *entities.Product) error {
+	return r.store.write(ctx, func() error {
+		stored, ok := r.store.products[product.ID]
+		if !ok || stored.DeletedAt.Valid {
+			return domainerrors.NewNotFoundError(productResource, product.ID)
+		}
+		if product.Version != 0 && product.Version != stored.Version {
+			return domainerrors.NewPreconditionFailedError(productResource, product.ID)
+		}
+		if err := r.checkCategory(product.Category); err != nil {
+			return err
+		}
+
+		stored.Name = product.Name
+		stored.Category = product.Category
+		stored.Price = product.Price
+		stored.Description = product.Description
+		stored.ImageLink = product.ImageLink
+		stored.Version++
+		stored.UpdatedAt = time.Now()
+		r.store.products[product.ID] = stored
+		return nil
+	})
+}
+
+// Delete soft deletes the given version of the product; it disappears from
+// every query but can be brought back with Restore.
+func (r *InMemoryProductRepository) Delete(ctx context.Context, id uint, version uint) error {
+	return r.store.write(ctx, func() error {
+		stored, ok := r.store.products[id]
+		if !ok || stored.DeletedAt.Valid {
+			return domainerrors.NewNotFoundError(productResource, id)
+		}
+		if version != 0 && version != stored.Version {
+			return domainerrors.NewPreconditionFailedError(productResource, id)
+		}
+		stored.DeletedAt = gorm.DeletedAt{Time: time.Now(), Valid: true}
+		stored.UpdatedAt = stored.DeletedAt.Time
+		stored.Version++
+		r.store.products[id] = stored
+		return nil
+	})
+}
+
+// Restore clears the deletion mark of a soft-deleted product and increments
+// its version. It reports not found when there is no deleted product with
+// the given ID.
+func (r *InMemoryProductRepository) Restore(ctx context.Context, id uint) error {
+	return r.store.write(ctx, func() error {
+		stored, ok := r.store.products[id]
+		if !ok || !stored.DeletedAt.Valid {
+			return domainerrors.NewNotFoundError(productResource, id)
+		}
+		stored.DeletedAt = gorm.DeletedAt{}
+		stored.UpdatedAt = time.Now()
+		stored.Version++
+		r.store.products[id] = stored
+		return nil
+	})
+}
+
+// LastModified returns the most recent UpdatedAt of any product, including
+// deleted ones, so that removing a product also counts as a change.
+func (r *InMemoryProductRepository) LastModified(ctx context.Context) (time.Time, error) {
+	var latest time.Time
+	err := r.store.read(ctx, func() error {
+		for _, product := range r.store.products {
+			if product.UpdatedAt.After(latest) {
+				latest = product.UpdatedAt
+			}
+		}
+		return nil
+	})
+	return latest, err
+}
+
+// checkCategory enforces the foreign key from product.category to category.id.
+func (r *InMemoryProductRepository) checkCategory(category int) error {
+	if _, ok := r.store.categories[uint(category)]; category <= 0 || !ok {
+		return domainerrors.NewConflictError(productResource + " references a resource that does not exist")
+	}
+	return nil
+}
+
+// filter returns copies of the stored products that match, ordered by ID.
+// The caller must hold the store's lock.
+func (r *InMemoryProductRepository) filter(match func(*entities.Product) bool) []*entities.Product {
+	products := []*entities.Product{}
+	for _, stored := range r.store.products {
+		if match(&stored) {
+			product := stored
+			products = append(products, &product)
+		}
+	}
+	slices.SortFunc(products, func(a, b *entities.Product) int { return cmp.Compare(a.ID, b.ID) })
+	return products
+}
+
+// productComparators mirrors sortColumns for products held in memory.
+var productComparators = map[string]func(a, b *entities.Product) int{
+	repositories.ProductSortByName: func(a, b *entities.Product) int {
+		return strings.Compare(a.Name, b.Name)
+	},
+	repositories.ProductSortByPrice: func(a, b *entities.Product) int {
+		return cmp.Compare(a.Price.Amount, b.Price.Amount)
+	},
+	repositories.ProductSortByCreatedAt: func(a, b *entities.Product) int {
+		return a.CreatedAt.Compare(b.CreatedAt)
+	},
+}
+
+func direction(c int, descending bool) int {
+	if descending {
+		return -c
+	}
+	return c
+}
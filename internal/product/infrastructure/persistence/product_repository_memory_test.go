@@ -0,0 +1,309 @@
+package persistence_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"github.com/mathefer/tc-fiap-product/internal/product/domain/domainerrors"
+	"github.com/mathefer/tc-fiap-product/internal/product/domain/entities"
+	"github.com/mathefer/tc-fiap-product/internal/product/domain/repositories"
+	"github.com/mathefer/tc-fiap-product/internal/product/infrastructure/persistence"
+)
+
+type InMemoryProductRepositoryTestSuite struct {
+	suite.Suite
+	ctx        context.Context
+	repository *persistence.InMemoryProductRepository
+}
+
+func (suite *InMemoryProductRepositoryTestSuite) SetupTest() {
+	suite.ctx = context.Background()
+	suite.repository = persistence.NewInMemoryProductRepository(persistence.NewInMemoryStore())
+}
+
+func TestInMemoryProductRepositoryTestSuite(t *testing.T) {
+	suite.Run(t, new(InMemoryProductRepositoryTestSuite))
+}
+
+func (suite *InMemoryProductRepositoryTestSuite) add(name string, category int, amount int64) *entities.Product {
+	product := &entities.Product{Name: name, Category: category, Price: entities.NewMoney(amount, "BRL")}
+	assert.NoError(suite.T(), suite.repository.Add(suite.ctx, product))
+	return product
+}
+
+func (suite *InMemoryProductRepositoryTestSuite) TestAdd_AssignsIDAndCreatedAt() {
+	// Act
+	first := suite.add("Hamburguer", 1, 3499)
+	second := suite.add("Refrigerante", 3, 650)
+
+	// Assert
+	assert.Equal(suite.T(), uint(1), first.ID)
+	assert.Equal(suite.T(), uint(2), second.ID)
+	assert.False(suite.T(), first.CreatedAt.IsZero())
+	assert.Equal(suite.T(), uint(1), first.Version)
+}
+
+func (suite *InMemoryProductRepositoryTestSuite) TestAdd_UnknownCategory() {
+	// Act
+	err := suite.repository.Add(suite.ctx, &entities.Product{Name: "Combo", Category: 99})
+
+	// Assert
+	assert.ErrorIs(suite.T(), err, domainerrors.ErrConflict)
+}
+
+func (suite *InMemoryProductRepositoryTestSuite) TestAdd_DuplicateID() {
+	// Arrange
+	product := suite.add("Hamburguer", 1, 3499)
+
+	// Act
+	err := suite.repository.Add(suite.ctx, &entities.Product{ID: product.ID, Name: "Other", Category: 1})
+
+	// Assert
+	assert.ErrorIs(suite.T(), err, domainerrors.ErrConflict)
+}
+
+func (suite *InMemoryProductRepositoryTestSuite) TestGetByID_ReturnsCopy() {
+	// Arrange
+	product := suite.add("Hamburguer", 1, 3499)
+
+	// Act
+	found, err := suite.repository.GetByID(suite.ctx, product.ID, false)
+	found.Name = "Changed"
+	again, _ := suite.repository.GetByID(suite.ctx, product.ID, false)
+
+	// Assert
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), "Hamburguer", again.Name)
+}
+
+func (suite *InMemoryProductRepositoryTestSuite) TestGetByID_NotFound() {
+	// Act
+	product, err := suite.repository.GetByID(suite.ctx, 999, false)
+
+	// Assert
+	assert.ErrorIs(suite.T(), err, domainerrors.ErrNotFound)
+	assert.Nil(suite.T(), product)
+}
+
+func (suite *InMemoryProductRepositoryTestSuite) TestGet_FiltersCategoryAndDeleted() {
+	// Arrange
+	kept := suite.add("Hamburguer", 1, 3499)
+	deleted := suite.add("X-Salada", 1, 2999)
+	suite.add("Refrigerante", 3, 650)
+	assert.NoError(suite.T(), suite.repository.Delete(suite.ctx, deleted.ID, 0))
+
+	// Act
+	products, err := suite.repository.Get(suite.ctx, 1, false)
+	withDeleted, _ := suite.repository.Get(suite.ctx, 1, true)
+
+	// Assert
+	assert.NoError(suite.T(), err)
+	assert.Len(suite.T(), products, 1)
+	assert.Equal(suite.T(), kept.ID, products[0].ID)
+	assert.Len(suite.T(), withDeleted, 2)
+}
+
+func (suite *InMemoryProductRepositoryTestSuite) TestGetByIDs_SkipsMissingAndDeleted() {
+	// Arrange
+	first := suite.add("Hamburguer", 1, 3499)
+	deleted := suite.add("X-Salada", 1, 2999)
+	assert.NoError(suite.T(), suite.repository.Delete(suite.ctx, deleted.ID, 0))
+
+	// Act
+	products, err := suite.repository.GetByIDs(suite.ctx, []uint{first.ID, deleted.ID, 999}, false)
+	withDeleted, _ := suite.repository.GetByIDs(suite.ctx, []uint{first.ID, deleted.ID, 999}, true)
+
+	// Assert
+	assert.NoError(suite.T(), err)
+	assert.Len(suite.T(), products, 1)
+	assert.Equal(suite.T(), first.ID, products[0].ID)
+	assert.Len(suite.T(), withDeleted, 2)
+}
+
+func (suite *InMemoryProductRepositoryTestSuite) TestList_SortsAndPaginates() {
+	// Arrange
+	suite.add("Batata", 2, 1200)
+	suite.add("Hamburguer", 1, 3499)
+	suite.add("Agua", 3, 400)
+	suite.add("Suco", 3, 1200)
+
+	// Act
+	products, total, err := suite.repository.List(suite.ctx, repositories.ProductListOptions{
+		SortBy:     repositories.ProductSortByPrice,
+		Descending: true,
+		Limit:      2,
+		Offset:     1,
+	})
+
+	// Assert - equal prices keep ascending ID order
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), int64(4), total)
+	assert.Len(suite.T(), products, 2)
+	assert.Equal(suite.T(), "Batata", products[0].Name)
+	assert.Equal(suite.T(), "Suco", products[1].Name)
+}
+
+func (suite *InMemoryProductRepositoryTestSuite) TestList_AfterPosition() {
+	// Arrange - the first product of the listing is removed after the
+	// previous page was read
+	batata := suite.add("Batata", 2, 1200)
+	hamburguer := suite.add("Hamburguer", 1, 3499)
+	suite.add("Agua", 3, 400)
+	suite.add("Suco", 3, 1200)
+	assert.NoError(suite.T(), suite.repository.Delete(suite.ctx, hamburguer.ID, 0))
+
+	// Act
+	products, total, err := suite.repository.List(suite.ctx, repositories.ProductListOptions{
+		SortBy:     repositories.ProductSortByPrice,
+		Descending: true,
+		Limit:      10,
+		After:      &repositories.ProductListPosition{ID: batata.ID, PriceAmount: 1200},
+	})
+
+	// Assert - the page still starts right after Batata
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), int64(3), total)
+	assert.Len(suite.T(), products, 2)
+	assert.Equal(suite.T(), "Suco", products[0].Name)
+	assert.Equal(suite.T(), "Agua", products[1].Name)
+}
+
+func (suite *InMemoryProductRepositoryTestSuite) TestList_FiltersCategoryByIDDescending() {
+	// Arrange
+	suite.add("Agua", 3, 400)
+	suite.add("Hamburguer", 1, 3499)
+	suite.add("Suco", 3, 1200)
+
+	// Act
+	products, total, err := suite.repository.List(suite.ctx, repositories.ProductListOptions{
+		Category:   3,
+		Descending: true,
+		Limit:      10,
+	})
+
+	// Assert
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), int64(2), total)
+	assert.Equal(suite.T(), "Suco", products[0].Name)
+	assert.Equal(suite.T(), "Agua", products[1].Name)
+}
+
+func (suite *InMemoryProductRepositoryTestSuite) TestCountByCategory_IgnoresDeleted() {
+	// Arrange
+	suite.add("Hamburguer", 1, 3499)
+	deleted := suite.add("X-Salada", 1, 2999)
+	suite.add("Agua", 3, 400)
+	assert.NoError(suite.T(), suite.repository.Delete(suite.ctx, deleted.ID, 0))
+
+	// Act
+	counts, err := suite.repository.CountByCategory(suite.ctx)
+
+	// Assert
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), map[int]int64{1: 1, 3: 1}, counts)
+}
+
+func (suite *InMemoryProductRepositoryTestSuite) TestUpdate() {
+	// Arrange
+	product := suite.add("Hamburguer", 1, 3499)
+
+	// Act
+	err := suite.repository.Update(suite.ctx, &entities.Product{
+		ID: product.ID, Name: "X-Burguer", Category: 1, Price: entities.NewMoney(3999, "BRL"),
+	})
+	updated, _ := suite.repository.GetByID(suite.ctx, product.ID, false)
+
+	// Assert
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), "X-Burguer", updated.Name)
+	assert.Equal(suite.T(), int64(3999), updated.Price.Amount)
+	assert.Equal(suite.T(), product.CreatedAt, updated.CreatedAt)
+	assert.Equal(suite.T(), uint(2), updated.Version)
+}
+
+func (suite *InMemoryProductRepositoryTestSuite) TestUpdateAndDelete_Versioned() {
+	// Arrange
+	product := suite.add("Hamburguer", 1, 3499)
+	change := &entities.Product{ID: product.ID, Name: "X-Burguer", Category: 1, Price: entities.NewMoney(3999, "BRL"), Version: 1}
+
+	// Act
+	first := suite.repository.Update(suite.ctx, change)
+	stale := suite.repository.Update(suite.ctx, change)
+	staleDelete := suite.repository.Delete(suite.ctx, product.ID, 1)
+	current := suite.repository.Delete(suite.ctx, product.ID, 2)
+
+	// Assert - only the first write based on version 1 succeeds
+	assert.NoError(suite.T(), first)
+	assert.ErrorIs(suite.T(), stale, domainerrors.ErrPreconditionFailed)
+	assert.ErrorIs(suite.T(), staleDelete, domainerrors.ErrPreconditionFailed)
+	assert.NoError(suite.T(), current)
+}
+
+func (suite *InMemoryProductRepositoryTestSuite) TestUpdate_NotFoundAndUnknownCategory() {
+	// Arrange
+	product := suite.add("Hamburguer", 1, 3499)
+
+	// Act
+	notFound := suite.repository.Update(suite.ctx, &entities.Product{ID: 999, Name: "X", Category: 1})
+	conflict := suite.repository.Update(suite.ctx, &entities.Product{ID: product.ID, Name: "X", Category: 99})
+
+	// Assert
+	assert.ErrorIs(suite.T(), notFound, domainerrors.ErrNotFound)
+	assert.ErrorIs(suite.T(), conflict, domainerrors.ErrConflict)
+}
+
+func (suite *InMemoryProductRepositoryTestSuite) TestDeleteAndRestore() {
+	// Arrange
+	product := suite.add("Hamburguer", 1, 3499)
+
+	// Act & Assert - a deleted product is hidden and cannot be deleted again
+	assert.NoError(suite.T(), suite.repository.Delete(suite.ctx, product.ID, 0))
+	_, err := suite.repository.GetByID(suite.ctx, product.ID, false)
+	assert.ErrorIs(suite.T(), err, domainerrors.ErrNotFound)
+	deleted, err := suite.repository.GetByID(suite.ctx, product.ID, true)
+	assert.NoError(suite.T(), err)
+	assert.True(suite.T(), deleted.DeletedAt.Valid)
+	assert.Equal(suite.T(), product.Version+1, deleted.Version)
+	assert.ErrorIs(suite.T(), suite.repository.Delete(suite.ctx, product.ID, 0), domainerrors.ErrNotFound)
+
+	// Act & Assert - restoring brings it back, only once
+	assert.NoError(suite.T(), suite.repository.Restore(suite.ctx, product.ID))
+	restored, err := suite.repository.GetByID(suite.ctx, product.ID, false)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), deleted.Version+1, restored.Version)
+	assert.ErrorIs(suite.T(), suite.repository.Restore(suite.ctx, product.ID), domainerrors.ErrNotFound)
+}
+
+func (suite *InMemoryProductRepositoryTestSuite) TestLastModified_AdvancesOnEveryWrite() {
+	// Arrange
+	empty, err := suite.repository.LastModified(suite.ctx)
+	assert.NoError(suite.T(), err)
+	product := suite.add("Hamburguer", 1, 3499)
+	added, _ := suite.repository.LastModified(suite.ctx)
+
+	// Act - deleting also counts, although the product is no longer listed
+	assert.NoError(suite.T(), suite.repository.Delete(suite.ctx, product.ID, 0))
+	deleted, _ := suite.repository.LastModified(suite.ctx)
+	assert.NoError(suite.T(), suite.repository.Restore(suite.ctx, product.ID))
+	restored, _ := suite.repository.LastModified(suite.ctx)
+
+	// Assert
+	assert.True(suite.T(), empty.IsZero())
+	assert.Equal(suite.T(), product.CreatedAt, added)
+	assert.False(suite.T(), deleted.Before(added))
+	assert.False(suite.T(), restored.Before(deleted))
+}
+
+func (suite *InMemoryProductRepositoryTestSuite) TestCanceledContext() {
+	// Arrange
+	ctx, cancel := context.WithCancel(suite.ctx)
+	cancel()
+
+	// Act
+	_, err := suite.repository.GetByID(ctx, 1, false)
+
+	// Assert
+	assert.ErrorIs(suite.T(), err, context.Canceled)
+}
@@ -0,0 +1,31 @@
+package persistence
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/mathefer/tc-fiap-product/internal/product/domain/entities"
+	"gorm.io/gorm"
+)
+
+// AutoMigrate creates the product schema from the entities and seeds the
+// default categories into an empty category table. It serves databases that
+// the versioned PostgreSQL migrations do not cover, such as SQLite.
+func AutoMigrate(ctx context.Context, db *gorm.DB) error {
+	db = db.WithContext(ctx)
+	if err := db.AutoMigrate(&entities.Category{}, &entities.Product{}, &entities.IdempotencyKey{}); err != nil {
+		return fmt.Errorf("failed to migrate database: %w", err)
+	}
+
+	var count int64
+	if err := db.Model(&entities.Category{}).Count(&count).Error; err != nil {
+		return err
+	}
+	if count > 0 {
+		return nil
+	}
+	if err := db.Create(entities.DefaultCategories()).Error; err != nil {
+		return fmt.Errorf("failed to seed categories: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,11 @@
+package presenter
+
+import (
+	"github.com/mathefer/tc-fiap-product/internal/product/domain/entities"
+	"github.com/mathefer/tc-fiap-product/internal/product/infrastructure/api/dto"
+)
+
+type CategoryPresenter interface {
+	Present(categories []*entities.Category) []*dto.GetCategoryResponseDto
+	PresentOne(category *entities.Category) *dto.GetCategoryResponseDto
+}
@@ -0,0 +1,37 @@
+package presenter
+
+import (
+	"github.com/mathefer/tc-fiap-product/internal/product/domain/entities"
+	"github.com/mathefer/tc-fiap-product/internal/product/infrastructure/api/dto"
+)
+
+var (
+	_ CategoryPresenter = (*CategoryPresenterImpl)(nil)
+)
+
+type CategoryPresenterImpl struct {
+}
+
+func NewCategoryPresenterImpl() *CategoryPresenterImpl {
+	return &CategoryPresenterImpl{}
+}
+
+func (p *CategoryPresenterImpl) Present(categories []*entities.Category) []*dto.GetCategoryResponseDto {
+	categoryDto := make([]*dto.GetCategoryResponseDto, len(categories))
+
+	for i, category := range categories {
+		categoryDto[i] = p.PresentOne(category)
+	}
+
+	return categoryDto
+}
+
+func (p *CategoryPresenterImpl) PresentOne(category *entities.Category) *dto.GetCategoryResponseDto {
+	return &dto.GetCategoryResponseDto{
+		ID:          category.ID,
+		CreatedAt:   category.CreatedAt,
+		DisplayName: category.DisplayName,
+		SortOrder:   category.SortOrder,
+		Active:      category.Active,
+	}
+}
@@ -0,0 +1,55 @@
+package presenter_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"github.com/mathefer/tc-fiap-product/internal/product/domain/entities"
+	"github.com/mathefer/tc-fiap-product/internal/product/presenter"
+)
+
+type CategoryPresenterTestSuite struct {
+	suite.Suite
+	presenter presenter.CategoryPresenter
+}
+
+func (suite *CategoryPresenterTestSuite) SetupTest() {
+	suite.presenter = presenter.NewCategoryPresenterImpl()
+}
+
+func TestCategoryPresenterTestSuite(t *testing.T) {
+	suite.Run(t, new(CategoryPresenterTestSuite))
+}
+
+func (suite *CategoryPresenterTestSuite) TestPresent_Success() {
+	// Arrange
+	now := time.Now()
+	categories := []*entities.Category{
+		{ID: 1, CreatedAt: now, DisplayName: "Lanche", SortOrder: 1, Active: true},
+		{ID: 5, CreatedAt: now, DisplayName: "Combo", SortOrder: 5, Active: false},
+	}
+
+	// Act
+	result := suite.presenter.Present(categories)
+
+	// Assert
+	assert.Len(suite.T(), result, 2)
+	assert.Equal(suite.T(), uint(1), result[0].ID)
+	assert.Equal(suite.T(), now, result[0].CreatedAt)
+	assert.Equal(suite.T(), "Lanche", result[0].DisplayName)
+	assert.Equal(suite.T(), 1, result[0].SortOrder)
+	assert.True(suite.T(), result[0].Active)
+	assert.Equal(suite.T(), "Combo", result[1].DisplayName)
+	assert.False(suite.T(), result[1].Active)
+}
+
+func (suite *CategoryPresenterTestSuite) TestPresent_Empty() {
+	// Act
+	result := suite.presenter.Present([]*entities.Category{})
+
+	// Assert
+	assert.NotNil(suite.T(), result)
+	assert.Len(suite.T(), result, 0)
+}
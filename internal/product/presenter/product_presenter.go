@@ -7,4 +7,9 @@ import (
 
 type ProductPresenter interface {
 	Present(products []*entities.Product) []*dto.GetProductResponseDto
+	PresentOne(product *entities.Product) *dto.GetProductResponseDto
+	PresentLookup(products []*entities.Product, missingIDs []uint) *dto.LookupProductsResponseDto
+	// PresentList presents a page of the listing requested by request; more
+	// tells whether another page follows it.
+	PresentList(products []*entities.Product, total int64, request *dto.ListProductsRequestDto, more bool) *dto.ListProductsResponseDto
 }
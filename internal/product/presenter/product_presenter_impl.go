@@ -2,7 +2,9 @@ package presenter
 
 import (
 	"github.com/mathefer/tc-fiap-product/internal/product/domain/entities"
+	"github.com/mathefer/tc-fiap-product/internal/product/domain/repositories"
 	"github.com/mathefer/tc-fiap-product/internal/product/infrastructure/api/dto"
+	"github.com/mathefer/tc-fiap-product/pkg/rest"
 )
 
 var (
@@ -20,16 +22,74 @@ func (p *ProductPresenterImpl) Present(products []*entities.Product) []*dto.GetP
 	productDto := make([]*dto.GetProductResponseDto, len(products))
 
 	for i, product := range products {
-		productDto[i] = &dto.GetProductResponseDto{
-			ID:          product.ID,
-			CreatedAt:   product.CreatedAt,
-			Name:        product.Name,
-			Category:    product.Category,
-			Price:       product.Price,
-			Description: product.Description,
-			ImageLink:   product.ImageLink,
-		}
+		productDto[i] = p.PresentOne(product)
 	}
 
 	return productDto
 }
+
+func (p *ProductPresenterImpl) PresentOne(product *entities.Product) *dto.GetProductResponseDto {
+	response := &dto.GetProductResponseDto{
+		ID:        product.ID,
+		CreatedAt: product.CreatedAt,
+		Name:      product.Name,
+		Category:  product.Category,
+		Price:     product.Price.Float64(),
+		PriceMoney: dto.MoneyDto{
+			Amount:     product.Price.String(),
+			MinorUnits: product.Price.Amount,
+			Currency:   product.Price.Currency,
+		},
+		Description: product.Description,
+		ImageLink:   product.ImageLink,
+		Version:     product.Version,
+		UpdatedAt:   product.UpdatedAt,
+	}
+
+	if product.DeletedAt.Valid {
+		deletedAt := product.DeletedAt.Time
+		response.DeletedAt = &deletedAt
+	}
+
+	return response
+}
+
+func (p *ProductPresenterImpl) PresentLookup(products []*entities.Product, missingIDs []uint) *dto.LookupProductsResponseDto {
+	return &dto.LookupProductsResponseDto{
+		Products:   p.Present(products),
+		MissingIDs: missingIDs,
+	}
+}
+
+func (p *ProductPresenterImpl) PresentList(products []*entities.Product, total int64, request *dto.ListProductsRequestDto, more bool) *dto.ListProductsResponseDto {
+	response := &dto.ListProductsResponseDto{
+		Items: p.Present(products),
+		Total: total,
+		Limit: request.Limit,
+	}
+	if request.After == nil {
+		response.Offset = request.Offset
+	}
+
+	if more && len(products) > 0 {
+		response.NextCursor = rest.EncodeCursor(listCursor(products[len(products)-1], request.Sort, request.Order))
+	}
+
+	return response
+}
+
+// listCursor returns the position of product in a listing sorted by sort in
+// order, carrying only the value of the sort field.
+func listCursor(product *entities.Product, sort string, order string) *dto.ListProductsCursorDto {
+	cursor := &dto.ListProductsCursorDto{Sort: sort, Order: order, ID: product.ID}
+	switch sort {
+	case repositories.ProductSortByName:
+		cursor.Name = product.Name
+	case repositories.ProductSortByPrice:
+		cursor.Price = product.Price.Amount
+	case repositories.ProductSortByCreatedAt:
+		createdAt := product.CreatedAt
+		cursor.CreatedAt = &createdAt
+	}
+	return cursor
+}
@@ -7,7 +7,10 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/suite"
 	"github.com/mathefer/tc-fiap-product/internal/product/domain/entities"
+	"github.com/mathefer/tc-fiap-product/internal/product/infrastructure/api/dto"
 	"github.com/mathefer/tc-fiap-product/internal/product/presenter"
+	"github.com/mathefer/tc-fiap-product/pkg/rest"
+	"gorm.io/gorm"
 )
 
 type ProductPresenterTestSuite struct {
@@ -32,7 +35,7 @@ func (suite *ProductPresenterTestSuite) TestPresent_Success() {
 			CreatedAt:   now,
 			Name:        "Hamburguer",
 			Category:    1,
-			Price:       34.99,
+			Price:       entities.NewMoney(3499, "BRL"),
 			Description: "Hamburguer com salada",
 			ImageLink:   "https://example.com/image.jpg",
 		},
@@ -41,7 +44,7 @@ func (suite *ProductPresenterTestSuite) TestPresent_Success() {
 			CreatedAt:   now,
 			Name:        "Cheeseburguer",
 			Category:    1,
-			Price:       39.99,
+			Price:       entities.NewMoney(3999, "BRL"),
 			Description: "Hamburguer com queijo",
 			ImageLink:   "https://example.com/cheese.jpg",
 		},
@@ -56,7 +59,7 @@ func (suite *ProductPresenterTestSuite) TestPresent_Success() {
 	assert.Equal(suite.T(), products[0].ID, dtos[0].ID)
 	assert.Equal(suite.T(), products[0].Name, dtos[0].Name)
 	assert.Equal(suite.T(), products[0].Category, dtos[0].Category)
-	assert.Equal(suite.T(), products[0].Price, dtos[0].Price)
+	assert.Equal(suite.T(), products[0].Price.Float64(), dtos[0].Price)
 	assert.Equal(suite.T(), products[0].Description, dtos[0].Description)
 	assert.Equal(suite.T(), products[0].ImageLink, dtos[0].ImageLink)
 	assert.Equal(suite.T(), products[0].CreatedAt, dtos[0].CreatedAt)
@@ -84,7 +87,7 @@ func (suite *ProductPresenterTestSuite) TestPresent_WithEmptyFields() {
 			CreatedAt:   time.Time{},
 			Name:        "",
 			Category:    0,
-			Price:       0.0,
+			Price:       entities.NewMoney(0, "BRL"),
 			Description: "",
 			ImageLink:   "",
 		},
@@ -114,7 +117,7 @@ func (suite *ProductPresenterTestSuite) TestPresent_PreservesAllData() {
 			CreatedAt:   now,
 			Name:        "Pizza Margherita",
 			Category:    1,
-			Price:       45.99,
+			Price:       entities.NewMoney(4599, "BRL"),
 			Description: "Pizza com queijo e manjericão",
 			ImageLink:   "https://example.com/pizza.jpg",
 		},
@@ -130,8 +133,134 @@ func (suite *ProductPresenterTestSuite) TestPresent_PreservesAllData() {
 	assert.Equal(suite.T(), "Pizza Margherita", dtos[0].Name)
 	assert.Equal(suite.T(), 1, dtos[0].Category)
 	assert.Equal(suite.T(), 45.99, dtos[0].Price)
+	assert.Equal(suite.T(), dto.MoneyDto{Amount: "45.99", MinorUnits: 4599, Currency: "BRL"}, dtos[0].PriceMoney)
 	assert.Equal(suite.T(), "Pizza com queijo e manjericão", dtos[0].Description)
 	assert.Equal(suite.T(), "https://example.com/pizza.jpg", dtos[0].ImageLink)
 	assert.Equal(suite.T(), now, dtos[0].CreatedAt)
 }
 
+
+func (suite *ProductPresenterTestSuite) TestPresentOne_Success() {
+	// Arrange
+	now := time.Now()
+	product := &entities.Product{
+		ID:          7,
+		CreatedAt:   now,
+		Name:        "Refrigerante",
+		Category:    3,
+		Price:       entities.NewMoney(650, "BRL"),
+		Description: "Lata 350ml",
+		ImageLink:   "https://example.com/soda.jpg",
+		Version:     2,
+		UpdatedAt:   now.Add(time.Hour),
+	}
+
+	// Act
+	result := suite.presenter.PresentOne(product)
+
+	// Assert
+	assert.NotNil(suite.T(), result)
+	assert.Equal(suite.T(), product.ID, result.ID)
+	assert.Equal(suite.T(), product.Name, result.Name)
+	assert.Equal(suite.T(), product.Category, result.Category)
+	assert.Equal(suite.T(), product.Price.Float64(), result.Price)
+	assert.Equal(suite.T(), product.Description, result.Description)
+	assert.Equal(suite.T(), product.ImageLink, result.ImageLink)
+	assert.Equal(suite.T(), product.Version, result.Version)
+	assert.Equal(suite.T(), now, result.CreatedAt)
+	assert.Equal(suite.T(), product.UpdatedAt, result.UpdatedAt)
+}
+
+func (suite *ProductPresenterTestSuite) TestPresentLookup_Success() {
+	// Arrange
+	products := []*entities.Product{
+		{ID: 1, Name: "Hamburguer", Category: 1, Price: entities.NewMoney(3499, "BRL")},
+	}
+	missingIDs := []uint{2, 3}
+
+	// Act
+	result := suite.presenter.PresentLookup(products, missingIDs)
+
+	// Assert
+	assert.NotNil(suite.T(), result)
+	assert.Len(suite.T(), result.Products, 1)
+	assert.Equal(suite.T(), uint(1), result.Products[0].ID)
+	assert.Equal(suite.T(), missingIDs, result.MissingIDs)
+}
+
+func (suite *ProductPresenterTestSuite) TestPresentList_WithNextPage() {
+	// Arrange
+	products := []*entities.Product{{ID: 1, Price: entities.NewMoney(3499, "BRL")}, {ID: 2, Price: entities.NewMoney(4999, "BRL")}}
+	request := &dto.ListProductsRequestDto{Sort: "price", Order: "desc", Limit: 2, Offset: 4}
+
+	// Act
+	result := suite.presenter.PresentList(products, 5, request, true)
+
+	// Assert - the cursor points after the last product, by its price
+	assert.Len(suite.T(), result.Items, 2)
+	assert.Equal(suite.T(), int64(5), result.Total)
+	assert.Equal(suite.T(), 2, result.Limit)
+	assert.Equal(suite.T(), 4, result.Offset)
+	var cursor dto.ListProductsCursorDto
+	assert.NoError(suite.T(), rest.DecodeCursor(result.NextCursor, &cursor))
+	assert.Equal(suite.T(), dto.ListProductsCursorDto{Sort: "price", Order: "desc", ID: 2, Price: 4999}, cursor)
+}
+
+func (suite *ProductPresenterTestSuite) TestPresentList_CursorByCreatedAt() {
+	// Arrange
+	createdAt := time.Date(2024, 2, 1, 12, 0, 0, 0, time.UTC)
+	products := []*entities.Product{{ID: 3, Name: "Suco", CreatedAt: createdAt}}
+	request := &dto.ListProductsRequestDto{Sort: "created_at", Limit: 1, After: &dto.ListProductsCursorDto{ID: 2}}
+
+	// Act
+	result := suite.presenter.PresentList(products, 5, request, true)
+
+	// Assert
+	var cursor dto.ListProductsCursorDto
+	assert.NoError(suite.T(), rest.DecodeCursor(result.NextCursor, &cursor))
+	assert.Equal(suite.T(), uint(3), cursor.ID)
+	assert.Empty(suite.T(), cursor.Name)
+	assert.True(suite.T(), createdAt.Equal(*cursor.CreatedAt))
+}
+
+func (suite *ProductPresenterTestSuite) TestPresentList_LastPage() {
+	// Arrange
+	products := []*entities.Product{{ID: 5}}
+
+	// Act
+	result := suite.presenter.PresentList(products, 5, &dto.ListProductsRequestDto{Limit: 2, Offset: 4}, false)
+
+	// Assert
+	assert.Len(suite.T(), result.Items, 1)
+	assert.Empty(suite.T(), result.NextCursor)
+}
+
+func (suite *ProductPresenterTestSuite) TestPresentOne_DeletedProduct() {
+	// Arrange
+	deletedAt := time.Date(2024, 2, 1, 12, 0, 0, 0, time.UTC)
+	product := &entities.Product{
+		ID:        9,
+		Name:      "Suco",
+		Category:  3,
+		Price:     entities.NewMoney(800, "BRL"),
+		DeletedAt: gorm.DeletedAt{Time: deletedAt, Valid: true},
+	}
+
+	// Act
+	result := suite.presenter.PresentOne(product)
+
+	// Assert
+	assert.NotNil(suite.T(), result.DeletedAt)
+	assert.Equal(suite.T(), deletedAt, *result.DeletedAt)
+}
+
+func (suite *ProductPresenterTestSuite) TestPresentOne_ActiveProductHasNoDeletedAt() {
+	// Arrange
+	product := &entities.Product{ID: 10, Name: "Agua", Category: 3, Price: entities.NewMoney(500, "BRL")}
+
+	// Act
+	result := suite.presenter.PresentOne(product)
+
+	// Assert
+	assert.Nil(suite.T(), result.DeletedAt)
+}
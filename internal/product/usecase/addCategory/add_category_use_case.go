@@ -0,0 +1,11 @@
+package addcategory
+
+import (
+	"context"
+
+	"github.com/mathefer/tc-fiap-product/internal/product/usecase/commands"
+)
+
+type AddCategoryUseCase interface {
+	Execute(ctx context.Context, command *commands.AddCategoryCommand) error
+}
@@ -0,0 +1,49 @@
+package addcategory
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/mathefer/tc-fiap-product/internal/product/domain/entities"
+	"github.com/mathefer/tc-fiap-product/internal/product/domain/repositories"
+	"github.com/mathefer/tc-fiap-product/internal/product/usecase/commands"
+	"github.com/mathefer/tc-fiap-product/pkg/tracing"
+)
+
+var (
+	_ AddCategoryUseCase = (*AddCategoryUseCaseImpl)(nil)
+)
+
+type AddCategoryUseCaseImpl struct {
+	categoryRepository repositories.CategoryRepository
+	logger             *slog.Logger
+}
+
+func NewAddCategoryUseCaseImpl(categoryRepository repositories.CategoryRepository, logger *slog.Logger) *AddCategoryUseCaseImpl {
+	return &AddCategoryUseCaseImpl{categoryRepository: categoryRepository, logger: logger}
+}
+
+func (u *AddCategoryUseCaseImpl) Execute(ctx context.Context, command *commands.AddCategoryCommand) (err error) {
+	ctx, span := tracing.Start(ctx, "AddCategoryUseCase.Execute")
+	defer func() {
+		tracing.RecordError(span, err)
+		span.End()
+	}()
+
+	entity := entities.Category{
+		DisplayName: command.DisplayName,
+		SortOrder:   command.SortOrder,
+		Active:      command.Active,
+	}
+
+	if err := entity.Validate(); err != nil {
+		return err
+	}
+
+	if err := u.categoryRepository.Add(ctx, &entity); err != nil {
+		return err
+	}
+
+	u.logger.InfoContext(ctx, "Category created", "category_id", entity.ID)
+	return nil
+}
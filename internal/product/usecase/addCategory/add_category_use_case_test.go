@@ -0,0 +1,76 @@
+package addcategory_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/mathefer/tc-fiap-product/internal/product/domain/domainerrors"
+	"github.com/mathefer/tc-fiap-product/internal/product/domain/entities"
+	addcategory "github.com/mathefer/tc-fiap-product/internal/product/usecase/addCategory"
+	"github.com/mathefer/tc-fiap-product/internal/product/usecase/commands"
+	mockRepositories "github.com/mathefer/tc-fiap-product/mocks/product/domain/repositories"
+	"github.com/mathefer/tc-fiap-product/pkg/logging"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type AddCategoryUseCaseTestSuite struct {
+	suite.Suite
+	mockRepository *mockRepositories.MockCategoryRepository
+	useCase        addcategory.AddCategoryUseCase
+}
+
+func (suite *AddCategoryUseCaseTestSuite) SetupTest() {
+	suite.mockRepository = mockRepositories.NewMockCategoryRepository(suite.T())
+	suite.useCase = addcategory.NewAddCategoryUseCaseImpl(suite.mockRepository, logging.NewNop())
+}
+
+func TestAddCategoryUseCaseTestSuite(t *testing.T) {
+	suite.Run(t, new(AddCategoryUseCaseTestSuite))
+}
+
+func (suite *AddCategoryUseCaseTestSuite) TestExecute_Success() {
+	// Arrange
+	command := commands.NewAddCategoryCommand("Combo", 5, true)
+
+	suite.mockRepository.EXPECT().
+		Add(mock.Anything, &entities.Category{DisplayName: "Combo", SortOrder: 5, Active: true}).
+		Return(nil).
+		Once()
+
+	// Act
+	err := suite.useCase.Execute(context.Background(), command)
+
+	// Assert
+	assert.NoError(suite.T(), err)
+	suite.mockRepository.AssertExpectations(suite.T())
+}
+
+func (suite *AddCategoryUseCaseTestSuite) TestExecute_Conflict() {
+	// Arrange
+	command := commands.NewAddCategoryCommand("Lanche", 1, true)
+
+	suite.mockRepository.EXPECT().
+		Add(mock.Anything, mock.Anything).
+		Return(domainerrors.NewConflictError("category already exists")).
+		Once()
+
+	// Act
+	err := suite.useCase.Execute(context.Background(), command)
+
+	// Assert
+	assert.ErrorIs(suite.T(), err, domainerrors.ErrConflict)
+}
+
+func (suite *AddCategoryUseCaseTestSuite) TestExecute_InvalidCategory() {
+	// Arrange
+	command := commands.NewAddCategoryCommand("", -1, true)
+
+	// Act
+	err := suite.useCase.Execute(context.Background(), command)
+
+	// Assert
+	assert.ErrorIs(suite.T(), err, domainerrors.ErrValidation)
+	suite.mockRepository.AssertNotCalled(suite.T(), "Add", mock.Anything)
+}
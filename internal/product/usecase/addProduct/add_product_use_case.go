@@ -1,7 +1,12 @@
 package addproduct
 
-import "github.com/mathefer/tc-fiap-product/internal/product/usecase/commands"
+import (
+	"context"
+
+	"github.com/mathefer/tc-fiap-product/internal/product/domain/entities"
+	"github.com/mathefer/tc-fiap-product/internal/product/usecase/commands"
+)
 
 type AddProductUseCase interface {
-	Execute(command *commands.AddProductCommand) error
+	Execute(ctx context.Context, command *commands.AddProductCommand) (*entities.Product, error)
 }
@@ -1,9 +1,14 @@
 package addproduct
 
 import (
+	"context"
+	"log/slog"
+
+	"github.com/mathefer/tc-fiap-product/internal/product/domain/domainerrors"
 	"github.com/mathefer/tc-fiap-product/internal/product/domain/entities"
 	"github.com/mathefer/tc-fiap-product/internal/product/domain/repositories"
 	"github.com/mathefer/tc-fiap-product/internal/product/usecase/commands"
+	"github.com/mathefer/tc-fiap-product/pkg/tracing"
 )
 
 var (
@@ -11,21 +16,49 @@ var (
 )
 
 type AddProductUseCaseImpl struct {
-	productRepository repositories.ProductRepository
+	productRepository  repositories.ProductRepository
+	categoryRepository repositories.CategoryRepository
+	logger             *slog.Logger
 }
 
-func NewAddProductUseCaseImpl(productRepository repositories.ProductRepository) *AddProductUseCaseImpl {
-	return &AddProductUseCaseImpl{productRepository: productRepository}
+func NewAddProductUseCaseImpl(productRepository repositories.ProductRepository, categoryRepository repositories.CategoryRepository, logger *slog.Logger) *AddProductUseCaseImpl {
+	return &AddProductUseCaseImpl{productRepository: productRepository, categoryRepository: categoryRepository, logger: logger}
 }
 
-func (u *AddProductUseCaseImpl) Execute(command *commands.AddProductCommand) error {
+// Execute validates and stores a new product and returns it with the ID and
+// creation time assigned by the repository.
+func (u *AddProductUseCaseImpl) Execute(ctx context.Context, command *commands.AddProductCommand) (_ *entities.Product, err error) {
+	ctx, span := tracing.Start(ctx, "AddProductUseCase.Execute")
+	defer func() {
+		tracing.RecordError(span, err)
+		span.End()
+	}()
+
+	price, err := entities.ParseMoney(command.Price, command.Currency)
+	if err != nil {
+		return nil, domainerrors.NewValidationError(domainerrors.FieldError{Field: "price", Message: err.Error()})
+	}
+
 	entity := entities.Product{
 		Name:        command.Name,
 		Category:    command.Category,
-		Price:       command.Price,
+		Price:       price,
 		Description: command.Description,
 		ImageLink:   command.ImageLink,
 	}
 
-	return u.productRepository.Add(&entity)
+	if err := entity.Validate(); err != nil {
+		return nil, err
+	}
+
+	if err := repositories.CheckCategory(ctx, u.categoryRepository, entity.Category); err != nil {
+		return nil, err
+	}
+
+	if err := u.productRepository.Add(ctx, &entity); err != nil {
+		return nil, err
+	}
+
+	u.logger.InfoContext(ctx, "Product created", "product_id", entity.ID, "category", entity.Category)
+	return &entity, nil
 }
@@ -1,26 +1,32 @@
 package addproduct_test
 
 import (
+	"context"
 	"errors"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/suite"
+	"github.com/mathefer/tc-fiap-product/internal/product/domain/domainerrors"
 	"github.com/mathefer/tc-fiap-product/internal/product/domain/entities"
 	addproduct "github.com/mathefer/tc-fiap-product/internal/product/usecase/addProduct"
 	"github.com/mathefer/tc-fiap-product/internal/product/usecase/commands"
 	mockRepositories "github.com/mathefer/tc-fiap-product/mocks/product/domain/repositories"
+	"github.com/mathefer/tc-fiap-product/pkg/logging"
 )
 
 type AddProductUseCaseTestSuite struct {
 	suite.Suite
-	mockRepository *mockRepositories.MockProductRepository
-	useCase        addproduct.AddProductUseCase
+	mockRepository         *mockRepositories.MockProductRepository
+	mockCategoryRepository *mockRepositories.MockCategoryRepository
+	useCase                addproduct.AddProductUseCase
 }
 
 func (suite *AddProductUseCaseTestSuite) SetupTest() {
 	suite.mockRepository = mockRepositories.NewMockProductRepository(suite.T())
-	suite.useCase = addproduct.NewAddProductUseCaseImpl(suite.mockRepository)
+	suite.mockCategoryRepository = mockRepositories.NewMockCategoryRepository(suite.T())
+	suite.useCase = addproduct.NewAddProductUseCaseImpl(suite.mockRepository, suite.mockCategoryRepository, logging.NewNop())
 }
 
 func TestAddProductUseCaseTestSuite(t *testing.T) {
@@ -29,79 +35,123 @@ func TestAddProductUseCaseTestSuite(t *testing.T) {
 
 func (suite *AddProductUseCaseTestSuite) TestExecute_Success() {
 	// Arrange
-	command := commands.NewAddProductCommand("Hamburguer", 1, 34.99, "Hamburguer com salada", "https://example.com/image.jpg")
+	command := commands.NewAddProductCommand("Hamburguer", 1, "34.99", "", "Hamburguer com salada", "https://example.com/image.jpg")
 
 	expectedProduct := &entities.Product{
 		Name:        command.Name,
 		Category:    command.Category,
-		Price:       command.Price,
+		Price:       entities.NewMoney(3499, "BRL"),
 		Description: command.Description,
 		ImageLink:   command.ImageLink,
 	}
 
+	suite.expectCategory(1, true)
+
 	suite.mockRepository.EXPECT().
-		Add(expectedProduct).
+		Add(mock.Anything, expectedProduct).
+		Run(func(_ context.Context, product *entities.Product) {
+			product.ID = 42
+		}).
 		Return(nil).
 		Once()
 
 	// Act
-	err := suite.useCase.Execute(command)
+	product, err := suite.useCase.Execute(context.Background(), command)
 
 	// Assert
 	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), uint(42), product.ID)
+	assert.Equal(suite.T(), "Hamburguer", product.Name)
 	suite.mockRepository.AssertExpectations(suite.T())
 }
 
 func (suite *AddProductUseCaseTestSuite) TestExecute_RepositoryError() {
 	// Arrange
-	command := commands.NewAddProductCommand("Pizza", 1, 45.99, "Pizza margherita", "https://example.com/pizza.jpg")
+	command := commands.NewAddProductCommand("Pizza", 1, "45.99", "", "Pizza margherita", "https://example.com/pizza.jpg")
 
 	expectedProduct := &entities.Product{
 		Name:        command.Name,
 		Category:    command.Category,
-		Price:       command.Price,
+		Price:       entities.NewMoney(4599, "BRL"),
 		Description: command.Description,
 		ImageLink:   command.ImageLink,
 	}
 
 	expectedError := errors.New("database error")
 
+	suite.expectCategory(1, true)
+
 	suite.mockRepository.EXPECT().
-		Add(expectedProduct).
+		Add(mock.Anything, expectedProduct).
 		Return(expectedError).
 		Once()
 
 	// Act
-	err := suite.useCase.Execute(command)
+	product, err := suite.useCase.Execute(context.Background(), command)
 
 	// Assert
 	assert.Error(suite.T(), err)
 	assert.Equal(suite.T(), expectedError, err)
+	assert.Nil(suite.T(), product)
 	suite.mockRepository.AssertExpectations(suite.T())
 }
 
 func (suite *AddProductUseCaseTestSuite) TestExecute_ValidatesProductData() {
 	// Arrange
-	command := commands.NewAddProductCommand("", 0, 0.0, "", "")
+	command := commands.NewAddProductCommand("", 0, "0.0", "", "", "")
 
-	expectedProduct := &entities.Product{
-		Name:        command.Name,
-		Category:    command.Category,
-		Price:       command.Price,
-		Description: command.Description,
-		ImageLink:   command.ImageLink,
-	}
+	// Act
+	_, err := suite.useCase.Execute(context.Background(), command)
 
-	suite.mockRepository.EXPECT().
-		Add(expectedProduct).
-		Return(nil).
+	// Assert
+	assert.Error(suite.T(), err)
+	assert.ErrorIs(suite.T(), err, domainerrors.ErrValidation)
+
+	var validationErr *domainerrors.ValidationError
+	assert.ErrorAs(suite.T(), err, &validationErr)
+	assert.Len(suite.T(), validationErr.Fields, 3)
+	suite.mockRepository.AssertNotCalled(suite.T(), "Add")
+}
+
+func (suite *AddProductUseCaseTestSuite) TestExecute_CategoryNotFound() {
+	// Arrange
+	command := commands.NewAddProductCommand("Hamburguer", 7, "34.99", "", "", "")
+
+	suite.mockCategoryRepository.EXPECT().
+		GetByID(mock.Anything, uint(7)).
+		Return(nil, domainerrors.NewNotFoundError("category", 7)).
 		Once()
 
 	// Act
-	err := suite.useCase.Execute(command)
+	_, err := suite.useCase.Execute(context.Background(), command)
 
 	// Assert
-	assert.NoError(suite.T(), err)
-	suite.mockRepository.AssertExpectations(suite.T())
+	assert.ErrorIs(suite.T(), err, domainerrors.ErrValidation)
+
+	var validationErr *domainerrors.ValidationError
+	assert.ErrorAs(suite.T(), err, &validationErr)
+	assert.Equal(suite.T(), []domainerrors.FieldError{{Field: "category", Message: "does not exist"}}, validationErr.Fields)
+	suite.mockRepository.AssertNotCalled(suite.T(), "Add")
 }
 
+func (suite *AddProductUseCaseTestSuite) TestExecute_CategoryInactive() {
+	// Arrange
+	command := commands.NewAddProductCommand("Hamburguer", 1, "34.99", "", "", "")
+
+	suite.expectCategory(1, false)
+
+	// Act
+	_, err := suite.useCase.Execute(context.Background(), command)
+
+	// Assert
+	assert.ErrorIs(suite.T(), err, domainerrors.ErrValidation)
+	assert.Contains(suite.T(), err.Error(), "is not active")
+	suite.mockRepository.AssertNotCalled(suite.T(), "Add")
+}
+
+func (suite *AddProductUseCaseTestSuite) expectCategory(id uint, active bool) {
+	suite.mockCategoryRepository.EXPECT().
+		GetByID(mock.Anything, id).
+		Return(&entities.Category{ID: id, DisplayName: "Lanche", Active: active}, nil).
+		Once()
+}
@@ -0,0 +1,15 @@
+package commands
+
+type AddCategoryCommand struct {
+	DisplayName string
+	SortOrder   int
+	Active      bool
+}
+
+func NewAddCategoryCommand(displayName string, sortOrder int, active bool) *AddCategoryCommand {
+	return &AddCategoryCommand{
+		DisplayName: displayName,
+		SortOrder:   sortOrder,
+		Active:      active,
+	}
+}
@@ -1,20 +1,23 @@
 package commands
 
+// AddProductCommand carries the price as a decimal string, such as "34.99",
+// in Currency; an empty currency means the default one.
 type AddProductCommand struct {
 	Name        string
 	Category    int
-	Price       float64
+	Price       string
+	Currency    string
 	Description string
 	ImageLink   string
 }
 
-func NewAddProductCommand(name string, category int, price float64, description string, imageLink string) *AddProductCommand {
+func NewAddProductCommand(name string, category int, price string, currency string, description string, imageLink string) *AddProductCommand {
 	return &AddProductCommand{
 		Name:        name,
 		Category:    category,
 		Price:       price,
+		Currency:    currency,
 		Description: description,
 		ImageLink:   imageLink,
 	}
 }
-
@@ -3,6 +3,7 @@ package commands_test
 import (
 	"testing"
 
+	"github.com/mathefer/tc-fiap-product/internal/product/domain/repositories"
 	"github.com/mathefer/tc-fiap-product/internal/product/usecase/commands"
 	"github.com/stretchr/testify/assert"
 )
@@ -11,31 +12,34 @@ func TestNewAddProductCommand(t *testing.T) {
 	// Arrange
 	name := "Hamburguer"
 	category := 1
-	price := 34.99
+	price := "34.99"
+	currency := "BRL"
 	description := "Hamburguer com salada"
 	imageLink := "https://example.com/image.jpg"
 
 	// Act
-	cmd := commands.NewAddProductCommand(name, category, price, description, imageLink)
+	cmd := commands.NewAddProductCommand(name, category, price, currency, description, imageLink)
 
 	// Assert
 	assert.NotNil(t, cmd)
 	assert.Equal(t, name, cmd.Name)
 	assert.Equal(t, category, cmd.Category)
 	assert.Equal(t, price, cmd.Price)
+	assert.Equal(t, currency, cmd.Currency)
 	assert.Equal(t, description, cmd.Description)
 	assert.Equal(t, imageLink, cmd.ImageLink)
 }
 
 func TestNewAddProductCommand_WithEmptyValues(t *testing.T) {
 	// Arrange & Act
-	cmd := commands.NewAddProductCommand("", 0, 0.0, "", "")
+	cmd := commands.NewAddProductCommand("", 0, "", "", "", "")
 
 	// Assert
 	assert.NotNil(t, cmd)
 	assert.Empty(t, cmd.Name)
 	assert.Zero(t, cmd.Category)
-	assert.Zero(t, cmd.Price)
+	assert.Empty(t, cmd.Price)
+	assert.Empty(t, cmd.Currency)
 	assert.Empty(t, cmd.Description)
 	assert.Empty(t, cmd.ImageLink)
 }
@@ -45,16 +49,17 @@ func TestNewGetProductCommand(t *testing.T) {
 	category := uint(1)
 
 	// Act
-	cmd := commands.NewGetProductCommand(category)
+	cmd := commands.NewGetProductCommand(category, true)
 
 	// Assert
 	assert.NotNil(t, cmd)
 	assert.Equal(t, category, cmd.Category)
+	assert.True(t, cmd.IncludeDeleted)
 }
 
 func TestNewGetProductCommand_WithZeroCategory(t *testing.T) {
 	// Arrange & Act
-	cmd := commands.NewGetProductCommand(0)
+	cmd := commands.NewGetProductCommand(0, false)
 
 	// Assert
 	assert.NotNil(t, cmd)
@@ -64,56 +69,147 @@ func TestNewGetProductCommand_WithZeroCategory(t *testing.T) {
 func TestNewUpdateProductCommand(t *testing.T) {
 	// Arrange
 	id := uint(1)
+	version := uint(3)
 	name := "Hamburguer Atualizado"
 	category := 1
-	price := 39.99
+	price := "39.99"
+	currency := "BRL"
 	description := "Hamburguer com bacon"
 	imageLink := "https://example.com/updated.jpg"
 
 	// Act
-	cmd := commands.NewUpdateProductCommand(id, name, category, price, description, imageLink)
+	cmd := commands.NewUpdateProductCommand(id, version, name, category, price, currency, description, imageLink)
 
 	// Assert
 	assert.NotNil(t, cmd)
 	assert.Equal(t, id, cmd.ID)
+	assert.Equal(t, version, cmd.Version)
 	assert.Equal(t, name, cmd.Name)
 	assert.Equal(t, category, cmd.Category)
 	assert.Equal(t, price, cmd.Price)
+	assert.Equal(t, currency, cmd.Currency)
 	assert.Equal(t, description, cmd.Description)
 	assert.Equal(t, imageLink, cmd.ImageLink)
 }
 
 func TestNewUpdateProductCommand_WithEmptyValues(t *testing.T) {
 	// Arrange & Act
-	cmd := commands.NewUpdateProductCommand(0, "", 0, 0.0, "", "")
+	cmd := commands.NewUpdateProductCommand(0, 0, "", 0, "", "", "", "")
 
 	// Assert
 	assert.NotNil(t, cmd)
 	assert.Zero(t, cmd.ID)
+	assert.Zero(t, cmd.Version)
 	assert.Empty(t, cmd.Name)
 	assert.Zero(t, cmd.Category)
-	assert.Zero(t, cmd.Price)
+	assert.Empty(t, cmd.Price)
+	assert.Empty(t, cmd.Currency)
 	assert.Empty(t, cmd.Description)
 	assert.Empty(t, cmd.ImageLink)
 }
 
+func TestNewPatchProductCommand(t *testing.T) {
+	// Arrange
+	id := uint(1)
+	price := "39.99"
+	imageLink := ""
+
+	// Act
+	cmd := commands.NewPatchProductCommand(id, 2, nil, nil, &price, nil, nil, &imageLink)
+
+	// Assert
+	assert.NotNil(t, cmd)
+	assert.Equal(t, id, cmd.ID)
+	assert.Equal(t, uint(2), cmd.Version)
+	assert.Nil(t, cmd.Name)
+	assert.Nil(t, cmd.Category)
+	assert.Equal(t, &price, cmd.Price)
+	assert.Nil(t, cmd.Currency)
+	assert.Nil(t, cmd.Description)
+	assert.Equal(t, &imageLink, cmd.ImageLink)
+	assert.Equal(t, []string{"price", "image_link"}, cmd.Fields())
+}
+
 func TestNewDeleteProductCommand(t *testing.T) {
 	// Arrange
 	id := uint(1)
 
 	// Act
-	cmd := commands.NewDeleteProductCommand(id)
+	cmd := commands.NewDeleteProductCommand(id, 4)
 
 	// Assert
 	assert.NotNil(t, cmd)
 	assert.Equal(t, id, cmd.ID)
+	assert.Equal(t, uint(4), cmd.Version)
 }
 
 func TestNewDeleteProductCommand_WithZeroID(t *testing.T) {
 	// Arrange & Act
-	cmd := commands.NewDeleteProductCommand(0)
+	cmd := commands.NewDeleteProductCommand(0, 0)
 
 	// Assert
 	assert.NotNil(t, cmd)
 	assert.Zero(t, cmd.ID)
 }
+
+func TestNewGetProductByIDCommand(t *testing.T) {
+	// Arrange
+	id := uint(1)
+
+	// Act
+	cmd := commands.NewGetProductByIDCommand(id, true)
+
+	// Assert
+	assert.NotNil(t, cmd)
+	assert.Equal(t, id, cmd.ID)
+	assert.True(t, cmd.IncludeDeleted)
+}
+
+func TestNewLookupProductsCommand(t *testing.T) {
+	// Arrange
+	ids := []uint{1, 2, 3}
+
+	// Act
+	cmd := commands.NewLookupProductsCommand(ids, true)
+
+	// Assert
+	assert.NotNil(t, cmd)
+	assert.Equal(t, ids, cmd.IDs)
+	assert.True(t, cmd.IncludeDeleted)
+}
+
+func TestNewListProductsCommand(t *testing.T) {
+	// Act
+	after := &repositories.ProductListPosition{ID: 7, Name: "Hamburguer"}
+	cmd := commands.NewListProductsCommand(2, "name", true, true, 50, 100, after)
+
+	// Assert
+	assert.NotNil(t, cmd)
+	assert.Equal(t, uint(2), cmd.Category)
+	assert.Equal(t, "name", cmd.SortBy)
+	assert.True(t, cmd.Descending)
+	assert.True(t, cmd.IncludeDeleted)
+	assert.Equal(t, 50, cmd.Limit)
+	assert.Equal(t, 100, cmd.Offset)
+	assert.Same(t, after, cmd.After)
+}
+
+func TestNewCategoryCommands(t *testing.T) {
+	// Act
+	list := commands.NewListCategoriesCommand(true)
+	get := commands.NewGetCategoryByIDCommand(2)
+	add := commands.NewAddCategoryCommand("Combo", 5, true)
+	update := commands.NewUpdateCategoryCommand(5, "Combo", 6, false)
+	remove := commands.NewDeleteCategoryCommand(5)
+
+	// Assert
+	assert.True(t, list.ActiveOnly)
+	assert.Equal(t, uint(2), get.ID)
+	assert.Equal(t, "Combo", add.DisplayName)
+	assert.Equal(t, 5, add.SortOrder)
+	assert.True(t, add.Active)
+	assert.Equal(t, uint(5), update.ID)
+	assert.Equal(t, 6, update.SortOrder)
+	assert.False(t, update.Active)
+	assert.Equal(t, uint(5), remove.ID)
+}
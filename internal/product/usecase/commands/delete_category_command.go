@@ -0,0 +1,11 @@
+package commands
+
+type DeleteCategoryCommand struct {
+	ID uint
+}
+
+func NewDeleteCategoryCommand(id uint) *DeleteCategoryCommand {
+	return &DeleteCategoryCommand{
+		ID: id,
+	}
+}
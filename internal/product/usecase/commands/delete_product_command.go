@@ -1,12 +1,15 @@
 package commands
 
+// DeleteProductCommand deletes the product at Version, or at any version
+// when Version is 0.
 type DeleteProductCommand struct {
-	ID uint
+	ID      uint
+	Version uint
 }
 
-func NewDeleteProductCommand(id uint) *DeleteProductCommand {
+func NewDeleteProductCommand(id uint, version uint) *DeleteProductCommand {
 	return &DeleteProductCommand{
-		ID: id,
+		ID:      id,
+		Version: version,
 	}
 }
-
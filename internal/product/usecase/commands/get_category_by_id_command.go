@@ -0,0 +1,11 @@
+package commands
+
+type GetCategoryByIDCommand struct {
+	ID uint
+}
+
+func NewGetCategoryByIDCommand(id uint) *GetCategoryByIDCommand {
+	return &GetCategoryByIDCommand{
+		ID: id,
+	}
+}
@@ -0,0 +1,13 @@
+package commands
+
+type GetProductByIDCommand struct {
+	ID             uint
+	IncludeDeleted bool
+}
+
+func NewGetProductByIDCommand(id uint, includeDeleted bool) *GetProductByIDCommand {
+	return &GetProductByIDCommand{
+		ID:             id,
+		IncludeDeleted: includeDeleted,
+	}
+}
@@ -1,12 +1,14 @@
 package commands
 
 type GetProductCommand struct {
-	Category uint
+	Category       uint
+	IncludeDeleted bool
 }
 
-func NewGetProductCommand(category uint) *GetProductCommand {
+func NewGetProductCommand(category uint, includeDeleted bool) *GetProductCommand {
 	return &GetProductCommand{
-		Category: category,
+		Category:       category,
+		IncludeDeleted: includeDeleted,
 	}
 }
 
@@ -0,0 +1,7 @@
+package commands
+
+type GetProductsLastModifiedCommand struct{}
+
+func NewGetProductsLastModifiedCommand() *GetProductsLastModifiedCommand {
+	return &GetProductsLastModifiedCommand{}
+}
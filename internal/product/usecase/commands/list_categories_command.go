@@ -0,0 +1,11 @@
+package commands
+
+type ListCategoriesCommand struct {
+	ActiveOnly bool
+}
+
+func NewListCategoriesCommand(activeOnly bool) *ListCategoriesCommand {
+	return &ListCategoriesCommand{
+		ActiveOnly: activeOnly,
+	}
+}
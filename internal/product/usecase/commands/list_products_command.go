@@ -0,0 +1,25 @@
+package commands
+
+import "github.com/mathefer/tc-fiap-product/internal/product/domain/repositories"
+
+type ListProductsCommand struct {
+	Category       uint
+	SortBy         string
+	Descending     bool
+	IncludeDeleted bool
+	Limit          int
+	Offset         int
+	After          *repositories.ProductListPosition
+}
+
+func NewListProductsCommand(category uint, sortBy string, descending bool, includeDeleted bool, limit int, offset int, after *repositories.ProductListPosition) *ListProductsCommand {
+	return &ListProductsCommand{
+		Category:       category,
+		SortBy:         sortBy,
+		Descending:     descending,
+		IncludeDeleted: includeDeleted,
+		Limit:          limit,
+		Offset:         offset,
+		After:          after,
+	}
+}
@@ -0,0 +1,13 @@
+package commands
+
+type LookupProductsCommand struct {
+	IDs            []uint
+	IncludeDeleted bool
+}
+
+func NewLookupProductsCommand(ids []uint, includeDeleted bool) *LookupProductsCommand {
+	return &LookupProductsCommand{
+		IDs:            ids,
+		IncludeDeleted: includeDeleted,
+	}
+}
@@ -0,0 +1,51 @@
+package commands
+
+// PatchProductCommand carries a partial update. A nil field is left untouched.
+// Version is the version the patch is based on, or 0 to patch any version.
+type PatchProductCommand struct {
+	ID          uint
+	Version     uint
+	Name        *string
+	Category    *int
+	Price       *string
+	Currency    *string
+	Description *string
+	ImageLink   *string
+}
+
+func NewPatchProductCommand(id uint, version uint, name *string, category *int, price *string, currency *string, description *string, imageLink *string) *PatchProductCommand {
+	return &PatchProductCommand{
+		ID:          id,
+		Version:     version,
+		Name:        name,
+		Category:    category,
+		Price:       price,
+		Currency:    currency,
+		Description: description,
+		ImageLink:   imageLink,
+	}
+}
+
+// Fields names the fields the patch changes, in a stable order.
+func (c *PatchProductCommand) Fields() []string {
+	fields := make([]string, 0, 6)
+	if c.Name != nil {
+		fields = append(fields, "name")
+	}
+	if c.Category != nil {
+		fields = append(fields, "category")
+	}
+	if c.Price != nil {
+		fields = append(fields, "price")
+	}
+	if c.Currency != nil {
+		fields = append(fields, "currency")
+	}
+	if c.Description != nil {
+		fields = append(fields, "description")
+	}
+	if c.ImageLink != nil {
+		fields = append(fields, "image_link")
+	}
+	return fields
+}
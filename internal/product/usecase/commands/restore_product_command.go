@@ -0,0 +1,11 @@
+package commands
+
+type RestoreProductCommand struct {
+	ID uint
+}
+
+func NewRestoreProductCommand(id uint) *RestoreProductCommand {
+	return &RestoreProductCommand{
+		ID: id,
+	}
+}
@@ -0,0 +1,17 @@
+package commands
+
+type UpdateCategoryCommand struct {
+	ID          uint
+	DisplayName string
+	SortOrder   int
+	Active      bool
+}
+
+func NewUpdateCategoryCommand(id uint, displayName string, sortOrder int, active bool) *UpdateCategoryCommand {
+	return &UpdateCategoryCommand{
+		ID:          id,
+		DisplayName: displayName,
+		SortOrder:   sortOrder,
+		Active:      active,
+	}
+}
@@ -1,22 +1,28 @@
 package commands
 
+// UpdateProductCommand carries the price as a decimal string, such as
+// "34.99", in Currency; an empty currency means the default one. Version is
+// the version the update is based on, or 0 to replace any version.
 type UpdateProductCommand struct {
 	ID          uint
+	Version     uint
 	Name        string
 	Category    int
-	Price       float64
+	Price       string
+	Currency    string
 	Description string
 	ImageLink   string
 }
 
-func NewUpdateProductCommand(id uint, name string, category int, price float64, description string, imageLink string) *UpdateProductCommand {
+func NewUpdateProductCommand(id uint, version uint, name string, category int, price string, currency string, description string, imageLink string) *UpdateProductCommand {
 	return &UpdateProductCommand{
 		ID:          id,
+		Version:     version,
 		Name:        name,
 		Category:    category,
 		Price:       price,
+		Currency:    currency,
 		Description: description,
 		ImageLink:   imageLink,
 	}
 }
-
@@ -0,0 +1,11 @@
+package deletecategory
+
+import (
+	"context"
+
+	"github.com/mathefer/tc-fiap-product/internal/product/usecase/commands"
+)
+
+type DeleteCategoryUseCase interface {
+	Execute(ctx context.Context, command *commands.DeleteCategoryCommand) error
+}
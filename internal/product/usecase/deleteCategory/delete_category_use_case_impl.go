@@ -0,0 +1,53 @@
+package deletecategory
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/mathefer/tc-fiap-product/internal/product/domain/domainerrors"
+	"github.com/mathefer/tc-fiap-product/internal/product/domain/repositories"
+	"github.com/mathefer/tc-fiap-product/internal/product/usecase/commands"
+	"github.com/mathefer/tc-fiap-product/pkg/tracing"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+var (
+	_ DeleteCategoryUseCase = (*DeleteCategoryUseCaseImpl)(nil)
+)
+
+type DeleteCategoryUseCaseImpl struct {
+	categoryRepository repositories.CategoryRepository
+	productRepository  repositories.ProductRepository
+	logger             *slog.Logger
+}
+
+func NewDeleteCategoryUseCaseImpl(categoryRepository repositories.CategoryRepository, productRepository repositories.ProductRepository, logger *slog.Logger) *DeleteCategoryUseCaseImpl {
+	return &DeleteCategoryUseCaseImpl{categoryRepository: categoryRepository, productRepository: productRepository, logger: logger}
+}
+
+// Execute refuses to delete a category that still has products; the foreign
+// key enforces the same rule, but checking first gives a clearer error.
+// Soft-deleted products count too, since they can still be restored.
+func (u *DeleteCategoryUseCaseImpl) Execute(ctx context.Context, command *commands.DeleteCategoryCommand) (err error) {
+	ctx, span := tracing.Start(ctx, "DeleteCategoryUseCase.Execute", trace.WithAttributes(attribute.Int("category.id", int(command.ID))))
+	defer func() {
+		tracing.RecordError(span, err)
+		span.End()
+	}()
+
+	_, total, err := u.productRepository.List(ctx, repositories.ProductListOptions{Category: command.ID, IncludeDeleted: true, Limit: 1})
+	if err != nil {
+		return err
+	}
+	if total > 0 {
+		return domainerrors.NewConflictError("category is still used by products")
+	}
+
+	if err := u.categoryRepository.Delete(ctx, command.ID); err != nil {
+		return err
+	}
+
+	u.logger.InfoContext(ctx, "Category deleted")
+	return nil
+}
@@ -0,0 +1,87 @@
+package deletecategory_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/mathefer/tc-fiap-product/internal/product/domain/domainerrors"
+	"github.com/mathefer/tc-fiap-product/internal/product/domain/entities"
+	"github.com/mathefer/tc-fiap-product/internal/product/domain/repositories"
+	"github.com/mathefer/tc-fiap-product/internal/product/usecase/commands"
+	deletecategory "github.com/mathefer/tc-fiap-product/internal/product/usecase/deleteCategory"
+	mockRepositories "github.com/mathefer/tc-fiap-product/mocks/product/domain/repositories"
+	"github.com/mathefer/tc-fiap-product/pkg/logging"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type DeleteCategoryUseCaseTestSuite struct {
+	suite.Suite
+	mockRepository        *mockRepositories.MockCategoryRepository
+	mockProductRepository *mockRepositories.MockProductRepository
+	useCase               deletecategory.DeleteCategoryUseCase
+}
+
+func (suite *DeleteCategoryUseCaseTestSuite) SetupTest() {
+	suite.mockRepository = mockRepositories.NewMockCategoryRepository(suite.T())
+	suite.mockProductRepository = mockRepositories.NewMockProductRepository(suite.T())
+	suite.useCase = deletecategory.NewDeleteCategoryUseCaseImpl(suite.mockRepository, suite.mockProductRepository, logging.NewNop())
+}
+
+func TestDeleteCategoryUseCaseTestSuite(t *testing.T) {
+	suite.Run(t, new(DeleteCategoryUseCaseTestSuite))
+}
+
+func (suite *DeleteCategoryUseCaseTestSuite) TestExecute_Success() {
+	// Arrange
+	suite.mockProductRepository.EXPECT().
+		List(mock.Anything, repositories.ProductListOptions{Category: 5, IncludeDeleted: true, Limit: 1}).
+		Return([]*entities.Product{}, int64(0), nil).
+		Once()
+
+	suite.mockRepository.EXPECT().
+		Delete(mock.Anything, uint(5)).
+		Return(nil).
+		Once()
+
+	// Act
+	err := suite.useCase.Execute(context.Background(), commands.NewDeleteCategoryCommand(5))
+
+	// Assert
+	assert.NoError(suite.T(), err)
+	suite.mockRepository.AssertExpectations(suite.T())
+}
+
+func (suite *DeleteCategoryUseCaseTestSuite) TestExecute_StillInUse() {
+	// Arrange
+	suite.mockProductRepository.EXPECT().
+		List(mock.Anything, repositories.ProductListOptions{Category: 1, IncludeDeleted: true, Limit: 1}).
+		Return([]*entities.Product{{ID: 1, Category: 1}}, int64(3), nil).
+		Once()
+
+	// Act
+	err := suite.useCase.Execute(context.Background(), commands.NewDeleteCategoryCommand(1))
+
+	// Assert
+	assert.ErrorIs(suite.T(), err, domainerrors.ErrConflict)
+	suite.mockRepository.AssertNotCalled(suite.T(), "Delete", mock.Anything)
+}
+
+func (suite *DeleteCategoryUseCaseTestSuite) TestExecute_ProductRepositoryError() {
+	// Arrange
+	expectedError := errors.New("database error")
+
+	suite.mockProductRepository.EXPECT().
+		List(mock.Anything, mock.Anything).
+		Return(nil, int64(0), expectedError).
+		Once()
+
+	// Act
+	err := suite.useCase.Execute(context.Background(), commands.NewDeleteCategoryCommand(1))
+
+	// Assert
+	assert.Equal(suite.T(), expectedError, err)
+	suite.mockRepository.AssertNotCalled(suite.T(), "Delete", mock.Anything)
+}
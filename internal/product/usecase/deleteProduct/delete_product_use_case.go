@@ -1,8 +1,12 @@
 package deleteproduct
 
-import "github.com/mathefer/tc-fiap-product/internal/product/usecase/commands"
+import (
+	"context"
+
+	"github.com/mathefer/tc-fiap-product/internal/product/usecase/commands"
+)
 
 type DeleteProductUseCase interface {
-	Execute(command *commands.DeleteProductCommand) error
+	Execute(ctx context.Context, command *commands.DeleteProductCommand) error
 }
 
@@ -1,8 +1,14 @@
 package deleteproduct
 
 import (
+	"context"
+	"log/slog"
+
 	"github.com/mathefer/tc-fiap-product/internal/product/domain/repositories"
 	"github.com/mathefer/tc-fiap-product/internal/product/usecase/commands"
+	"github.com/mathefer/tc-fiap-product/pkg/tracing"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 )
 
 var (
@@ -11,13 +17,24 @@ var (
 
 type DeleteProductUseCaseImpl struct {
 	productRepository repositories.ProductRepository
+	logger            *slog.Logger
 }
 
-func NewDeleteProductUseCaseImpl(productRepository repositories.ProductRepository) *DeleteProductUseCaseImpl {
-	return &DeleteProductUseCaseImpl{productRepository: productRepository}
+func NewDeleteProductUseCaseImpl(productRepository repositories.ProductRepository, logger *slog.Logger) *DeleteProductUseCaseImpl {
+	return &DeleteProductUseCaseImpl{productRepository: productRepository, logger: logger}
 }
 
-func (u *DeleteProductUseCaseImpl) Execute(command *commands.DeleteProductCommand) error {
-	return u.productRepository.Delete(command.ID)
-}
+func (u *DeleteProductUseCaseImpl) Execute(ctx context.Context, command *commands.DeleteProductCommand) (err error) {
+	ctx, span := tracing.Start(ctx, "DeleteProductUseCase.Execute", trace.WithAttributes(attribute.Int("product.id", int(command.ID))))
+	defer func() {
+		tracing.RecordError(span, err)
+		span.End()
+	}()
 
+	if err := u.productRepository.Delete(ctx, command.ID, command.Version); err != nil {
+		return err
+	}
+
+	u.logger.InfoContext(ctx, "Product deleted")
+	return nil
+}
@@ -1,14 +1,17 @@
 package deleteproduct_test
 
 import (
+	"context"
 	"errors"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/suite"
 	"github.com/mathefer/tc-fiap-product/internal/product/usecase/commands"
 	deleteproduct "github.com/mathefer/tc-fiap-product/internal/product/usecase/deleteProduct"
 	mockRepositories "github.com/mathefer/tc-fiap-product/mocks/product/domain/repositories"
+	"github.com/mathefer/tc-fiap-product/pkg/logging"
 )
 
 type DeleteProductUseCaseTestSuite struct {
@@ -19,7 +22,7 @@ type DeleteProductUseCaseTestSuite struct {
 
 func (suite *DeleteProductUseCaseTestSuite) SetupTest() {
 	suite.mockRepository = mockRepositories.NewMockProductRepository(suite.T())
-	suite.useCase = deleteproduct.NewDeleteProductUseCaseImpl(suite.mockRepository)
+	suite.useCase = deleteproduct.NewDeleteProductUseCaseImpl(suite.mockRepository, logging.NewNop())
 }
 
 func TestDeleteProductUseCaseTestSuite(t *testing.T) {
@@ -29,15 +32,15 @@ func TestDeleteProductUseCaseTestSuite(t *testing.T) {
 func (suite *DeleteProductUseCaseTestSuite) TestExecute_Success() {
 	// Arrange
 	id := uint(1)
-	command := commands.NewDeleteProductCommand(id)
+	command := commands.NewDeleteProductCommand(id, 2)
 
 	suite.mockRepository.EXPECT().
-		Delete(id).
+		Delete(mock.Anything, id, uint(2)).
 		Return(nil).
 		Once()
 
 	// Act
-	err := suite.useCase.Execute(command)
+	err := suite.useCase.Execute(context.Background(), command)
 
 	// Assert
 	assert.NoError(suite.T(), err)
@@ -47,17 +50,17 @@ func (suite *DeleteProductUseCaseTestSuite) TestExecute_Success() {
 func (suite *DeleteProductUseCaseTestSuite) TestExecute_RepositoryError() {
 	// Arrange
 	id := uint(1)
-	command := commands.NewDeleteProductCommand(id)
+	command := commands.NewDeleteProductCommand(id, 0)
 
 	expectedError := errors.New("database error")
 
 	suite.mockRepository.EXPECT().
-		Delete(id).
+		Delete(mock.Anything, id, uint(0)).
 		Return(expectedError).
 		Once()
 
 	// Act
-	err := suite.useCase.Execute(command)
+	err := suite.useCase.Execute(context.Background(), command)
 
 	// Assert
 	assert.Error(suite.T(), err)
@@ -68,17 +71,17 @@ func (suite *DeleteProductUseCaseTestSuite) TestExecute_RepositoryError() {
 func (suite *DeleteProductUseCaseTestSuite) TestExecute_ProductNotFound() {
 	// Arrange
 	id := uint(999)
-	command := commands.NewDeleteProductCommand(id)
+	command := commands.NewDeleteProductCommand(id, 0)
 
 	expectedError := errors.New("product not found")
 
 	suite.mockRepository.EXPECT().
-		Delete(id).
+		Delete(mock.Anything, id, uint(0)).
 		Return(expectedError).
 		Once()
 
 	// Act
-	err := suite.useCase.Execute(command)
+	err := suite.useCase.Execute(context.Background(), command)
 
 	// Assert
 	assert.Error(suite.T(), err)
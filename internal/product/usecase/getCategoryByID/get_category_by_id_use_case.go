@@ -0,0 +1,12 @@
+package getcategorybyid
+
+import (
+	"context"
+
+	"github.com/mathefer/tc-fiap-product/internal/product/domain/entities"
+	"github.com/mathefer/tc-fiap-product/internal/product/usecase/commands"
+)
+
+type GetCategoryByIDUseCase interface {
+	Execute(ctx context.Context, command *commands.GetCategoryByIDCommand) (*entities.Category, error)
+}
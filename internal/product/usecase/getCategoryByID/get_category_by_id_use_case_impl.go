@@ -0,0 +1,42 @@
+package getcategorybyid
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/mathefer/tc-fiap-product/internal/product/domain/entities"
+	"github.com/mathefer/tc-fiap-product/internal/product/domain/repositories"
+	"github.com/mathefer/tc-fiap-product/internal/product/usecase/commands"
+	"github.com/mathefer/tc-fiap-product/pkg/tracing"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+var (
+	_ GetCategoryByIDUseCase = (*GetCategoryByIDUseCaseImpl)(nil)
+)
+
+type GetCategoryByIDUseCaseImpl struct {
+	categoryRepository repositories.CategoryRepository
+	logger             *slog.Logger
+}
+
+func NewGetCategoryByIDUseCaseImpl(categoryRepository repositories.CategoryRepository, logger *slog.Logger) *GetCategoryByIDUseCaseImpl {
+	return &GetCategoryByIDUseCaseImpl{categoryRepository: categoryRepository, logger: logger}
+}
+
+func (u *GetCategoryByIDUseCaseImpl) Execute(ctx context.Context, command *commands.GetCategoryByIDCommand) (_ *entities.Category, err error) {
+	ctx, span := tracing.Start(ctx, "GetCategoryByIDUseCase.Execute", trace.WithAttributes(attribute.Int("category.id", int(command.ID))))
+	defer func() {
+		tracing.RecordError(span, err)
+		span.End()
+	}()
+
+	entity, err := u.categoryRepository.GetByID(ctx, command.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	u.logger.DebugContext(ctx, "Category loaded")
+	return entity, nil
+}
@@ -0,0 +1,64 @@
+package getcategorybyid_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/mathefer/tc-fiap-product/internal/product/domain/domainerrors"
+	"github.com/mathefer/tc-fiap-product/internal/product/domain/entities"
+	"github.com/mathefer/tc-fiap-product/internal/product/usecase/commands"
+	getcategorybyid "github.com/mathefer/tc-fiap-product/internal/product/usecase/getCategoryByID"
+	mockRepositories "github.com/mathefer/tc-fiap-product/mocks/product/domain/repositories"
+	"github.com/mathefer/tc-fiap-product/pkg/logging"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type GetCategoryByIDUseCaseTestSuite struct {
+	suite.Suite
+	mockRepository *mockRepositories.MockCategoryRepository
+	useCase        getcategorybyid.GetCategoryByIDUseCase
+}
+
+func (suite *GetCategoryByIDUseCaseTestSuite) SetupTest() {
+	suite.mockRepository = mockRepositories.NewMockCategoryRepository(suite.T())
+	suite.useCase = getcategorybyid.NewGetCategoryByIDUseCaseImpl(suite.mockRepository, logging.NewNop())
+}
+
+func TestGetCategoryByIDUseCaseTestSuite(t *testing.T) {
+	suite.Run(t, new(GetCategoryByIDUseCaseTestSuite))
+}
+
+func (suite *GetCategoryByIDUseCaseTestSuite) TestExecute_Success() {
+	// Arrange
+	expectedCategory := &entities.Category{ID: 2, DisplayName: "Acompanhamento", SortOrder: 2, Active: true}
+
+	suite.mockRepository.EXPECT().
+		GetByID(mock.Anything, uint(2)).
+		Return(expectedCategory, nil).
+		Once()
+
+	// Act
+	category, err := suite.useCase.Execute(context.Background(), commands.NewGetCategoryByIDCommand(2))
+
+	// Assert
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), expectedCategory, category)
+	suite.mockRepository.AssertExpectations(suite.T())
+}
+
+func (suite *GetCategoryByIDUseCaseTestSuite) TestExecute_NotFound() {
+	// Arrange
+	suite.mockRepository.EXPECT().
+		GetByID(mock.Anything, uint(99)).
+		Return(nil, domainerrors.NewNotFoundError("category", 99)).
+		Once()
+
+	// Act
+	category, err := suite.useCase.Execute(context.Background(), commands.NewGetCategoryByIDCommand(99))
+
+	// Assert
+	assert.Nil(suite.T(), category)
+	assert.ErrorIs(suite.T(), err, domainerrors.ErrNotFound)
+}
@@ -1,10 +1,12 @@
 package getproduct
 
 import (
+	"context"
+
 	"github.com/mathefer/tc-fiap-product/internal/product/domain/entities"
 	"github.com/mathefer/tc-fiap-product/internal/product/usecase/commands"
 )
 
 type GetProductUseCase interface {
-	Execute(command *commands.GetProductCommand) ([]*entities.Product, error)
+	Execute(ctx context.Context, command *commands.GetProductCommand) ([]*entities.Product, error)
 }
@@ -1,9 +1,15 @@
 package getproduct
 
 import (
+	"context"
+	"log/slog"
+
 	"github.com/mathefer/tc-fiap-product/internal/product/domain/entities"
 	"github.com/mathefer/tc-fiap-product/internal/product/domain/repositories"
 	"github.com/mathefer/tc-fiap-product/internal/product/usecase/commands"
+	"github.com/mathefer/tc-fiap-product/pkg/tracing"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 )
 
 var (
@@ -12,18 +18,26 @@ var (
 
 type GetProductUseCaseImpl struct {
 	productRepository repositories.ProductRepository
+	logger            *slog.Logger
 }
 
-func NewGetProductUseCaseImpl(productRepository repositories.ProductRepository) *GetProductUseCaseImpl {
-	return &GetProductUseCaseImpl{productRepository: productRepository}
+func NewGetProductUseCaseImpl(productRepository repositories.ProductRepository, logger *slog.Logger) *GetProductUseCaseImpl {
+	return &GetProductUseCaseImpl{productRepository: productRepository, logger: logger}
 }
 
-func (u *GetProductUseCaseImpl) Execute(command *commands.GetProductCommand) ([]*entities.Product, error) {
-	entities, err := u.productRepository.Get(command.Category)
+func (u *GetProductUseCaseImpl) Execute(ctx context.Context, command *commands.GetProductCommand) (_ []*entities.Product, err error) {
+	ctx, span := tracing.Start(ctx, "GetProductUseCase.Execute", trace.WithAttributes(attribute.Int("product.category", int(command.Category))))
+	defer func() {
+		tracing.RecordError(span, err)
+		span.End()
+	}()
+
+	entities, err := u.productRepository.Get(ctx, command.Category, command.IncludeDeleted)
 	if err != nil {
 		return nil, err
 	}
 
+	u.logger.DebugContext(ctx, "Products loaded", "category", command.Category, "count", len(entities))
+
 	return entities, nil
 }
-
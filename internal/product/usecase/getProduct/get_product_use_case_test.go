@@ -1,16 +1,19 @@
 package getproduct_test
 
 import (
+	"context"
 	"errors"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/suite"
 	"github.com/mathefer/tc-fiap-product/internal/product/domain/entities"
 	"github.com/mathefer/tc-fiap-product/internal/product/usecase/commands"
 	getproduct "github.com/mathefer/tc-fiap-product/internal/product/usecase/getProduct"
 	mockRepositories "github.com/mathefer/tc-fiap-product/mocks/product/domain/repositories"
+	"github.com/mathefer/tc-fiap-product/pkg/logging"
 )
 
 type GetProductUseCaseTestSuite struct {
@@ -21,7 +24,7 @@ type GetProductUseCaseTestSuite struct {
 
 func (suite *GetProductUseCaseTestSuite) SetupTest() {
 	suite.mockRepository = mockRepositories.NewMockProductRepository(suite.T())
-	suite.useCase = getproduct.NewGetProductUseCaseImpl(suite.mockRepository)
+	suite.useCase = getproduct.NewGetProductUseCaseImpl(suite.mockRepository, logging.NewNop())
 }
 
 func TestGetProductUseCaseTestSuite(t *testing.T) {
@@ -31,7 +34,7 @@ func TestGetProductUseCaseTestSuite(t *testing.T) {
 func (suite *GetProductUseCaseTestSuite) TestExecute_Success() {
 	// Arrange
 	category := uint(1)
-	command := commands.NewGetProductCommand(category)
+	command := commands.NewGetProductCommand(category, false)
 
 	expectedProducts := []*entities.Product{
 		{
@@ -39,7 +42,7 @@ func (suite *GetProductUseCaseTestSuite) TestExecute_Success() {
 			CreatedAt:   time.Now(),
 			Name:        "Hamburguer",
 			Category:    1,
-			Price:       34.99,
+			Price:       entities.NewMoney(3499, "BRL"),
 			Description: "Hamburguer com salada",
 			ImageLink:   "https://example.com/image.jpg",
 		},
@@ -48,19 +51,19 @@ func (suite *GetProductUseCaseTestSuite) TestExecute_Success() {
 			CreatedAt:   time.Now(),
 			Name:        "Cheeseburguer",
 			Category:    1,
-			Price:       39.99,
+			Price:       entities.NewMoney(3999, "BRL"),
 			Description: "Hamburguer com queijo",
 			ImageLink:   "https://example.com/cheese.jpg",
 		},
 	}
 
 	suite.mockRepository.EXPECT().
-		Get(category).
+		Get(mock.Anything, category, false).
 		Return(expectedProducts, nil).
 		Once()
 
 	// Act
-	products, err := suite.useCase.Execute(command)
+	products, err := suite.useCase.Execute(context.Background(), command)
 
 	// Assert
 	assert.NoError(suite.T(), err)
@@ -74,17 +77,17 @@ func (suite *GetProductUseCaseTestSuite) TestExecute_Success() {
 func (suite *GetProductUseCaseTestSuite) TestExecute_EmptyResult() {
 	// Arrange
 	category := uint(2)
-	command := commands.NewGetProductCommand(category)
+	command := commands.NewGetProductCommand(category, false)
 
 	expectedProducts := []*entities.Product{}
 
 	suite.mockRepository.EXPECT().
-		Get(category).
+		Get(mock.Anything, category, false).
 		Return(expectedProducts, nil).
 		Once()
 
 	// Act
-	products, err := suite.useCase.Execute(command)
+	products, err := suite.useCase.Execute(context.Background(), command)
 
 	// Assert
 	assert.NoError(suite.T(), err)
@@ -96,17 +99,17 @@ func (suite *GetProductUseCaseTestSuite) TestExecute_EmptyResult() {
 func (suite *GetProductUseCaseTestSuite) TestExecute_RepositoryError() {
 	// Arrange
 	category := uint(1)
-	command := commands.NewGetProductCommand(category)
+	command := commands.NewGetProductCommand(category, false)
 
 	expectedError := errors.New("database connection error")
 
 	suite.mockRepository.EXPECT().
-		Get(category).
+		Get(mock.Anything, category, false).
 		Return(nil, expectedError).
 		Once()
 
 	// Act
-	products, err := suite.useCase.Execute(command)
+	products, err := suite.useCase.Execute(context.Background(), command)
 
 	// Assert
 	assert.Error(suite.T(), err)
@@ -0,0 +1,12 @@
+package getproductbyid
+
+import (
+	"context"
+
+	"github.com/mathefer/tc-fiap-product/internal/product/domain/entities"
+	"github.com/mathefer/tc-fiap-product/internal/product/usecase/commands"
+)
+
+type GetProductByIDUseCase interface {
+	Execute(ctx context.Context, command *commands.GetProductByIDCommand) (*entities.Product, error)
+}
@@ -0,0 +1,43 @@
+package getproductbyid
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/mathefer/tc-fiap-product/internal/product/domain/entities"
+	"github.com/mathefer/tc-fiap-product/internal/product/domain/repositories"
+	"github.com/mathefer/tc-fiap-product/internal/product/usecase/commands"
+	"github.com/mathefer/tc-fiap-product/pkg/tracing"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+var (
+	_ GetProductByIDUseCase = (*GetProductByIDUseCaseImpl)(nil)
+)
+
+type GetProductByIDUseCaseImpl struct {
+	productRepository repositories.ProductRepository
+	logger            *slog.Logger
+}
+
+func NewGetProductByIDUseCaseImpl(productRepository repositories.ProductRepository, logger *slog.Logger) *GetProductByIDUseCaseImpl {
+	return &GetProductByIDUseCaseImpl{productRepository: productRepository, logger: logger}
+}
+
+func (u *GetProductByIDUseCaseImpl) Execute(ctx context.Context, command *commands.GetProductByIDCommand) (_ *entities.Product, err error) {
+	ctx, span := tracing.Start(ctx, "GetProductByIDUseCase.Execute", trace.WithAttributes(attribute.Int("product.id", int(command.ID))))
+	defer func() {
+		tracing.RecordError(span, err)
+		span.End()
+	}()
+
+	entity, err := u.productRepository.GetByID(ctx, command.ID, command.IncludeDeleted)
+	if err != nil {
+		return nil, err
+	}
+
+	u.logger.DebugContext(ctx, "Product loaded")
+
+	return entity, nil
+}
@@ -0,0 +1,103 @@
+package getproductbyid_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"github.com/mathefer/tc-fiap-product/internal/product/domain/entities"
+	"github.com/mathefer/tc-fiap-product/internal/product/usecase/commands"
+	getproductbyid "github.com/mathefer/tc-fiap-product/internal/product/usecase/getProductByID"
+	mockRepositories "github.com/mathefer/tc-fiap-product/mocks/product/domain/repositories"
+	"github.com/mathefer/tc-fiap-product/pkg/logging"
+)
+
+type GetProductByIDUseCaseTestSuite struct {
+	suite.Suite
+	mockRepository *mockRepositories.MockProductRepository
+	useCase        getproductbyid.GetProductByIDUseCase
+}
+
+func (suite *GetProductByIDUseCaseTestSuite) SetupTest() {
+	suite.mockRepository = mockRepositories.NewMockProductRepository(suite.T())
+	suite.useCase = getproductbyid.NewGetProductByIDUseCaseImpl(suite.mockRepository, logging.NewNop())
+}
+
+func TestGetProductByIDUseCaseTestSuite(t *testing.T) {
+	suite.Run(t, new(GetProductByIDUseCaseTestSuite))
+}
+
+func (suite *GetProductByIDUseCaseTestSuite) TestExecute_Success() {
+	// Arrange
+	id := uint(1)
+	command := commands.NewGetProductByIDCommand(id, false)
+
+	expectedProduct := &entities.Product{
+		ID:          1,
+		CreatedAt:   time.Now(),
+		Name:        "Hamburguer",
+		Category:    1,
+		Price:       entities.NewMoney(3499, "BRL"),
+		Description: "Hamburguer com salada",
+		ImageLink:   "https://example.com/image.jpg",
+	}
+
+	suite.mockRepository.EXPECT().
+		GetByID(mock.Anything, id, false).
+		Return(expectedProduct, nil).
+		Once()
+
+	// Act
+	product, err := suite.useCase.Execute(context.Background(), command)
+
+	// Assert
+	assert.NoError(suite.T(), err)
+	assert.NotNil(suite.T(), product)
+	assert.Equal(suite.T(), expectedProduct.ID, product.ID)
+	assert.Equal(suite.T(), expectedProduct.Name, product.Name)
+	suite.mockRepository.AssertExpectations(suite.T())
+}
+
+func (suite *GetProductByIDUseCaseTestSuite) TestExecute_IncludeDeleted() {
+	// Arrange
+	id := uint(4)
+	command := commands.NewGetProductByIDCommand(id, true)
+
+	suite.mockRepository.EXPECT().
+		GetByID(mock.Anything, id, true).
+		Return(&entities.Product{ID: 4, Name: "Milk-shake"}, nil).
+		Once()
+
+	// Act
+	product, err := suite.useCase.Execute(context.Background(), command)
+
+	// Assert
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), id, product.ID)
+}
+
+func (suite *GetProductByIDUseCaseTestSuite) TestExecute_RepositoryError() {
+	// Arrange
+	id := uint(999)
+	command := commands.NewGetProductByIDCommand(id, false)
+
+	expectedError := errors.New("record not found")
+
+	suite.mockRepository.EXPECT().
+		GetByID(mock.Anything, id, false).
+		Return(nil, expectedError).
+		Once()
+
+	// Act
+	product, err := suite.useCase.Execute(context.Background(), command)
+
+	// Assert
+	assert.Error(suite.T(), err)
+	assert.Nil(suite.T(), product)
+	assert.Equal(suite.T(), expectedError, err)
+	suite.mockRepository.AssertExpectations(suite.T())
+}
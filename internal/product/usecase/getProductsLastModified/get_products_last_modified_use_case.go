@@ -0,0 +1,12 @@
+package getproductslastmodified
+
+import (
+	"context"
+	"time"
+
+	"github.com/mathefer/tc-fiap-product/internal/product/usecase/commands"
+)
+
+type GetProductsLastModifiedUseCase interface {
+	Execute(ctx context.Context, command *commands.GetProductsLastModifiedCommand) (time.Time, error)
+}
@@ -0,0 +1,44 @@
+package getproductslastmodified
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/mathefer/tc-fiap-product/internal/product/domain/repositories"
+	"github.com/mathefer/tc-fiap-product/internal/product/usecase/commands"
+	"github.com/mathefer/tc-fiap-product/pkg/tracing"
+)
+
+var (
+	_ GetProductsLastModifiedUseCase = (*GetProductsLastModifiedUseCaseImpl)(nil)
+)
+
+// GetProductsLastModifiedUseCaseImpl reports when the catalog last changed.
+// Listings use it as their Last-Modified because removing a product from a
+// listing does not advance the UpdatedAt of any product still in it.
+type GetProductsLastModifiedUseCaseImpl struct {
+	productRepository repositories.ProductRepository
+	logger            *slog.Logger
+}
+
+func NewGetProductsLastModifiedUseCaseImpl(productRepository repositories.ProductRepository, logger *slog.Logger) *GetProductsLastModifiedUseCaseImpl {
+	return &GetProductsLastModifiedUseCaseImpl{productRepository: productRepository, logger: logger}
+}
+
+func (u *GetProductsLastModifiedUseCaseImpl) Execute(ctx context.Context, command *commands.GetProductsLastModifiedCommand) (_ time.Time, err error) {
+	ctx, span := tracing.Start(ctx, "GetProductsLastModifiedUseCase.Execute")
+	defer func() {
+		tracing.RecordError(span, err)
+		span.End()
+	}()
+
+	lastModified, err := u.productRepository.LastModified(ctx)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	u.logger.DebugContext(ctx, "Products last modified loaded", "last_modified", lastModified)
+
+	return lastModified, nil
+}
@@ -0,0 +1,66 @@
+package getproductslastmodified_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/mathefer/tc-fiap-product/internal/product/usecase/commands"
+	getproductslastmodified "github.com/mathefer/tc-fiap-product/internal/product/usecase/getProductsLastModified"
+	mockRepositories "github.com/mathefer/tc-fiap-product/mocks/product/domain/repositories"
+	"github.com/mathefer/tc-fiap-product/pkg/logging"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type GetProductsLastModifiedUseCaseTestSuite struct {
+	suite.Suite
+	mockRepository *mockRepositories.MockProductRepository
+	useCase        getproductslastmodified.GetProductsLastModifiedUseCase
+}
+
+func (suite *GetProductsLastModifiedUseCaseTestSuite) SetupTest() {
+	suite.mockRepository = mockRepositories.NewMockProductRepository(suite.T())
+	suite.useCase = getproductslastmodified.NewGetProductsLastModifiedUseCaseImpl(suite.mockRepository, logging.NewNop())
+}
+
+func TestGetProductsLastModifiedUseCaseTestSuite(t *testing.T) {
+	suite.Run(t, new(GetProductsLastModifiedUseCaseTestSuite))
+}
+
+func (suite *GetProductsLastModifiedUseCaseTestSuite) TestExecute_Success() {
+	// Arrange
+	expected := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	suite.mockRepository.EXPECT().
+		LastModified(mock.Anything).
+		Return(expected, nil).
+		Once()
+
+	// Act
+	lastModified, err := suite.useCase.Execute(context.Background(), commands.NewGetProductsLastModifiedCommand())
+
+	// Assert
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), expected, lastModified)
+	suite.mockRepository.AssertExpectations(suite.T())
+}
+
+func (suite *GetProductsLastModifiedUseCaseTestSuite) TestExecute_RepositoryError() {
+	// Arrange
+	expectedError := errors.New("database error")
+
+	suite.mockRepository.EXPECT().
+		LastModified(mock.Anything).
+		Return(time.Time{}, expectedError).
+		Once()
+
+	// Act
+	lastModified, err := suite.useCase.Execute(context.Background(), commands.NewGetProductsLastModifiedCommand())
+
+	// Assert
+	assert.Equal(suite.T(), expectedError, err)
+	assert.True(suite.T(), lastModified.IsZero())
+}
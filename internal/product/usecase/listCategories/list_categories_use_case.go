@@ -0,0 +1,12 @@
+package listcategories
+
+import (
+	"context"
+
+	"github.com/mathefer/tc-fiap-product/internal/product/domain/entities"
+	"github.com/mathefer/tc-fiap-product/internal/product/usecase/commands"
+)
+
+type ListCategoriesUseCase interface {
+	Execute(ctx context.Context, command *commands.ListCategoriesCommand) ([]*entities.Category, error)
+}
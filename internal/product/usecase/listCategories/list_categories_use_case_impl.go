@@ -0,0 +1,40 @@
+package listcategories
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/mathefer/tc-fiap-product/internal/product/domain/entities"
+	"github.com/mathefer/tc-fiap-product/internal/product/domain/repositories"
+	"github.com/mathefer/tc-fiap-product/internal/product/usecase/commands"
+	"github.com/mathefer/tc-fiap-product/pkg/tracing"
+)
+
+var (
+	_ ListCategoriesUseCase = (*ListCategoriesUseCaseImpl)(nil)
+)
+
+type ListCategoriesUseCaseImpl struct {
+	categoryRepository repositories.CategoryRepository
+	logger             *slog.Logger
+}
+
+func NewListCategoriesUseCaseImpl(categoryRepository repositories.CategoryRepository, logger *slog.Logger) *ListCategoriesUseCaseImpl {
+	return &ListCategoriesUseCaseImpl{categoryRepository: categoryRepository, logger: logger}
+}
+
+func (u *ListCategoriesUseCaseImpl) Execute(ctx context.Context, command *commands.ListCategoriesCommand) (_ []*entities.Category, err error) {
+	ctx, span := tracing.Start(ctx, "ListCategoriesUseCase.Execute")
+	defer func() {
+		tracing.RecordError(span, err)
+		span.End()
+	}()
+
+	categories, err := u.categoryRepository.List(ctx, command.ActiveOnly)
+	if err != nil {
+		return []*entities.Category{}, err
+	}
+
+	u.logger.DebugContext(ctx, "Categories listed", "count", len(categories))
+	return categories, nil
+}
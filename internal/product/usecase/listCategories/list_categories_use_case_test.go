@@ -0,0 +1,67 @@
+package listcategories_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/mathefer/tc-fiap-product/internal/product/domain/entities"
+	"github.com/mathefer/tc-fiap-product/internal/product/usecase/commands"
+	listcategories "github.com/mathefer/tc-fiap-product/internal/product/usecase/listCategories"
+	mockRepositories "github.com/mathefer/tc-fiap-product/mocks/product/domain/repositories"
+	"github.com/mathefer/tc-fiap-product/pkg/logging"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type ListCategoriesUseCaseTestSuite struct {
+	suite.Suite
+	mockRepository *mockRepositories.MockCategoryRepository
+	useCase        listcategories.ListCategoriesUseCase
+}
+
+func (suite *ListCategoriesUseCaseTestSuite) SetupTest() {
+	suite.mockRepository = mockRepositories.NewMockCategoryRepository(suite.T())
+	suite.useCase = listcategories.NewListCategoriesUseCaseImpl(suite.mockRepository, logging.NewNop())
+}
+
+func TestListCategoriesUseCaseTestSuite(t *testing.T) {
+	suite.Run(t, new(ListCategoriesUseCaseTestSuite))
+}
+
+func (suite *ListCategoriesUseCaseTestSuite) TestExecute_Success() {
+	// Arrange
+	expectedCategories := entities.DefaultCategories()
+
+	suite.mockRepository.EXPECT().
+		List(mock.Anything, true).
+		Return(expectedCategories, nil).
+		Once()
+
+	// Act
+	categories, err := suite.useCase.Execute(context.Background(), commands.NewListCategoriesCommand(true))
+
+	// Assert
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), expectedCategories, categories)
+	suite.mockRepository.AssertExpectations(suite.T())
+}
+
+func (suite *ListCategoriesUseCaseTestSuite) TestExecute_RepositoryError() {
+	// Arrange
+	expectedError := errors.New("database error")
+
+	suite.mockRepository.EXPECT().
+		List(mock.Anything, false).
+		Return(nil, expectedError).
+		Once()
+
+	// Act
+	categories, err := suite.useCase.Execute(context.Background(), commands.NewListCategoriesCommand(false))
+
+	// Assert
+	assert.Equal(suite.T(), expectedError, err)
+	assert.NotNil(suite.T(), categories)
+	assert.Len(suite.T(), categories, 0)
+}
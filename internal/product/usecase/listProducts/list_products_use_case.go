@@ -0,0 +1,14 @@
+package listproducts
+
+import (
+	"context"
+
+	"github.com/mathefer/tc-fiap-product/internal/product/domain/entities"
+	"github.com/mathefer/tc-fiap-product/internal/product/usecase/commands"
+)
+
+// ListProductsUseCase returns one page of products together with the total
+// number of products matching the filter.
+type ListProductsUseCase interface {
+	Execute(ctx context.Context, command *commands.ListProductsCommand) ([]*entities.Product, int64, error)
+}
@@ -0,0 +1,49 @@
+package listproducts
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/mathefer/tc-fiap-product/internal/product/domain/entities"
+	"github.com/mathefer/tc-fiap-product/internal/product/domain/repositories"
+	"github.com/mathefer/tc-fiap-product/internal/product/usecase/commands"
+	"github.com/mathefer/tc-fiap-product/pkg/tracing"
+)
+
+var (
+	_ ListProductsUseCase = (*ListProductsUseCaseImpl)(nil)
+)
+
+type ListProductsUseCaseImpl struct {
+	productRepository repositories.ProductRepository
+	logger            *slog.Logger
+}
+
+func NewListProductsUseCaseImpl(productRepository repositories.ProductRepository, logger *slog.Logger) *ListProductsUseCaseImpl {
+	return &ListProductsUseCaseImpl{productRepository: productRepository, logger: logger}
+}
+
+func (u *ListProductsUseCaseImpl) Execute(ctx context.Context, command *commands.ListProductsCommand) (_ []*entities.Product, _ int64, err error) {
+	ctx, span := tracing.Start(ctx, "ListProductsUseCase.Execute")
+	defer func() {
+		tracing.RecordError(span, err)
+		span.End()
+	}()
+
+	products, total, err := u.productRepository.List(ctx, repositories.ProductListOptions{
+		Category:       command.Category,
+		SortBy:         command.SortBy,
+		Descending:     command.Descending,
+		IncludeDeleted: command.IncludeDeleted,
+		Limit:          command.Limit,
+		Offset:         command.Offset,
+		After:          command.After,
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+
+	u.logger.DebugContext(ctx, "Products listed", "count", len(products), "total", total)
+
+	return products, total, nil
+}
@@ -0,0 +1,83 @@
+package listproducts_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"github.com/mathefer/tc-fiap-product/internal/product/domain/entities"
+	"github.com/mathefer/tc-fiap-product/internal/product/domain/repositories"
+	"github.com/mathefer/tc-fiap-product/internal/product/usecase/commands"
+	listproducts "github.com/mathefer/tc-fiap-product/internal/product/usecase/listProducts"
+	mockRepositories "github.com/mathefer/tc-fiap-product/mocks/product/domain/repositories"
+	"github.com/mathefer/tc-fiap-product/pkg/logging"
+)
+
+type ListProductsUseCaseTestSuite struct {
+	suite.Suite
+	mockRepository *mockRepositories.MockProductRepository
+	useCase        listproducts.ListProductsUseCase
+}
+
+func (suite *ListProductsUseCaseTestSuite) SetupTest() {
+	suite.mockRepository = mockRepositories.NewMockProductRepository(suite.T())
+	suite.useCase = listproducts.NewListProductsUseCaseImpl(suite.mockRepository, logging.NewNop())
+}
+
+func TestListProductsUseCaseTestSuite(t *testing.T) {
+	suite.Run(t, new(ListProductsUseCaseTestSuite))
+}
+
+func (suite *ListProductsUseCaseTestSuite) TestExecute_Success() {
+	// Arrange
+	after := &repositories.ProductListPosition{ID: 5, PriceAmount: 5999}
+	command := commands.NewListProductsCommand(1, "price", true, false, 10, 20, after)
+
+	expectedProducts := []*entities.Product{
+		{ID: 3, Name: "Hamburguer Duplo", Category: 1, Price: entities.NewMoney(4999, "BRL")},
+		{ID: 1, Name: "Hamburguer", Category: 1, Price: entities.NewMoney(3499, "BRL")},
+	}
+
+	suite.mockRepository.EXPECT().
+		List(mock.Anything, repositories.ProductListOptions{
+			Category:   1,
+			SortBy:     "price",
+			Descending: true,
+			Limit:      10,
+			Offset:     20,
+			After:      after,
+		}).
+		Return(expectedProducts, int64(22), nil).
+		Once()
+
+	// Act
+	products, total, err := suite.useCase.Execute(context.Background(), command)
+
+	// Assert
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), expectedProducts, products)
+	assert.Equal(suite.T(), int64(22), total)
+}
+
+func (suite *ListProductsUseCaseTestSuite) TestExecute_RepositoryError() {
+	// Arrange
+	command := commands.NewListProductsCommand(0, "", false, false, 20, 0, nil)
+	expectedError := errors.New("database connection error")
+
+	suite.mockRepository.EXPECT().
+		List(mock.Anything, repositories.ProductListOptions{Limit: 20}).
+		Return(nil, int64(0), expectedError).
+		Once()
+
+	// Act
+	products, total, err := suite.useCase.Execute(context.Background(), command)
+
+	// Assert
+	assert.Error(suite.T(), err)
+	assert.Equal(suite.T(), expectedError, err)
+	assert.Nil(suite.T(), products)
+	assert.Zero(suite.T(), total)
+}
@@ -0,0 +1,15 @@
+package lookupproducts
+
+import (
+	"context"
+
+	"github.com/mathefer/tc-fiap-product/internal/product/domain/entities"
+	"github.com/mathefer/tc-fiap-product/internal/product/usecase/commands"
+)
+
+// LookupProductsUseCase resolves a batch of product IDs in a single query.
+// It returns the products found, in the order they were requested, and the
+// IDs that do not exist.
+type LookupProductsUseCase interface {
+	Execute(ctx context.Context, command *commands.LookupProductsCommand) ([]*entities.Product, []uint, error)
+}
@@ -0,0 +1,73 @@
+package lookupproducts
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/mathefer/tc-fiap-product/internal/product/domain/entities"
+	"github.com/mathefer/tc-fiap-product/internal/product/domain/repositories"
+	"github.com/mathefer/tc-fiap-product/internal/product/usecase/commands"
+	"github.com/mathefer/tc-fiap-product/pkg/tracing"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+var (
+	_ LookupProductsUseCase = (*LookupProductsUseCaseImpl)(nil)
+)
+
+type LookupProductsUseCaseImpl struct {
+	productRepository repositories.ProductRepository
+	logger            *slog.Logger
+}
+
+func NewLookupProductsUseCaseImpl(productRepository repositories.ProductRepository, logger *slog.Logger) *LookupProductsUseCaseImpl {
+	return &LookupProductsUseCaseImpl{productRepository: productRepository, logger: logger}
+}
+
+func (u *LookupProductsUseCaseImpl) Execute(ctx context.Context, command *commands.LookupProductsCommand) (_ []*entities.Product, _ []uint, err error) {
+	ctx, span := tracing.Start(ctx, "LookupProductsUseCase.Execute", trace.WithAttributes(attribute.Int("product.lookup.count", len(command.IDs))))
+	defer func() {
+		tracing.RecordError(span, err)
+		span.End()
+	}()
+
+	ids := uniqueIDs(command.IDs)
+
+	found, err := u.productRepository.GetByIDs(ctx, ids, command.IncludeDeleted)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	byID := make(map[uint]*entities.Product, len(found))
+	for _, product := range found {
+		byID[product.ID] = product
+	}
+
+	products := make([]*entities.Product, 0, len(found))
+	missingIDs := make([]uint, 0)
+	for _, id := range ids {
+		if product, ok := byID[id]; ok {
+			products = append(products, product)
+		} else {
+			missingIDs = append(missingIDs, id)
+		}
+	}
+
+	u.logger.DebugContext(ctx, "Products looked up", "found", len(products), "missing", len(missingIDs))
+	return products, missingIDs, nil
+}
+
+// uniqueIDs drops repeated IDs while keeping the order of first appearance.
+func uniqueIDs(ids []uint) []uint {
+	seen := make(map[uint]struct{}, len(ids))
+	unique := make([]uint, 0, len(ids))
+	for _, id := range ids {
+		if _, ok := seen[id]; ok {
+			continue
+		}
+		seen[id] = struct{}{}
+		unique = append(unique, id)
+	}
+	return unique
+}
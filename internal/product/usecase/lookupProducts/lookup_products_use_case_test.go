@@ -0,0 +1,131 @@
+package lookupproducts_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"github.com/mathefer/tc-fiap-product/internal/product/domain/entities"
+	"github.com/mathefer/tc-fiap-product/internal/product/usecase/commands"
+	lookupproducts "github.com/mathefer/tc-fiap-product/internal/product/usecase/lookupProducts"
+	mockRepositories "github.com/mathefer/tc-fiap-product/mocks/product/domain/repositories"
+	"github.com/mathefer/tc-fiap-product/pkg/logging"
+)
+
+type LookupProductsUseCaseTestSuite struct {
+	suite.Suite
+	mockRepository *mockRepositories.MockProductRepository
+	useCase        lookupproducts.LookupProductsUseCase
+}
+
+func (suite *LookupProductsUseCaseTestSuite) SetupTest() {
+	suite.mockRepository = mockRepositories.NewMockProductRepository(suite.T())
+	suite.useCase = lookupproducts.NewLookupProductsUseCaseImpl(suite.mockRepository, logging.NewNop())
+}
+
+func TestLookupProductsUseCaseTestSuite(t *testing.T) {
+	suite.Run(t, new(LookupProductsUseCaseTestSuite))
+}
+
+func (suite *LookupProductsUseCaseTestSuite) TestExecute_AllFound() {
+	// Arrange
+	command := commands.NewLookupProductsCommand([]uint{2, 1}, false)
+
+	suite.mockRepository.EXPECT().
+		GetByIDs(mock.Anything, []uint{2, 1}, false).
+		Return([]*entities.Product{
+			{ID: 1, Name: "Hamburguer", Price: entities.NewMoney(3499, "BRL")},
+			{ID: 2, Name: "Batata frita", Price: entities.NewMoney(1250, "BRL")},
+		}, nil).
+		Once()
+
+	// Act
+	products, missingIDs, err := suite.useCase.Execute(context.Background(), command)
+
+	// Assert
+	assert.NoError(suite.T(), err)
+	assert.Len(suite.T(), products, 2)
+	assert.Equal(suite.T(), uint(2), products[0].ID)
+	assert.Equal(suite.T(), uint(1), products[1].ID)
+	assert.Empty(suite.T(), missingIDs)
+}
+
+func (suite *LookupProductsUseCaseTestSuite) TestExecute_ReportsMissingIDs() {
+	// Arrange
+	command := commands.NewLookupProductsCommand([]uint{1, 999, 3}, false)
+
+	suite.mockRepository.EXPECT().
+		GetByIDs(mock.Anything, []uint{1, 999, 3}, false).
+		Return([]*entities.Product{
+			{ID: 1, Name: "Hamburguer"},
+			{ID: 3, Name: "Refrigerante"},
+		}, nil).
+		Once()
+
+	// Act
+	products, missingIDs, err := suite.useCase.Execute(context.Background(), command)
+
+	// Assert
+	assert.NoError(suite.T(), err)
+	assert.Len(suite.T(), products, 2)
+	assert.Equal(suite.T(), []uint{999}, missingIDs)
+}
+
+func (suite *LookupProductsUseCaseTestSuite) TestExecute_DeduplicatesIDs() {
+	// Arrange
+	command := commands.NewLookupProductsCommand([]uint{1, 1, 2, 1}, false)
+
+	suite.mockRepository.EXPECT().
+		GetByIDs(mock.Anything, []uint{1, 2}, false).
+		Return([]*entities.Product{{ID: 1}, {ID: 2}}, nil).
+		Once()
+
+	// Act
+	products, missingIDs, err := suite.useCase.Execute(context.Background(), command)
+
+	// Assert
+	assert.NoError(suite.T(), err)
+	assert.Len(suite.T(), products, 2)
+	assert.Empty(suite.T(), missingIDs)
+}
+
+func (suite *LookupProductsUseCaseTestSuite) TestExecute_IncludeDeleted() {
+	// Arrange
+	command := commands.NewLookupProductsCommand([]uint{4}, true)
+
+	suite.mockRepository.EXPECT().
+		GetByIDs(mock.Anything, []uint{4}, true).
+		Return([]*entities.Product{{ID: 4, Name: "Milk-shake"}}, nil).
+		Once()
+
+	// Act
+	products, missingIDs, err := suite.useCase.Execute(context.Background(), command)
+
+	// Assert
+	assert.NoError(suite.T(), err)
+	assert.Len(suite.T(), products, 1)
+	assert.Empty(suite.T(), missingIDs)
+}
+
+func (suite *LookupProductsUseCaseTestSuite) TestExecute_RepositoryError() {
+	// Arrange
+	command := commands.NewLookupProductsCommand([]uint{1}, false)
+	expectedError := errors.New("database connection error")
+
+	suite.mockRepository.EXPECT().
+		GetByIDs(mock.Anything, []uint{1}, false).
+		Return(nil, expectedError).
+		Once()
+
+	// Act
+	products, missingIDs, err := suite.useCase.Execute(context.Background(), command)
+
+	// Assert
+	assert.Error(suite.T(), err)
+	assert.Equal(suite.T(), expectedError, err)
+	assert.Nil(suite.T(), products)
+	assert.Nil(suite.T(), missingIDs)
+}
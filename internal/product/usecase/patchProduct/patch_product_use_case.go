@@ -0,0 +1,12 @@
+package patchproduct
+
+import (
+	"context"
+
+	"github.com/mathefer/tc-fiap-product/internal/product/domain/entities"
+	"github.com/mathefer/tc-fiap-product/internal/product/usecase/commands"
+)
+
+type PatchProductUseCase interface {
+	Execute(ctx context.Context, command *commands.PatchProductCommand) (*entities.Product, error)
+}
@@ -0,0 +1,94 @@
+package patchproduct
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/mathefer/tc-fiap-product/internal/product/domain/domainerrors"
+	"github.com/mathefer/tc-fiap-product/internal/product/domain/entities"
+	"github.com/mathefer/tc-fiap-product/internal/product/domain/repositories"
+	"github.com/mathefer/tc-fiap-product/internal/product/usecase/commands"
+	"github.com/mathefer/tc-fiap-product/pkg/tracing"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+var (
+	_ PatchProductUseCase = (*PatchProductUseCaseImpl)(nil)
+)
+
+type PatchProductUseCaseImpl struct {
+	productRepository  repositories.ProductRepository
+	categoryRepository repositories.CategoryRepository
+	logger             *slog.Logger
+}
+
+func NewPatchProductUseCaseImpl(productRepository repositories.ProductRepository, categoryRepository repositories.CategoryRepository, logger *slog.Logger) *PatchProductUseCaseImpl {
+	return &PatchProductUseCaseImpl{productRepository: productRepository, categoryRepository: categoryRepository, logger: logger}
+}
+
+// Execute applies the patch to the product as currently stored. When
+// command.Version is set the product must still be at that version; either
+// way the write fails if the product changes between reading and updating it.
+// The product is returned as stored.
+func (u *PatchProductUseCaseImpl) Execute(ctx context.Context, command *commands.PatchProductCommand) (_ *entities.Product, err error) {
+	ctx, span := tracing.Start(ctx, "PatchProductUseCase.Execute", trace.WithAttributes(attribute.Int("product.id", int(command.ID))))
+	defer func() {
+		tracing.RecordError(span, err)
+		span.End()
+	}()
+
+	entity, err := u.productRepository.GetByIDForUpdate(ctx, command.ID)
+	if err != nil {
+		return nil, err
+	}
+	if command.Version != 0 && command.Version != entity.Version {
+		return nil, domainerrors.NewPreconditionFailedError("product", command.ID)
+	}
+
+	if command.Name != nil {
+		entity.Name = *command.Name
+	}
+	if command.Category != nil {
+		entity.Category = *command.Category
+	}
+	if command.Price != nil || command.Currency != nil {
+		amount, currency := entity.Price.String(), entity.Price.Currency
+		if command.Price != nil {
+			amount = *command.Price
+		}
+		if command.Currency != nil {
+			currency = *command.Currency
+		}
+
+		price, err := entities.ParseMoney(amount, currency)
+		if err != nil {
+			return nil, domainerrors.NewValidationError(domainerrors.FieldError{Field: "price", Message: err.Error()})
+		}
+		entity.Price = price
+	}
+	if command.Description != nil {
+		entity.Description = *command.Description
+	}
+	if command.ImageLink != nil {
+		entity.ImageLink = *command.ImageLink
+	}
+
+	if err := entity.Validate(); err != nil {
+		return nil, err
+	}
+
+	if command.Category != nil {
+		if err := repositories.CheckCategory(ctx, u.categoryRepository, entity.Category); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := u.productRepository.Update(ctx, entity); err != nil {
+		return nil, err
+	}
+
+	u.logger.InfoContext(ctx, "Product patched", "fields", command.Fields())
+
+	return u.productRepository.GetByIDForUpdate(ctx, entity.ID)
+}
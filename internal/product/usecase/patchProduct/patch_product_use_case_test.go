@@ -0,0 +1,284 @@
+package patchproduct_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/mathefer/tc-fiap-product/internal/product/domain/domainerrors"
+	"github.com/mathefer/tc-fiap-product/internal/product/domain/entities"
+	"github.com/mathefer/tc-fiap-product/internal/product/usecase/commands"
+	patchproduct "github.com/mathefer/tc-fiap-product/internal/product/usecase/patchProduct"
+	mockRepositories "github.com/mathefer/tc-fiap-product/mocks/product/domain/repositories"
+	"github.com/mathefer/tc-fiap-product/pkg/logging"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type PatchProductUseCaseTestSuite struct {
+	suite.Suite
+	mockRepository         *mockRepositories.MockProductRepository
+	mockCategoryRepository *mockRepositories.MockCategoryRepository
+	useCase                patchproduct.PatchProductUseCase
+}
+
+func (suite *PatchProductUseCaseTestSuite) SetupTest() {
+	suite.mockRepository = mockRepositories.NewMockProductRepository(suite.T())
+	suite.mockCategoryRepository = mockRepositories.NewMockCategoryRepository(suite.T())
+	suite.useCase = patchproduct.NewPatchProductUseCaseImpl(suite.mockRepository, suite.mockCategoryRepository, logging.NewNop())
+}
+
+func TestPatchProductUseCaseTestSuite(t *testing.T) {
+	suite.Run(t, new(PatchProductUseCaseTestSuite))
+}
+
+func existingProduct() *entities.Product {
+	return &entities.Product{
+		ID:          1,
+		Name:        "Hamburguer",
+		Category:    1,
+		Price:       entities.NewMoney(2999, "BRL"),
+		Description: "Hamburguer artesanal",
+		ImageLink:   "https://example.com/hamburguer.jpg",
+		Version:     3,
+	}
+}
+
+func (suite *PatchProductUseCaseTestSuite) TestExecute_Success() {
+	// Arrange
+	price := "34.99"
+	description := ""
+	command := commands.NewPatchProductCommand(1, 0, nil, nil, &price, nil, &description, nil)
+
+	expectedProduct := existingProduct()
+	expectedProduct.Price = entities.NewMoney(3499, "BRL")
+	expectedProduct.Description = description
+
+	suite.mockRepository.EXPECT().
+		GetByIDForUpdate(mock.Anything, uint(1)).
+		Return(existingProduct(), nil).
+		Once()
+
+	suite.mockRepository.EXPECT().
+		Update(mock.Anything, expectedProduct).
+		Return(nil).
+		Once()
+
+	storedProduct := *expectedProduct
+	storedProduct.Version = 4
+	suite.mockRepository.EXPECT().
+		GetByIDForUpdate(mock.Anything, uint(1)).
+		Return(&storedProduct, nil).
+		Once()
+
+	// Act
+	product, err := suite.useCase.Execute(context.Background(), command)
+
+	// Assert - the product is returned as stored, at its new version
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), &storedProduct, product)
+	suite.mockRepository.AssertExpectations(suite.T())
+	suite.mockCategoryRepository.AssertNotCalled(suite.T(), "GetByID", mock.Anything)
+}
+
+func (suite *PatchProductUseCaseTestSuite) TestExecute_MatchingVersion() {
+	// Arrange
+	name := "X-Burguer"
+	command := commands.NewPatchProductCommand(1, 3, &name, nil, nil, nil, nil, nil)
+
+	suite.mockRepository.EXPECT().
+		GetByIDForUpdate(mock.Anything, uint(1)).
+		Return(existingProduct(), nil).
+		Once()
+
+	// The update is conditional on the version that was read
+	suite.mockRepository.EXPECT().
+		Update(mock.Anything, mock.MatchedBy(func(product *entities.Product) bool {
+			return product.Name == name && product.Version == 3
+		})).
+		Return(nil).
+		Once()
+
+	suite.mockRepository.EXPECT().
+		GetByIDForUpdate(mock.Anything, uint(1)).
+		Return(existingProduct(), nil).
+		Once()
+
+	// Act
+	_, err := suite.useCase.Execute(context.Background(), command)
+
+	// Assert
+	assert.NoError(suite.T(), err)
+}
+
+func (suite *PatchProductUseCaseTestSuite) TestExecute_VersionChanged() {
+	// Arrange
+	name := "X-Burguer"
+	command := commands.NewPatchProductCommand(1, 2, &name, nil, nil, nil, nil, nil)
+
+	suite.mockRepository.EXPECT().
+		GetByIDForUpdate(mock.Anything, uint(1)).
+		Return(existingProduct(), nil).
+		Once()
+
+	// Act
+	_, err := suite.useCase.Execute(context.Background(), command)
+
+	// Assert - the stored product is at version 3, so nothing is written
+	assert.ErrorIs(suite.T(), err, domainerrors.ErrPreconditionFailed)
+	suite.mockRepository.AssertNotCalled(suite.T(), "Update", mock.Anything, mock.Anything)
+}
+
+func (suite *PatchProductUseCaseTestSuite) TestExecute_ChangesCurrencyOnly() {
+	// Arrange
+	currency := "usd"
+	command := commands.NewPatchProductCommand(1, 0, nil, nil, nil, &currency, nil, nil)
+
+	expectedProduct := existingProduct()
+	expectedProduct.Price = entities.NewMoney(2999, "USD")
+
+	suite.mockRepository.EXPECT().
+		GetByIDForUpdate(mock.Anything, uint(1)).
+		Return(existingProduct(), nil).
+		Once()
+
+	suite.mockRepository.EXPECT().
+		Update(mock.Anything, expectedProduct).
+		Return(nil).
+		Once()
+
+	suite.mockRepository.EXPECT().
+		GetByIDForUpdate(mock.Anything, uint(1)).
+		Return(existingProduct(), nil).
+		Once()
+
+	// Act
+	_, err := suite.useCase.Execute(context.Background(), command)
+
+	// Assert
+	assert.NoError(suite.T(), err)
+	suite.mockRepository.AssertExpectations(suite.T())
+}
+
+func (suite *PatchProductUseCaseTestSuite) TestExecute_InvalidPrice() {
+	// Arrange
+	price := "34.999"
+	command := commands.NewPatchProductCommand(1, 0, nil, nil, &price, nil, nil, nil)
+
+	suite.mockRepository.EXPECT().
+		GetByIDForUpdate(mock.Anything, uint(1)).
+		Return(existingProduct(), nil).
+		Once()
+
+	// Act
+	_, err := suite.useCase.Execute(context.Background(), command)
+
+	// Assert
+	assert.ErrorIs(suite.T(), err, domainerrors.ErrValidation)
+	assert.Contains(suite.T(), err.Error(), "price: must be a decimal number")
+	suite.mockRepository.AssertNotCalled(suite.T(), "Update", mock.Anything)
+}
+
+func (suite *PatchProductUseCaseTestSuite) TestExecute_ChangesCategory() {
+	// Arrange
+	category := 3
+	command := commands.NewPatchProductCommand(1, 0, nil, &category, nil, nil, nil, nil)
+
+	expectedProduct := existingProduct()
+	expectedProduct.Category = category
+
+	suite.mockRepository.EXPECT().
+		GetByIDForUpdate(mock.Anything, uint(1)).
+		Return(existingProduct(), nil).
+		Once()
+
+	suite.mockCategoryRepository.EXPECT().
+		GetByID(mock.Anything, uint(3)).
+		Return(&entities.Category{ID: 3, DisplayName: "Bebida", Active: true}, nil).
+		Once()
+
+	suite.mockRepository.EXPECT().
+		Update(mock.Anything, expectedProduct).
+		Return(nil).
+		Once()
+
+	suite.mockRepository.EXPECT().
+		GetByIDForUpdate(mock.Anything, uint(1)).
+		Return(existingProduct(), nil).
+		Once()
+
+	// Act
+	_, err := suite.useCase.Execute(context.Background(), command)
+
+	// Assert
+	assert.NoError(suite.T(), err)
+	suite.mockRepository.AssertExpectations(suite.T())
+}
+
+func (suite *PatchProductUseCaseTestSuite) TestExecute_CategoryNotFound() {
+	// Arrange
+	category := 7
+	command := commands.NewPatchProductCommand(1, 0, nil, &category, nil, nil, nil, nil)
+
+	suite.mockRepository.EXPECT().
+		GetByIDForUpdate(mock.Anything, uint(1)).
+		Return(existingProduct(), nil).
+		Once()
+
+	suite.mockCategoryRepository.EXPECT().
+		GetByID(mock.Anything, uint(7)).
+		Return(nil, domainerrors.NewNotFoundError("category", 7)).
+		Once()
+
+	// Act
+	_, err := suite.useCase.Execute(context.Background(), command)
+
+	// Assert
+	assert.ErrorIs(suite.T(), err, domainerrors.ErrValidation)
+	assert.Contains(suite.T(), err.Error(), "category: does not exist")
+	suite.mockRepository.AssertNotCalled(suite.T(), "Update", mock.Anything)
+}
+
+func (suite *PatchProductUseCaseTestSuite) TestExecute_ProductNotFound() {
+	// Arrange
+	name := "Pizza"
+	command := commands.NewPatchProductCommand(999, 0, &name, nil, nil, nil, nil, nil)
+
+	expectedError := domainerrors.NewNotFoundError("product", 999)
+
+	suite.mockRepository.EXPECT().
+		GetByIDForUpdate(mock.Anything, uint(999)).
+		Return(nil, expectedError).
+		Once()
+
+	// Act
+	_, err := suite.useCase.Execute(context.Background(), command)
+
+	// Assert
+	assert.Error(suite.T(), err)
+	assert.ErrorIs(suite.T(), err, domainerrors.ErrNotFound)
+	suite.mockRepository.AssertNotCalled(suite.T(), "Update", mock.Anything)
+}
+
+func (suite *PatchProductUseCaseTestSuite) TestExecute_InvalidProduct() {
+	// Arrange
+	name := "   "
+	category := 0
+	command := commands.NewPatchProductCommand(1, 0, &name, &category, nil, nil, nil, nil)
+
+	suite.mockRepository.EXPECT().
+		GetByIDForUpdate(mock.Anything, uint(1)).
+		Return(existingProduct(), nil).
+		Once()
+
+	// Act
+	_, err := suite.useCase.Execute(context.Background(), command)
+
+	// Assert
+	assert.ErrorIs(suite.T(), err, domainerrors.ErrValidation)
+
+	var validationErr *domainerrors.ValidationError
+	assert.ErrorAs(suite.T(), err, &validationErr)
+	assert.Len(suite.T(), validationErr.Fields, 2)
+	suite.mockRepository.AssertNotCalled(suite.T(), "Update", mock.Anything)
+}
@@ -0,0 +1,12 @@
+package restoreproduct
+
+import (
+	"context"
+
+	"github.com/mathefer/tc-fiap-product/internal/product/domain/entities"
+	"github.com/mathefer/tc-fiap-product/internal/product/usecase/commands"
+)
+
+type RestoreProductUseCase interface {
+	Execute(ctx context.Context, command *commands.RestoreProductCommand) (*entities.Product, error)
+}
@@ -0,0 +1,53 @@
+package restoreproduct
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	"github.com/mathefer/tc-fiap-product/internal/product/domain/domainerrors"
+	"github.com/mathefer/tc-fiap-product/internal/product/domain/entities"
+	"github.com/mathefer/tc-fiap-product/internal/product/domain/repositories"
+	"github.com/mathefer/tc-fiap-product/internal/product/usecase/commands"
+	"github.com/mathefer/tc-fiap-product/pkg/tracing"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+var (
+	_ RestoreProductUseCase = (*RestoreProductUseCaseImpl)(nil)
+)
+
+type RestoreProductUseCaseImpl struct {
+	productRepository repositories.ProductRepository
+	logger            *slog.Logger
+}
+
+func NewRestoreProductUseCaseImpl(productRepository repositories.ProductRepository, logger *slog.Logger) *RestoreProductUseCaseImpl {
+	return &RestoreProductUseCaseImpl{productRepository: productRepository, logger: logger}
+}
+
+// Execute brings a soft-deleted product back and returns it. Restoring a
+// product that is not deleted changes nothing, so the call is safe to retry.
+func (u *RestoreProductUseCaseImpl) Execute(ctx context.Context, command *commands.RestoreProductCommand) (_ *entities.Product, err error) {
+	ctx, span := tracing.Start(ctx, "RestoreProductUseCase.Execute", trace.WithAttributes(attribute.Int("product.id", int(command.ID))))
+	defer func() {
+		tracing.RecordError(span, err)
+		span.End()
+	}()
+
+	product, err := u.productRepository.GetByIDForUpdate(ctx, command.ID)
+	if err == nil {
+		return product, nil
+	}
+	if !errors.Is(err, domainerrors.ErrNotFound) {
+		return nil, err
+	}
+
+	if err := u.productRepository.Restore(ctx, command.ID); err != nil {
+		return nil, err
+	}
+	u.logger.InfoContext(ctx, "Product restored")
+
+	return u.productRepository.GetByIDForUpdate(ctx, command.ID)
+}
@@ -0,0 +1,115 @@
+package restoreproduct_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"github.com/mathefer/tc-fiap-product/internal/product/domain/domainerrors"
+	"github.com/mathefer/tc-fiap-product/internal/product/domain/entities"
+	"github.com/mathefer/tc-fiap-product/internal/product/usecase/commands"
+	restoreproduct "github.com/mathefer/tc-fiap-product/internal/product/usecase/restoreProduct"
+	mockRepositories "github.com/mathefer/tc-fiap-product/mocks/product/domain/repositories"
+	"github.com/mathefer/tc-fiap-product/pkg/logging"
+)
+
+type RestoreProductUseCaseTestSuite struct {
+	suite.Suite
+	mockRepository *mockRepositories.MockProductRepository
+	useCase        restoreproduct.RestoreProductUseCase
+}
+
+func (suite *RestoreProductUseCaseTestSuite) SetupTest() {
+	suite.mockRepository = mockRepositories.NewMockProductRepository(suite.T())
+	suite.useCase = restoreproduct.NewRestoreProductUseCaseImpl(suite.mockRepository, logging.NewNop())
+}
+
+func TestRestoreProductUseCaseTestSuite(t *testing.T) {
+	suite.Run(t, new(RestoreProductUseCaseTestSuite))
+}
+
+func (suite *RestoreProductUseCaseTestSuite) TestExecute_RestoresDeletedProduct() {
+	// Arrange
+	id := uint(1)
+	restored := &entities.Product{ID: id, Name: "Hamburguer", Category: 1}
+
+	suite.mockRepository.EXPECT().
+		GetByIDForUpdate(mock.Anything, id).
+		Return(nil, domainerrors.NewNotFoundError("product", id)).
+		Once()
+	suite.mockRepository.EXPECT().
+		Restore(mock.Anything, id).
+		Return(nil).
+		Once()
+	suite.mockRepository.EXPECT().
+		GetByIDForUpdate(mock.Anything, id).
+		Return(restored, nil).
+		Once()
+
+	// Act
+	product, err := suite.useCase.Execute(context.Background(), commands.NewRestoreProductCommand(id))
+
+	// Assert
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), restored, product)
+}
+
+func (suite *RestoreProductUseCaseTestSuite) TestExecute_ProductNotDeleted() {
+	// Arrange
+	id := uint(1)
+	existing := &entities.Product{ID: id, Name: "Hamburguer", Category: 1}
+
+	suite.mockRepository.EXPECT().
+		GetByIDForUpdate(mock.Anything, id).
+		Return(existing, nil).
+		Once()
+
+	// Act
+	product, err := suite.useCase.Execute(context.Background(), commands.NewRestoreProductCommand(id))
+
+	// Assert
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), existing, product)
+}
+
+func (suite *RestoreProductUseCaseTestSuite) TestExecute_ProductNotFound() {
+	// Arrange
+	id := uint(999)
+
+	suite.mockRepository.EXPECT().
+		GetByIDForUpdate(mock.Anything, id).
+		Return(nil, domainerrors.NewNotFoundError("product", id)).
+		Once()
+	suite.mockRepository.EXPECT().
+		Restore(mock.Anything, id).
+		Return(domainerrors.NewNotFoundError("product", id)).
+		Once()
+
+	// Act
+	product, err := suite.useCase.Execute(context.Background(), commands.NewRestoreProductCommand(id))
+
+	// Assert
+	assert.Nil(suite.T(), product)
+	assert.ErrorIs(suite.T(), err, domainerrors.ErrNotFound)
+}
+
+func (suite *RestoreProductUseCaseTestSuite) TestExecute_RepositoryError() {
+	// Arrange
+	id := uint(1)
+	expectedError := errors.New("database error")
+
+	suite.mockRepository.EXPECT().
+		GetByIDForUpdate(mock.Anything, id).
+		Return(nil, expectedError).
+		Once()
+
+	// Act
+	product, err := suite.useCase.Execute(context.Background(), commands.NewRestoreProductCommand(id))
+
+	// Assert
+	assert.Nil(suite.T(), product)
+	assert.Equal(suite.T(), expectedError, err)
+}
@@ -0,0 +1,11 @@
+package updatecategory
+
+import (
+	"context"
+
+	"github.com/mathefer/tc-fiap-product/internal/product/usecase/commands"
+)
+
+type UpdateCategoryUseCase interface {
+	Execute(ctx context.Context, command *commands.UpdateCategoryCommand) error
+}
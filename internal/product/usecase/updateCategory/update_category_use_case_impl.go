@@ -0,0 +1,52 @@
+package updatecategory
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/mathefer/tc-fiap-product/internal/product/domain/entities"
+	"github.com/mathefer/tc-fiap-product/internal/product/domain/repositories"
+	"github.com/mathefer/tc-fiap-product/internal/product/usecase/commands"
+	"github.com/mathefer/tc-fiap-product/pkg/tracing"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+var (
+	_ UpdateCategoryUseCase = (*UpdateCategoryUseCaseImpl)(nil)
+)
+
+type UpdateCategoryUseCaseImpl struct {
+	categoryRepository repositories.CategoryRepository
+	logger             *slog.Logger
+}
+
+func NewUpdateCategoryUseCaseImpl(categoryRepository repositories.CategoryRepository, logger *slog.Logger) *UpdateCategoryUseCaseImpl {
+	return &UpdateCategoryUseCaseImpl{categoryRepository: categoryRepository, logger: logger}
+}
+
+func (u *UpdateCategoryUseCaseImpl) Execute(ctx context.Context, command *commands.UpdateCategoryCommand) (err error) {
+	ctx, span := tracing.Start(ctx, "UpdateCategoryUseCase.Execute", trace.WithAttributes(attribute.Int("category.id", int(command.ID))))
+	defer func() {
+		tracing.RecordError(span, err)
+		span.End()
+	}()
+
+	entity := entities.Category{
+		ID:          command.ID,
+		DisplayName: command.DisplayName,
+		SortOrder:   command.SortOrder,
+		Active:      command.Active,
+	}
+
+	if err := entity.Validate(); err != nil {
+		return err
+	}
+
+	if err := u.categoryRepository.Update(ctx, &entity); err != nil {
+		return err
+	}
+
+	u.logger.InfoContext(ctx, "Category updated")
+	return nil
+}
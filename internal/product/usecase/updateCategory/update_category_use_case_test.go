@@ -0,0 +1,76 @@
+package updatecategory_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/mathefer/tc-fiap-product/internal/product/domain/domainerrors"
+	"github.com/mathefer/tc-fiap-product/internal/product/domain/entities"
+	"github.com/mathefer/tc-fiap-product/internal/product/usecase/commands"
+	updatecategory "github.com/mathefer/tc-fiap-product/internal/product/usecase/updateCategory"
+	mockRepositories "github.com/mathefer/tc-fiap-product/mocks/product/domain/repositories"
+	"github.com/mathefer/tc-fiap-product/pkg/logging"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type UpdateCategoryUseCaseTestSuite struct {
+	suite.Suite
+	mockRepository *mockRepositories.MockCategoryRepository
+	useCase        updatecategory.UpdateCategoryUseCase
+}
+
+func (suite *UpdateCategoryUseCaseTestSuite) SetupTest() {
+	suite.mockRepository = mockRepositories.NewMockCategoryRepository(suite.T())
+	suite.useCase = updatecategory.NewUpdateCategoryUseCaseImpl(suite.mockRepository, logging.NewNop())
+}
+
+func TestUpdateCategoryUseCaseTestSuite(t *testing.T) {
+	suite.Run(t, new(UpdateCategoryUseCaseTestSuite))
+}
+
+func (suite *UpdateCategoryUseCaseTestSuite) TestExecute_Success() {
+	// Arrange
+	command := commands.NewUpdateCategoryCommand(5, "Combo", 6, false)
+
+	suite.mockRepository.EXPECT().
+		Update(mock.Anything, &entities.Category{ID: 5, DisplayName: "Combo", SortOrder: 6, Active: false}).
+		Return(nil).
+		Once()
+
+	// Act
+	err := suite.useCase.Execute(context.Background(), command)
+
+	// Assert
+	assert.NoError(suite.T(), err)
+	suite.mockRepository.AssertExpectations(suite.T())
+}
+
+func (suite *UpdateCategoryUseCaseTestSuite) TestExecute_NotFound() {
+	// Arrange
+	command := commands.NewUpdateCategoryCommand(99, "Combo", 6, true)
+
+	suite.mockRepository.EXPECT().
+		Update(mock.Anything, mock.Anything).
+		Return(domainerrors.NewNotFoundError("category", 99)).
+		Once()
+
+	// Act
+	err := suite.useCase.Execute(context.Background(), command)
+
+	// Assert
+	assert.ErrorIs(suite.T(), err, domainerrors.ErrNotFound)
+}
+
+func (suite *UpdateCategoryUseCaseTestSuite) TestExecute_InvalidCategory() {
+	// Arrange
+	command := commands.NewUpdateCategoryCommand(5, " ", 0, true)
+
+	// Act
+	err := suite.useCase.Execute(context.Background(), command)
+
+	// Assert
+	assert.ErrorIs(suite.T(), err, domainerrors.ErrValidation)
+	suite.mockRepository.AssertNotCalled(suite.T(), "Update", mock.Anything)
+}
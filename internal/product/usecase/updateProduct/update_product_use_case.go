@@ -1,8 +1,13 @@
 package updateproduct
 
-import "github.com/mathefer/tc-fiap-product/internal/product/usecase/commands"
+import (
+	"context"
+
+	"github.com/mathefer/tc-fiap-product/internal/product/domain/entities"
+	"github.com/mathefer/tc-fiap-product/internal/product/usecase/commands"
+)
 
 type UpdateProductUseCase interface {
-	Execute(command *commands.UpdateProductCommand) error
+	Execute(ctx context.Context, command *commands.UpdateProductCommand) (*entities.Product, error)
 }
 
@@ -1,9 +1,16 @@
 package updateproduct
 
 import (
+	"context"
+	"log/slog"
+
+	"github.com/mathefer/tc-fiap-product/internal/product/domain/domainerrors"
 	"github.com/mathefer/tc-fiap-product/internal/product/domain/entities"
 	"github.com/mathefer/tc-fiap-product/internal/product/domain/repositories"
 	"github.com/mathefer/tc-fiap-product/internal/product/usecase/commands"
+	"github.com/mathefer/tc-fiap-product/pkg/tracing"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 )
 
 var (
@@ -11,23 +18,53 @@ var (
 )
 
 type UpdateProductUseCaseImpl struct {
-	productRepository repositories.ProductRepository
+	productRepository  repositories.ProductRepository
+	categoryRepository repositories.CategoryRepository
+	logger             *slog.Logger
 }
 
-func NewUpdateProductUseCaseImpl(productRepository repositories.ProductRepository) *UpdateProductUseCaseImpl {
-	return &UpdateProductUseCaseImpl{productRepository: productRepository}
+func NewUpdateProductUseCaseImpl(productRepository repositories.ProductRepository, categoryRepository repositories.CategoryRepository, logger *slog.Logger) *UpdateProductUseCaseImpl {
+	return &UpdateProductUseCaseImpl{productRepository: productRepository, categoryRepository: categoryRepository, logger: logger}
 }
 
-func (u *UpdateProductUseCaseImpl) Execute(command *commands.UpdateProductCommand) error {
+// Execute replaces every editable field of the product and returns it as
+// stored. When command.Version is set the product is only replaced if it is
+// still at that version.
+func (u *UpdateProductUseCaseImpl) Execute(ctx context.Context, command *commands.UpdateProductCommand) (_ *entities.Product, err error) {
+	ctx, span := tracing.Start(ctx, "UpdateProductUseCase.Execute", trace.WithAttributes(attribute.Int("product.id", int(command.ID))))
+	defer func() {
+		tracing.RecordError(span, err)
+		span.End()
+	}()
+
+	price, err := entities.ParseMoney(command.Price, command.Currency)
+	if err != nil {
+		return nil, domainerrors.NewValidationError(domainerrors.FieldError{Field: "price", Message: err.Error()})
+	}
+
 	entity := entities.Product{
 		ID:          command.ID,
+		Version:     command.Version,
 		Name:        command.Name,
 		Category:    command.Category,
-		Price:       command.Price,
+		Price:       price,
 		Description: command.Description,
 		ImageLink:   command.ImageLink,
 	}
 
-	return u.productRepository.Update(&entity)
-}
+	if err := entity.Validate(); err != nil {
+		return nil, err
+	}
 
+	if err := repositories.CheckCategory(ctx, u.categoryRepository, entity.Category); err != nil {
+		return nil, err
+	}
+
+	if err := u.productRepository.Update(ctx, &entity); err != nil {
+		return nil, err
+	}
+
+	u.logger.InfoContext(ctx, "Product updated")
+
+	return u.productRepository.GetByIDForUpdate(ctx, entity.ID)
+}
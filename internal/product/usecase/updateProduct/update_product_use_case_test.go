@@ -1,26 +1,33 @@
 package updateproduct_test
 
 import (
+	"context"
 	"errors"
 	"testing"
+	"time"
 
+	"github.com/mathefer/tc-fiap-product/internal/product/domain/domainerrors"
 	"github.com/mathefer/tc-fiap-product/internal/product/domain/entities"
 	"github.com/mathefer/tc-fiap-product/internal/product/usecase/commands"
 	updateproduct "github.com/mathefer/tc-fiap-product/internal/product/usecase/updateProduct"
 	mockRepositories "github.com/mathefer/tc-fiap-product/mocks/product/domain/repositories"
+	"github.com/mathefer/tc-fiap-product/pkg/logging"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/suite"
 )
 
 type UpdateProductUseCaseTestSuite struct {
 	suite.Suite
-	mockRepository *mockRepositories.MockProductRepository
-	useCase        updateproduct.UpdateProductUseCase
+	mockRepository         *mockRepositories.MockProductRepository
+	mockCategoryRepository *mockRepositories.MockCategoryRepository
+	useCase                updateproduct.UpdateProductUseCase
 }
 
 func (suite *UpdateProductUseCaseTestSuite) SetupTest() {
 	suite.mockRepository = mockRepositories.NewMockProductRepository(suite.T())
-	suite.useCase = updateproduct.NewUpdateProductUseCaseImpl(suite.mockRepository)
+	suite.mockCategoryRepository = mockRepositories.NewMockCategoryRepository(suite.T())
+	suite.useCase = updateproduct.NewUpdateProductUseCaseImpl(suite.mockRepository, suite.mockCategoryRepository, logging.NewNop())
 }
 
 func TestUpdateProductUseCaseTestSuite(t *testing.T) {
@@ -29,52 +36,66 @@ func TestUpdateProductUseCaseTestSuite(t *testing.T) {
 
 func (suite *UpdateProductUseCaseTestSuite) TestExecute_Success() {
 	// Arrange
-	command := commands.NewUpdateProductCommand(1, "Hamburguer Atualizado", 1, 39.99, "Hamburguer com bacon", "https://example.com/updated.jpg")
+	command := commands.NewUpdateProductCommand(1, 2, "Hamburguer Atualizado", 1, "39.99", "", "Hamburguer com bacon", "https://example.com/updated.jpg")
 
+	// The update is conditional on the version in the command
 	expectedProduct := &entities.Product{
 		ID:          command.ID,
+		Version:     2,
 		Name:        command.Name,
 		Category:    command.Category,
-		Price:       command.Price,
+		Price:       entities.NewMoney(3999, "BRL"),
 		Description: command.Description,
 		ImageLink:   command.ImageLink,
 	}
 
+	suite.expectCategory(1, true)
+
 	suite.mockRepository.EXPECT().
-		Update(expectedProduct).
+		Update(mock.Anything, expectedProduct).
 		Return(nil).
 		Once()
 
+	stored := *expectedProduct
+	stored.CreatedAt = time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	suite.mockRepository.EXPECT().
+		GetByIDForUpdate(mock.Anything, uint(1)).
+		Return(&stored, nil).
+		Once()
+
 	// Act
-	err := suite.useCase.Execute(command)
+	product, err := suite.useCase.Execute(context.Background(), command)
 
-	// Assert
+	// Assert - the product is returned as stored, with its creation time
 	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), &stored, product)
 	suite.mockRepository.AssertExpectations(suite.T())
 }
 
 func (suite *UpdateProductUseCaseTestSuite) TestExecute_RepositoryError() {
 	// Arrange
-	command := commands.NewUpdateProductCommand(1, "Pizza", 1, 45.99, "Pizza margherita", "https://example.com/pizza.jpg")
+	command := commands.NewUpdateProductCommand(1, 0, "Pizza", 1, "45.99", "", "Pizza margherita", "https://example.com/pizza.jpg")
 
 	expectedProduct := &entities.Product{
 		ID:          command.ID,
 		Name:        command.Name,
 		Category:    command.Category,
-		Price:       command.Price,
+		Price:       entities.NewMoney(4599, "BRL"),
 		Description: command.Description,
 		ImageLink:   command.ImageLink,
 	}
 
 	expectedError := errors.New("database error")
 
+	suite.expectCategory(1, true)
+
 	suite.mockRepository.EXPECT().
-		Update(expectedProduct).
+		Update(mock.Anything, expectedProduct).
 		Return(expectedError).
 		Once()
 
 	// Act
-	err := suite.useCase.Execute(command)
+	_, err := suite.useCase.Execute(context.Background(), command)
 
 	// Assert
 	assert.Error(suite.T(), err)
@@ -84,29 +105,91 @@ func (suite *UpdateProductUseCaseTestSuite) TestExecute_RepositoryError() {
 
 func (suite *UpdateProductUseCaseTestSuite) TestExecute_ProductNotFound() {
 	// Arrange
-	command := commands.NewUpdateProductCommand(999, "Non-existent Product", 1, 10.0, "Description", "https://example.com/image.jpg")
+	command := commands.NewUpdateProductCommand(999, 0, "Non-existent Product", 1, "10.0", "", "Description", "https://example.com/image.jpg")
 
 	expectedProduct := &entities.Product{
 		ID:          command.ID,
 		Name:        command.Name,
 		Category:    command.Category,
-		Price:       command.Price,
+		Price:       entities.NewMoney(1000, "BRL"),
 		Description: command.Description,
 		ImageLink:   command.ImageLink,
 	}
 
 	expectedError := errors.New("product not found")
 
+	suite.expectCategory(1, true)
+
 	suite.mockRepository.EXPECT().
-		Update(expectedProduct).
+		Update(mock.Anything, expectedProduct).
 		Return(expectedError).
 		Once()
 
 	// Act
-	err := suite.useCase.Execute(command)
+	_, err := suite.useCase.Execute(context.Background(), command)
 
 	// Assert
 	assert.Error(suite.T(), err)
 	assert.Equal(suite.T(), expectedError, err)
 	suite.mockRepository.AssertExpectations(suite.T())
 }
+
+func (suite *UpdateProductUseCaseTestSuite) TestExecute_InvalidProduct() {
+	// Arrange
+	command := commands.NewUpdateProductCommand(1, 0, "Hamburguer", 0, "-10", "", "", "invalid-link")
+
+	// Act
+	_, err := suite.useCase.Execute(context.Background(), command)
+
+	// Assert
+	assert.Error(suite.T(), err)
+	assert.ErrorIs(suite.T(), err, domainerrors.ErrValidation)
+
+	var validationErr *domainerrors.ValidationError
+	assert.ErrorAs(suite.T(), err, &validationErr)
+	assert.Len(suite.T(), validationErr.Fields, 3)
+	suite.mockRepository.AssertNotCalled(suite.T(), "Update")
+}
+
+func (suite *UpdateProductUseCaseTestSuite) TestExecute_CategoryNotFound() {
+	// Arrange
+	command := commands.NewUpdateProductCommand(1, 0, "Hamburguer", 7, "34.99", "", "", "")
+
+	suite.mockCategoryRepository.EXPECT().
+		GetByID(mock.Anything, uint(7)).
+		Return(nil, domainerrors.NewNotFoundError("category", 7)).
+		Once()
+
+	// Act
+	_, err := suite.useCase.Execute(context.Background(), command)
+
+	// Assert
+	assert.ErrorIs(suite.T(), err, domainerrors.ErrValidation)
+
+	var validationErr *domainerrors.ValidationError
+	assert.ErrorAs(suite.T(), err, &validationErr)
+	assert.Equal(suite.T(), []domainerrors.FieldError{{Field: "category", Message: "does not exist"}}, validationErr.Fields)
+	suite.mockRepository.AssertNotCalled(suite.T(), "Update")
+}
+
+func (suite *UpdateProductUseCaseTestSuite) TestExecute_CategoryInactive() {
+	// Arrange
+	command := commands.NewUpdateProductCommand(1, 0, "Hamburguer", 1, "34.99", "", "", "")
+
+	suite.expectCategory(1, false)
+
+	// Act
+	_, err := suite.useCase.Execute(context.Background(), command)
+
+	// Assert
+	assert.ErrorIs(suite.T(), err, domainerrors.ErrValidation)
+	assert.Contains(suite.T(), err.Error(), "is not active")
+	suite.mockRepository.AssertNotCalled(suite.T(), "Update")
+}
+
+func (suite *UpdateProductUseCaseTestSuite) expectCategory(id uint, active bool) {
+	suite.mockCategoryRepository.EXPECT().
+		GetByID(mock.Anything, id).
+		Return(&entities.Category{ID: id, DisplayName: "Lanche", Active: active}, nil).
+		Once()
+}
@@ -3,6 +3,9 @@
 package mocks
 
 import (
+	context "context"
+	time "time"
+
 	dto "github.com/mathefer/tc-fiap-product/internal/product/infrastructure/api/dto"
 	mock "github.com/stretchr/testify/mock"
 )
@@ -20,22 +23,34 @@ func (_m *MockProductController) EXPECT() *MockProductController_Expecter {
 	return &MockProductController_Expecter{mock: &_m.Mock}
 }
 
-// Add provides a mock function with given fields: product
-func (_m *MockProductController) Add(product *dto.AddProductRequestDto) error {
-	ret := _m.Called(product)
+// Add provides a mock function with given fields: ctx, product
+func (_m *MockProductController) Add(ctx context.Context, product *dto.AddProductRequestDto) (*dto.GetProductResponseDto, error) {
+	ret := _m.Called(ctx, product)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Add")
 	}
 
-	var r0 error
-	if rf, ok := ret.Get(0).(func(*dto.AddProductRequestDto) error); ok {
-		r0 = rf(product)
+	var r0 *dto.GetProductResponseDto
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *dto.AddProductRequestDto) (*dto.GetProductResponseDto, error)); ok {
+		return rf(ctx, product)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *dto.AddProductRequestDto) *dto.GetProductResponseDto); ok {
+		r0 = rf(ctx, product)
 	} else {
-		r0 = ret.Error(0)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.GetProductResponseDto)
+		}
 	}
 
-	return r0
+	if rf, ok := ret.Get(1).(func(context.Context, *dto.AddProductRequestDto) error); ok {
+		r1 = rf(ctx, product)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
 }
 
 // MockProductController_Add_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Add'
@@ -44,39 +59,40 @@ type MockProductController_Add_Call struct {
 }
 
 // Add is a helper method to define mock.On call
+//   - ctx context.Context
 //   - product *dto.AddProductRequestDto
-func (_e *MockProductController_Expecter) Add(product interface{}) *MockProductController_Add_Call {
-	return &MockProductController_Add_Call{Call: _e.mock.On("Add", product)}
+func (_e *MockProductController_Expecter) Add(ctx interface{}, product interface{}) *MockProductController_Add_Call {
+	return &MockProductController_Add_Call{Call: _e.mock.On("Add", ctx, product)}
 }
 
-func (_c *MockProductController_Add_Call) Run(run func(product *dto.AddProductRequestDto)) *MockProductController_Add_Call {
+func (_c *MockProductController_Add_Call) Run(run func(ctx context.Context, product *dto.AddProductRequestDto)) *MockProductController_Add_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(*dto.AddProductRequestDto))
+		run(args[0].(context.Context), args[1].(*dto.AddProductRequestDto))
 	})
 	return _c
 }
 
-func (_c *MockProductController_Add_Call) Return(_a0 error) *MockProductController_Add_Call {
-	_c.Call.Return(_a0)
+func (_c *MockProductController_Add_Call) Return(_a0 *dto.GetProductResponseDto, _a1 error) *MockProductController_Add_Call {
+	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockProductController_Add_Call) RunAndReturn(run func(*dto.AddProductRequestDto) error) *MockProductController_Add_Call {
+func (_c *MockProductController_Add_Call) RunAndReturn(run func(context.Context, *dto.AddProductRequestDto) (*dto.GetProductResponseDto, error)) *MockProductController_Add_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// Delete provides a mock function with given fields: id
-func (_m *MockProductController) Delete(id uint) error {
-	ret := _m.Called(id)
+// Delete provides a mock function with given fields: ctx, id, version
+func (_m *MockProductController) Delete(ctx context.Context, id uint, version uint) error {
+	ret := _m.Called(ctx, id, version)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Delete")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(uint) error); ok {
-		r0 = rf(id)
+	if rf, ok := ret.Get(0).(func(context.Context, uint, uint) error); ok {
+		r0 = rf(ctx, id, version)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -90,14 +106,16 @@ type MockProductController_Delete_Call struct {
 }
 
 // Delete is a helper method to define mock.On call
+//   - ctx context.Context
 //   - id uint
-func (_e *MockProductController_Expecter) Delete(id interface{}) *MockProductController_Delete_Call {
-	return &MockProductController_Delete_Call{Call: _e.mock.On("Delete", id)}
+//   - version uint
+func (_e *MockProductController_Expecter) Delete(ctx interface{}, id interface{}, version interface{}) *MockProductController_Delete_Call {
+	return &MockProductController_Delete_Call{Call: _e.mock.On("Delete", ctx, id, version)}
 }
 
-func (_c *MockProductController_Delete_Call) Run(run func(id uint)) *MockProductController_Delete_Call {
+func (_c *MockProductController_Delete_Call) Run(run func(ctx context.Context, id uint, version uint)) *MockProductController_Delete_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(uint))
+		run(args[0].(context.Context), args[1].(uint), args[2].(uint))
 	})
 	return _c
 }
@@ -107,14 +125,14 @@ func (_c *MockProductController_Delete_Call) Return(_a0 error) *MockProductContr
 	return _c
 }
 
-func (_c *MockProductController_Delete_Call) RunAndReturn(run func(uint) error) *MockProductController_Delete_Call {
+func (_c *MockProductController_Delete_Call) RunAndReturn(run func(context.Context, uint, uint) error) *MockProductController_Delete_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// Get provides a mock function with given fields: category
-func (_m *MockProductController) Get(category uint) ([]*dto.GetProductResponseDto, error) {
-	ret := _m.Called(category)
+// Get provides a mock function with given fields: ctx, category, includeDeleted
+func (_m *MockProductController) Get(ctx context.Context, category uint, includeDeleted bool) ([]*dto.GetProductResponseDto, error) {
+	ret := _m.Called(ctx, category, includeDeleted)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Get")
@@ -122,19 +140,19 @@ func (_m *MockProductController) Get(category uint) ([]*dto.GetProductResponseDt
 
 	var r0 []*dto.GetProductResponseDto
 	var r1 error
-	if rf, ok := ret.Get(0).(func(uint) ([]*dto.GetProductResponseDto, error)); ok {
-		return rf(category)
+	if rf, ok := ret.Get(0).(func(context.Context, uint, bool) ([]*dto.GetProductResponseDto, error)); ok {
+		return rf(ctx, category, includeDeleted)
 	}
-	if rf, ok := ret.Get(0).(func(uint) []*dto.GetProductResponseDto); ok {
-		r0 = rf(category)
+	if rf, ok := ret.Get(0).(func(context.Context, uint, bool) []*dto.GetProductResponseDto); ok {
+		r0 = rf(ctx, category, includeDeleted)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*dto.GetProductResponseDto)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(uint) error); ok {
-		r1 = rf(category)
+	if rf, ok := ret.Get(1).(func(context.Context, uint, bool) error); ok {
+		r1 = rf(ctx, category, includeDeleted)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -148,14 +166,16 @@ type MockProductController_Get_Call struct {
 }
 
 // Get is a helper method to define mock.On call
+//   - ctx context.Context
 //   - category uint
-func (_e *MockProductController_Expecter) Get(category interface{}) *MockProductController_Get_Call {
-	return &MockProductController_Get_Call{Call: _e.mock.On("Get", category)}
+//   - includeDeleted bool
+func (_e *MockProductController_Expecter) Get(ctx interface{}, category interface{}, includeDeleted interface{}) *MockProductController_Get_Call {
+	return &MockProductController_Get_Call{Call: _e.mock.On("Get", ctx, category, includeDeleted)}
 }
 
-func (_c *MockProductController_Get_Call) Run(run func(category uint)) *MockProductController_Get_Call {
+func (_c *MockProductController_Get_Call) Run(run func(ctx context.Context, category uint, includeDeleted bool)) *MockProductController_Get_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(uint))
+		run(args[0].(context.Context), args[1].(uint), args[2].(bool))
 	})
 	return _c
 }
@@ -165,27 +185,393 @@ func (_c *MockProductController_Get_Call) Return(_a0 []*dto.GetProductResponseDt
 	return _c
 }
 
-func (_c *MockProductController_Get_Call) RunAndReturn(run func(uint) ([]*dto.GetProductResponseDto, error)) *MockProductController_Get_Call {
+func (_c *MockProductController_Get_Call) RunAndReturn(run func(context.Context, uint, bool) ([]*dto.GetProductResponseDto, error)) *MockProductController_Get_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetByID provides a mock function with given fields: ctx, id, includeDeleted
+func (_m *MockProductController) GetByID(ctx context.Context, id uint, includeDeleted bool) (*dto.GetProductResponseDto, error) {
+	ret := _m.Called(ctx, id, includeDeleted)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByID")
+	}
+
+	var r0 *dto.GetProductResponseDto
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint, bool) (*dto.GetProductResponseDto, error)); ok {
+		return rf(ctx, id, includeDeleted)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint, bool) *dto.GetProductResponseDto); ok {
+		r0 = rf(ctx, id, includeDeleted)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.GetProductResponseDto)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint, bool) error); ok {
+		r1 = rf(ctx, id, includeDeleted)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockProductController_GetByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByID'
+type MockProductController_GetByID_Call struct {
+	*mock.Call
+}
+
+// GetByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uint
+//   - includeDeleted bool
+func (_e *MockProductController_Expecter) GetByID(ctx interface{}, id interface{}, includeDeleted interface{}) *MockProductController_GetByID_Call {
+	return &MockProductController_GetByID_Call{Call: _e.mock.On("GetByID", ctx, id, includeDeleted)}
+}
+
+func (_c *MockProductController_GetByID_Call) Run(run func(ctx context.Context, id uint, includeDeleted bool)) *MockProductController_GetByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint), args[2].(bool))
+	})
+	return _c
+}
+
+func (_c *MockProductController_GetByID_Call) Return(_a0 *dto.GetProductResponseDto, _a1 error) *MockProductController_GetByID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockProductController_GetByID_Call) RunAndReturn(run func(context.Context, uint, bool) (*dto.GetProductResponseDto, error)) *MockProductController_GetByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// LastModified provides a mock function with given fields: ctx
+func (_m *MockProductController) LastModified(ctx context.Context) (time.Time, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for LastModified")
+	}
+
+	var r0 time.Time
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (time.Time, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) time.Time); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(time.Time)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockProductController_LastModified_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LastModified'
+type MockProductController_LastModified_Call struct {
+	*mock.Call
+}
+
+// LastModified is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockProductController_Expecter) LastModified(ctx interface{}) *MockProductController_LastModified_Call {
+	return &MockProductController_LastModified_Call{Call: _e.mock.On("LastModified", ctx)}
+}
+
+func (_c *MockProductController_LastModified_Call) Run(run func(ctx context.Context)) *MockProductController_LastModified_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockProductController_LastModified_Call) Return(_a0 time.Time, _a1 error) *MockProductController_LastModified_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockProductController_LastModified_Call) RunAndReturn(run func(context.Context) (time.Time, error)) *MockProductController_LastModified_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// List provides a mock function with given fields: ctx, request
+func (_m *MockProductController) List(ctx context.Context, request *dto.ListProductsRequestDto) (*dto.ListProductsResponseDto, error) {
+	ret := _m.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for List")
+	}
+
+	var r0 *dto.ListProductsResponseDto
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *dto.ListProductsRequestDto) (*dto.ListProductsResponseDto, error)); ok {
+		return rf(ctx, request)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *dto.ListProductsRequestDto) *dto.ListProductsResponseDto); ok {
+		r0 = rf(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.ListProductsResponseDto)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *dto.ListProductsRequestDto) error); ok {
+		r1 = rf(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockProductController_List_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'List'
+type MockProductController_List_Call struct {
+	*mock.Call
+}
+
+// List is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *dto.ListProductsRequestDto
+func (_e *MockProductController_Expecter) List(ctx interface{}, request interface{}) *MockProductController_List_Call {
+	return &MockProductController_List_Call{Call: _e.mock.On("List", ctx, request)}
+}
+
+func (_c *MockProductController_List_Call) Run(run func(ctx context.Context, request *dto.ListProductsRequestDto)) *MockProductController_List_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*dto.ListProductsRequestDto))
+	})
+	return _c
+}
+
+func (_c *MockProductController_List_Call) Return(_a0 *dto.ListProductsResponseDto, _a1 error) *MockProductController_List_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockProductController_List_Call) RunAndReturn(run func(context.Context, *dto.ListProductsRequestDto) (*dto.ListProductsResponseDto, error)) *MockProductController_List_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Lookup provides a mock function with given fields: ctx, request
+func (_m *MockProductController) Lookup(ctx context.Context, request *dto.LookupProductsRequestDto) (*dto.LookupProductsResponseDto, error) {
+	ret := _m.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Lookup")
+	}
+
+	var r0 *dto.LookupProductsResponseDto
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *dto.LookupProductsRequestDto) (*dto.LookupProductsResponseDto, error)); ok {
+		return rf(ctx, request)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *dto.LookupProductsRequestDto) *dto.LookupProductsResponseDto); ok {
+		r0 = rf(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.LookupProductsResponseDto)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *dto.LookupProductsRequestDto) error); ok {
+		r1 = rf(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockProductController_Lookup_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Lookup'
+type MockProductController_Lookup_Call struct {
+	*mock.Call
+}
+
+// Lookup is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *dto.LookupProductsRequestDto
+func (_e *MockProductController_Expecter) Lookup(ctx interface{}, request interface{}) *MockProductController_Lookup_Call {
+	return &MockProductController_Lookup_Call{Call: _e.mock.On("Lookup", ctx, request)}
+}
+
+func (_c *MockProductController_Lookup_Call) Run(run func(ctx context.Context, request *dto.LookupProductsRequestDto)) *MockProductController_Lookup_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*dto.LookupProductsRequestDto))
+	})
+	return _c
+}
+
+func (_c *MockProductController_Lookup_Call) Return(_a0 *dto.LookupProductsResponseDto, _a1 error) *MockProductController_Lookup_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockProductController_Lookup_Call) RunAndReturn(run func(context.Context, *dto.LookupProductsRequestDto) (*dto.LookupProductsResponseDto, error)) *MockProductController_Lookup_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// Update provides a mock function with given fields: id, product
-func (_m *MockProductController) Update(id uint, product *dto.UpdateProductRequestDto) error {
-	ret := _m.Called(id, product)
+// Patch provides a mock function with given fields: ctx, id, version, product
+func (_m *MockProductController) Patch(ctx context.Context, id uint, version uint, product *dto.PatchProductRequestDto) (*dto.GetProductResponseDto, error) {
+	ret := _m.Called(ctx, id, version, product)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Patch")
+	}
+
+	var r0 *dto.GetProductResponseDto
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint, uint, *dto.PatchProductRequestDto) (*dto.GetProductResponseDto, error)); ok {
+		return rf(ctx, id, version, product)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint, uint, *dto.PatchProductRequestDto) *dto.GetProductResponseDto); ok {
+		r0 = rf(ctx, id, version, product)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.GetProductResponseDto)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint, uint, *dto.PatchProductRequestDto) error); ok {
+		r1 = rf(ctx, id, version, product)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockProductController_Patch_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Patch'
+type MockProductController_Patch_Call struct {
+	*mock.Call
+}
+
+// Patch is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uint
+//   - version uint
+//   - product *dto.PatchProductRequestDto
+func (_e *MockProductController_Expecter) Patch(ctx interface{}, id interface{}, version interface{}, product interface{}) *MockProductController_Patch_Call {
+	return &MockProductController_Patch_Call{Call: _e.mock.On("Patch", ctx, id, version, product)}
+}
+
+func (_c *MockProductController_Patch_Call) Run(run func(ctx context.Context, id uint, version uint, product *dto.PatchProductRequestDto)) *MockProductController_Patch_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint), args[2].(uint), args[3].(*dto.PatchProductRequestDto))
+	})
+	return _c
+}
+
+func (_c *MockProductController_Patch_Call) Return(_a0 *dto.GetProductResponseDto, _a1 error) *MockProductController_Patch_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockProductController_Patch_Call) RunAndReturn(run func(context.Context, uint, uint, *dto.PatchProductRequestDto) (*dto.GetProductResponseDto, error)) *MockProductController_Patch_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Restore provides a mock function with given fields: ctx, id
+func (_m *MockProductController) Restore(ctx context.Context, id uint) (*dto.GetProductResponseDto, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Restore")
+	}
+
+	var r0 *dto.GetProductResponseDto
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint) (*dto.GetProductResponseDto, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint) *dto.GetProductResponseDto); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.GetProductResponseDto)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockProductController_Restore_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Restore'
+type MockProductController_Restore_Call struct {
+	*mock.Call
+}
+
+// Restore is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uint
+func (_e *MockProductController_Expecter) Restore(ctx interface{}, id interface{}) *MockProductController_Restore_Call {
+	return &MockProductController_Restore_Call{Call: _e.mock.On("Restore", ctx, id)}
+}
+
+func (_c *MockProductController_Restore_Call) Run(run func(ctx context.Context, id uint)) *MockProductController_Restore_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint))
+	})
+	return _c
+}
+
+func (_c *MockProductController_Restore_Call) Return(_a0 *dto.GetProductResponseDto, _a1 error) *MockProductController_Restore_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockProductController_Restore_Call) RunAndReturn(run func(context.Context, uint) (*dto.GetProductResponseDto, error)) *MockProductController_Restore_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Update provides a mock function with given fields: ctx, id, version, product
+func (_m *MockProductController) Update(ctx context.Context, id uint, version uint, product *dto.UpdateProductRequestDto) (*dto.GetProductResponseDto, error) {
+	ret := _m.Called(ctx, id, version, product)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Update")
 	}
 
-	var r0 error
-	if rf, ok := ret.Get(0).(func(uint, *dto.UpdateProductRequestDto) error); ok {
-		r0 = rf(id, product)
+	var r0 *dto.GetProductResponseDto
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint, uint, *dto.UpdateProductRequestDto) (*dto.GetProductResponseDto, error)); ok {
+		return rf(ctx, id, version, product)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint, uint, *dto.UpdateProductRequestDto) *dto.GetProductResponseDto); ok {
+		r0 = rf(ctx, id, version, product)
 	} else {
-		r0 = ret.Error(0)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.GetProductResponseDto)
+		}
 	}
 
-	return r0
+	if rf, ok := ret.Get(1).(func(context.Context, uint, uint, *dto.UpdateProductRequestDto) error); ok {
+		r1 = rf(ctx, id, version, product)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
 }
 
 // MockProductController_Update_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Update'
@@ -194,25 +580,27 @@ type MockProductController_Update_Call struct {
 }
 
 // Update is a helper method to define mock.On call
+//   - ctx context.Context
 //   - id uint
+//   - version uint
 //   - product *dto.UpdateProductRequestDto
-func (_e *MockProductController_Expecter) Update(id interface{}, product interface{}) *MockProductController_Update_Call {
-	return &MockProductController_Update_Call{Call: _e.mock.On("Update", id, product)}
+func (_e *MockProductController_Expecter) Update(ctx interface{}, id interface{}, version interface{}, product interface{}) *MockProductController_Update_Call {
+	return &MockProductController_Update_Call{Call: _e.mock.On("Update", ctx, id, version, product)}
 }
 
-func (_c *MockProductController_Update_Call) Run(run func(id uint, product *dto.UpdateProductRequestDto)) *MockProductController_Update_Call {
+func (_c *MockProductController_Update_Call) Run(run func(ctx context.Context, id uint, version uint, product *dto.UpdateProductRequestDto)) *MockProductController_Update_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(uint), args[1].(*dto.UpdateProductRequestDto))
+		run(args[0].(context.Context), args[1].(uint), args[2].(uint), args[3].(*dto.UpdateProductRequestDto))
 	})
 	return _c
 }
 
-func (_c *MockProductController_Update_Call) Return(_a0 error) *MockProductController_Update_Call {
-	_c.Call.Return(_a0)
+func (_c *MockProductController_Update_Call) Return(_a0 *dto.GetProductResponseDto, _a1 error) *MockProductController_Update_Call {
+	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockProductController_Update_Call) RunAndReturn(run func(uint, *dto.UpdateProductRequestDto) error) *MockProductController_Update_Call {
+func (_c *MockProductController_Update_Call) RunAndReturn(run func(context.Context, uint, uint, *dto.UpdateProductRequestDto) (*dto.GetProductResponseDto, error)) *MockProductController_Update_Call {
 	_c.Call.Return(run)
 	return _c
 }
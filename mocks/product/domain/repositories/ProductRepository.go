@@ -3,7 +3,12 @@
 package mocks
 
 import (
+	context "context"
+	time "time"
+
 	entities "github.com/mathefer/tc-fiap-product/internal/product/domain/entities"
+	repositories "github.com/mathefer/tc-fiap-product/internal/product/domain/repositories"
+
 	mock "github.com/stretchr/testify/mock"
 )
 
@@ -20,17 +25,17 @@ func (_m *MockProductRepository) EXPECT() *MockProductRepository_Expecter {
 	return &MockProductRepository_Expecter{mock: &_m.Mock}
 }
 
-// Add provides a mock function with given fields: product
-func (_m *MockProductRepository) Add(product *entities.Product) error {
-	ret := _m.Called(product)
+// Add provides a mock function with given fields: ctx, product
+func (_m *MockProductRepository) Add(ctx context.Context, product *entities.Product) error {
+	ret := _m.Called(ctx, product)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Add")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(*entities.Product) error); ok {
-		r0 = rf(product)
+	if rf, ok := ret.Get(0).(func(context.Context, *entities.Product) error); ok {
+		r0 = rf(ctx, product)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -44,14 +49,15 @@ type MockProductRepository_Add_Call struct {
 }
 
 // Add is a helper method to define mock.On call
+//   - ctx context.Context
 //   - product *entities.Product
-func (_e *MockProductRepository_Expecter) Add(product interface{}) *MockProductRepository_Add_Call {
-	return &MockProductRepository_Add_Call{Call: _e.mock.On("Add", product)}
+func (_e *MockProductRepository_Expecter) Add(ctx interface{}, product interface{}) *MockProductRepository_Add_Call {
+	return &MockProductRepository_Add_Call{Call: _e.mock.On("Add", ctx, product)}
 }
 
-func (_c *MockProductRepository_Add_Call) Run(run func(product *entities.Product)) *MockProductRepository_Add_Call {
+func (_c *MockProductRepository_Add_Call) Run(run func(ctx context.Context, product *entities.Product)) *MockProductRepository_Add_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(*entities.Product))
+		run(args[0].(context.Context), args[1].(*entities.Product))
 	})
 	return _c
 }
@@ -61,22 +67,80 @@ func (_c *MockProductRepository_Add_Call) Return(_a0 error) *MockProductReposito
 	return _c
 }
 
-func (_c *MockProductRepository_Add_Call) RunAndReturn(run func(*entities.Product) error) *MockProductRepository_Add_Call {
+func (_c *MockProductRepository_Add_Call) RunAndReturn(run func(context.Context, *entities.Product) error) *MockProductRepository_Add_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// Delete provides a mock function with given fields: id
-func (_m *MockProductRepository) Delete(id uint) error {
-	ret := _m.Called(id)
+// CountByCategory provides a mock function with given fields: ctx
+func (_m *MockProductRepository) CountByCategory(ctx context.Context) (map[int]int64, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CountByCategory")
+	}
+
+	var r0 map[int]int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (map[int]int64, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) map[int]int64); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[int]int64)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockProductRepository_CountByCategory_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CountByCategory'
+type MockProductRepository_CountByCategory_Call struct {
+	*mock.Call
+}
+
+// CountByCategory is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockProductRepository_Expecter) CountByCategory(ctx interface{}) *MockProductRepository_CountByCategory_Call {
+	return &MockProductRepository_CountByCategory_Call{Call: _e.mock.On("CountByCategory", ctx)}
+}
+
+func (_c *MockProductRepository_CountByCategory_Call) Run(run func(ctx context.Context)) *MockProductRepository_CountByCategory_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockProductRepository_CountByCategory_Call) Return(_a0 map[int]int64, _a1 error) *MockProductRepository_CountByCategory_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockProductRepository_CountByCategory_Call) RunAndReturn(run func(context.Context) (map[int]int64, error)) *MockProductRepository_CountByCategory_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Delete provides a mock function with given fields: ctx, id, version
+func (_m *MockProductRepository) Delete(ctx context.Context, id uint, version uint) error {
+	ret := _m.Called(ctx, id, version)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Delete")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(uint) error); ok {
-		r0 = rf(id)
+	if rf, ok := ret.Get(0).(func(context.Context, uint, uint) error); ok {
+		r0 = rf(ctx, id, version)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -90,14 +154,16 @@ type MockProductRepository_Delete_Call struct {
 }
 
 // Delete is a helper method to define mock.On call
+//   - ctx context.Context
 //   - id uint
-func (_e *MockProductRepository_Expecter) Delete(id interface{}) *MockProductRepository_Delete_Call {
-	return &MockProductRepository_Delete_Call{Call: _e.mock.On("Delete", id)}
+//   - version uint
+func (_e *MockProductRepository_Expecter) Delete(ctx interface{}, id interface{}, version interface{}) *MockProductRepository_Delete_Call {
+	return &MockProductRepository_Delete_Call{Call: _e.mock.On("Delete", ctx, id, version)}
 }
 
-func (_c *MockProductRepository_Delete_Call) Run(run func(id uint)) *MockProductRepository_Delete_Call {
+func (_c *MockProductRepository_Delete_Call) Run(run func(ctx context.Context, id uint, version uint)) *MockProductRepository_Delete_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(uint))
+		run(args[0].(context.Context), args[1].(uint), args[2].(uint))
 	})
 	return _c
 }
@@ -107,14 +173,14 @@ func (_c *MockProductRepository_Delete_Call) Return(_a0 error) *MockProductRepos
 	return _c
 }
 
-func (_c *MockProductRepository_Delete_Call) RunAndReturn(run func(uint) error) *MockProductRepository_Delete_Call {
+func (_c *MockProductRepository_Delete_Call) RunAndReturn(run func(context.Context, uint, uint) error) *MockProductRepository_Delete_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// Get provides a mock function with given fields: category
-func (_m *MockProductRepository) Get(category uint) ([]*entities.Product, error) {
-	ret := _m.Called(category)
+// Get provides a mock function with given fields: ctx, category, includeDeleted
+func (_m *MockProductRepository) Get(ctx context.Context, category uint, includeDeleted bool) ([]*entities.Product, error) {
+	ret := _m.Called(ctx, category, includeDeleted)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Get")
@@ -122,19 +188,19 @@ func (_m *MockProductRepository) Get(category uint) ([]*entities.Product, error)
 
 	var r0 []*entities.Product
 	var r1 error
-	if rf, ok := ret.Get(0).(func(uint) ([]*entities.Product, error)); ok {
-		return rf(category)
+	if rf, ok := ret.Get(0).(func(context.Context, uint, bool) ([]*entities.Product, error)); ok {
+		return rf(ctx, category, includeDeleted)
 	}
-	if rf, ok := ret.Get(0).(func(uint) []*entities.Product); ok {
-		r0 = rf(category)
+	if rf, ok := ret.Get(0).(func(context.Context, uint, bool) []*entities.Product); ok {
+		r0 = rf(ctx, category, includeDeleted)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*entities.Product)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(uint) error); ok {
-		r1 = rf(category)
+	if rf, ok := ret.Get(1).(func(context.Context, uint, bool) error); ok {
+		r1 = rf(ctx, category, includeDeleted)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -148,14 +214,16 @@ type MockProductRepository_Get_Call struct {
 }
 
 // Get is a helper method to define mock.On call
+//   - ctx context.Context
 //   - category uint
-func (_e *MockProductRepository_Expecter) Get(category interface{}) *MockProductRepository_Get_Call {
-	return &MockProductRepository_Get_Call{Call: _e.mock.On("Get", category)}
+//   - includeDeleted bool
+func (_e *MockProductRepository_Expecter) Get(ctx interface{}, category interface{}, includeDeleted interface{}) *MockProductRepository_Get_Call {
+	return &MockProductRepository_Get_Call{Call: _e.mock.On("Get", ctx, category, includeDeleted)}
 }
 
-func (_c *MockProductRepository_Get_Call) Run(run func(category uint)) *MockProductRepository_Get_Call {
+func (_c *MockProductRepository_Get_Call) Run(run func(ctx context.Context, category uint, includeDeleted bool)) *MockProductRepository_Get_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(uint))
+		run(args[0].(context.Context), args[1].(uint), args[2].(bool))
 	})
 	return _c
 }
@@ -165,22 +233,370 @@ func (_c *MockProductRepository_Get_Call) Return(_a0 []*entities.Product, _a1 er
 	return _c
 }
 
-func (_c *MockProductRepository_Get_Call) RunAndReturn(run func(uint) ([]*entities.Product, error)) *MockProductRepository_Get_Call {
+func (_c *MockProductRepository_Get_Call) RunAndReturn(run func(context.Context, uint, bool) ([]*entities.Product, error)) *MockProductRepository_Get_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetByID provides a mock function with given fields: ctx, id, includeDeleted
+func (_m *MockProductRepository) GetByID(ctx context.Context, id uint, includeDeleted bool) (*entities.Product, error) {
+	ret := _m.Called(ctx, id, includeDeleted)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByID")
+	}
+
+	var r0 *entities.Product
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint, bool) (*entities.Product, error)); ok {
+		return rf(ctx, id, includeDeleted)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint, bool) *entities.Product); ok {
+		r0 = rf(ctx, id, includeDeleted)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.Product)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint, bool) error); ok {
+		r1 = rf(ctx, id, includeDeleted)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockProductRepository_GetByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByID'
+type MockProductRepository_GetByID_Call struct {
+	*mock.Call
+}
+
+// GetByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uint
+//   - includeDeleted bool
+func (_e *MockProductRepository_Expecter) GetByID(ctx interface{}, id interface{}, includeDeleted interface{}) *MockProductRepository_GetByID_Call {
+	return &MockProductRepository_GetByID_Call{Call: _e.mock.On("GetByID", ctx, id, includeDeleted)}
+}
+
+func (_c *MockProductRepository_GetByID_Call) Run(run func(ctx context.Context, id uint, includeDeleted bool)) *MockProductRepository_GetByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint), args[2].(bool))
+	})
+	return _c
+}
+
+func (_c *MockProductRepository_GetByID_Call) Return(_a0 *entities.Product, _a1 error) *MockProductRepository_GetByID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockProductRepository_GetByID_Call) RunAndReturn(run func(context.Context, uint, bool) (*entities.Product, error)) *MockProductRepository_GetByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetByIDForUpdate provides a mock function with given fields: ctx, id
+func (_m *MockProductRepository) GetByIDForUpdate(ctx context.Context, id uint) (*entities.Product, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByIDForUpdate")
+	}
+
+	var r0 *entities.Product
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint) (*entities.Product, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint) *entities.Product); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.Product)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockProductRepository_GetByIDForUpdate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByIDForUpdate'
+type MockProductRepository_GetByIDForUpdate_Call struct {
+	*mock.Call
+}
+
+// GetByIDForUpdate is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uint
+func (_e *MockProductRepository_Expecter) GetByIDForUpdate(ctx interface{}, id interface{}) *MockProductRepository_GetByIDForUpdate_Call {
+	return &MockProductRepository_GetByIDForUpdate_Call{Call: _e.mock.On("GetByIDForUpdate", ctx, id)}
+}
+
+func (_c *MockProductRepository_GetByIDForUpdate_Call) Run(run func(ctx context.Context, id uint)) *MockProductRepository_GetByIDForUpdate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint))
+	})
+	return _c
+}
+
+func (_c *MockProductRepository_GetByIDForUpdate_Call) Return(_a0 *entities.Product, _a1 error) *MockProductRepository_GetByIDForUpdate_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockProductRepository_GetByIDForUpdate_Call) RunAndReturn(run func(context.Context, uint) (*entities.Product, error)) *MockProductRepository_GetByIDForUpdate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetByIDs provides a mock function with given fields: ctx, ids, includeDeleted
+func (_m *MockProductRepository) GetByIDs(ctx context.Context, ids []uint, includeDeleted bool) ([]*entities.Product, error) {
+	ret := _m.Called(ctx, ids, includeDeleted)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByIDs")
+	}
+
+	var r0 []*entities.Product
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []uint, bool) ([]*entities.Product, error)); ok {
+		return rf(ctx, ids, includeDeleted)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []uint, bool) []*entities.Product); ok {
+		r0 = rf(ctx, ids, includeDeleted)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entities.Product)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []uint, bool) error); ok {
+		r1 = rf(ctx, ids, includeDeleted)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockProductRepository_GetByIDs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByIDs'
+type MockProductRepository_GetByIDs_Call struct {
+	*mock.Call
+}
+
+// GetByIDs is a helper method to define mock.On call
+//   - ctx context.Context
+//   - ids []uint
+//   - includeDeleted bool
+func (_e *MockProductRepository_Expecter) GetByIDs(ctx interface{}, ids interface{}, includeDeleted interface{}) *MockProductRepository_GetByIDs_Call {
+	return &MockProductRepository_GetByIDs_Call{Call: _e.mock.On("GetByIDs", ctx, ids, includeDeleted)}
+}
+
+func (_c *MockProductRepository_GetByIDs_Call) Run(run func(ctx context.Context, ids []uint, includeDeleted bool)) *MockProductRepository_GetByIDs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]uint), args[2].(bool))
+	})
+	return _c
+}
+
+func (_c *MockProductRepository_GetByIDs_Call) Return(_a0 []*entities.Product, _a1 error) *MockProductRepository_GetByIDs_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockProductRepository_GetByIDs_Call) RunAndReturn(run func(context.Context, []uint, bool) ([]*entities.Product, error)) *MockProductRepository_GetByIDs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// LastModified provides a mock function with given fields: ctx
+func (_m *MockProductRepository) LastModified(ctx context.Context) (time.Time, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for LastModified")
+	}
+
+	var r0 time.Time
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (time.Time, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) time.Time); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(time.Time)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockProductRepository_LastModified_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LastModified'
+type MockProductRepository_LastModified_Call struct {
+	*mock.Call
+}
+
+// LastModified is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockProductRepository_Expecter) LastModified(ctx interface{}) *MockProductRepository_LastModified_Call {
+	return &MockProductRepository_LastModified_Call{Call: _e.mock.On("LastModified", ctx)}
+}
+
+func (_c *MockProductRepository_LastModified_Call) Run(run func(ctx context.Context)) *MockProductRepository_LastModified_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockProductRepository_LastModified_Call) Return(_a0 time.Time, _a1 error) *MockProductRepository_LastModified_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockProductRepository_LastModified_Call) RunAndReturn(run func(context.Context) (time.Time, error)) *MockProductRepository_LastModified_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// List provides a mock function with given fields: ctx, options
+func (_m *MockProductRepository) List(ctx context.Context, options repositories.ProductListOptions) ([]*entities.Product, int64, error) {
+	ret := _m.Called(ctx, options)
+
+	if len(ret) == 0 {
+		panic("no return value specified for List")
+	}
+
+	var r0 []*entities.Product
+	var r1 int64
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, repositories.ProductListOptions) ([]*entities.Product, int64, error)); ok {
+		return rf(ctx, options)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, repositories.ProductListOptions) []*entities.Product); ok {
+		r0 = rf(ctx, options)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entities.Product)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, repositories.ProductListOptions) int64); ok {
+		r1 = rf(ctx, options)
+	} else {
+		r1 = ret.Get(1).(int64)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, repositories.ProductListOptions) error); ok {
+		r2 = rf(ctx, options)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// MockProductRepository_List_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'List'
+type MockProductRepository_List_Call struct {
+	*mock.Call
+}
+
+// List is a helper method to define mock.On call
+//   - ctx context.Context
+//   - options repositories.ProductListOptions
+func (_e *MockProductRepository_Expecter) List(ctx interface{}, options interface{}) *MockProductRepository_List_Call {
+	return &MockProductRepository_List_Call{Call: _e.mock.On("List", ctx, options)}
+}
+
+func (_c *MockProductRepository_List_Call) Run(run func(ctx context.Context, options repositories.ProductListOptions)) *MockProductRepository_List_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(repositories.ProductListOptions))
+	})
+	return _c
+}
+
+func (_c *MockProductRepository_List_Call) Return(_a0 []*entities.Product, _a1 int64, _a2 error) *MockProductRepository_List_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *MockProductRepository_List_Call) RunAndReturn(run func(context.Context, repositories.ProductListOptions) ([]*entities.Product, int64, error)) *MockProductRepository_List_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Restore provides a mock function with given fields: ctx, id
+func (_m *MockProductRepository) Restore(ctx context.Context, id uint) error {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Restore")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockProductRepository_Restore_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Restore'
+type MockProductRepository_Restore_Call struct {
+	*mock.Call
+}
+
+// Restore is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uint
+func (_e *MockProductRepository_Expecter) Restore(ctx interface{}, id interface{}) *MockProductRepository_Restore_Call {
+	return &MockProductRepository_Restore_Call{Call: _e.mock.On("Restore", ctx, id)}
+}
+
+func (_c *MockProductRepository_Restore_Call) Run(run func(ctx context.Context, id uint)) *MockProductRepository_Restore_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint))
+	})
+	return _c
+}
+
+func (_c *MockProductRepository_Restore_Call) Return(_a0 error) *MockProductRepository_Restore_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockProductRepository_Restore_Call) RunAndReturn(run func(context.Context, uint) error) *MockProductRepository_Restore_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// Update provides a mock function with given fields: product
-func (_m *MockProductRepository) Update(product *entities.Product) error {
-	ret := _m.Called(product)
+// Update provides a mock function with given fields: ctx, product
+func (_m *MockProductRepository) Update(ctx context.Context, product *entities.Product) error {
+	ret := _m.Called(ctx, product)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Update")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(*entities.Product) error); ok {
-		r0 = rf(product)
+	if rf, ok := ret.Get(0).(func(context.Context, *entities.Product) error); ok {
+		r0 = rf(ctx, product)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -194,14 +610,15 @@ type MockProductRepository_Update_Call struct {
 }
 
 // Update is a helper method to define mock.On call
+//   - ctx context.Context
 //   - product *entities.Product
-func (_e *MockProductRepository_Expecter) Update(product interface{}) *MockProductRepository_Update_Call {
-	return &MockProductRepository_Update_Call{Call: _e.mock.On("Update", product)}
+func (_e *MockProductRepository_Expecter) Update(ctx interface{}, product interface{}) *MockProductRepository_Update_Call {
+	return &MockProductRepository_Update_Call{Call: _e.mock.On("Update", ctx, product)}
 }
 
-func (_c *MockProductRepository_Update_Call) Run(run func(product *entities.Product)) *MockProductRepository_Update_Call {
+func (_c *MockProductRepository_Update_Call) Run(run func(ctx context.Context, product *entities.Product)) *MockProductRepository_Update_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(*entities.Product))
+		run(args[0].(context.Context), args[1].(*entities.Product))
 	})
 	return _c
 }
@@ -211,7 +628,7 @@ func (_c *MockProductRepository_Update_Call) Return(_a0 error) *MockProductRepos
 	return _c
 }
 
-func (_c *MockProductRepository_Update_Call) RunAndReturn(run func(*entities.Product) error) *MockProductRepository_Update_Call {
+func (_c *MockProductRepository_Update_Call) RunAndReturn(run func(context.Context, *entities.Product) error) *MockProductRepository_Update_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -70,6 +70,154 @@ func (_c *MockProductPresenter_Present_Call) RunAndReturn(run func([]*entities.P
 	return _c
 }
 
+// PresentList provides a mock function with given fields: products, total, request, more
+func (_m *MockProductPresenter) PresentList(products []*entities.Product, total int64, request *dto.ListProductsRequestDto, more bool) *dto.ListProductsResponseDto {
+	ret := _m.Called(products, total, request, more)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PresentList")
+	}
+
+	var r0 *dto.ListProductsResponseDto
+	if rf, ok := ret.Get(0).(func([]*entities.Product, int64, *dto.ListProductsRequestDto, bool) *dto.ListProductsResponseDto); ok {
+		r0 = rf(products, total, request, more)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.ListProductsResponseDto)
+		}
+	}
+
+	return r0
+}
+
+// MockProductPresenter_PresentList_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PresentList'
+type MockProductPresenter_PresentList_Call struct {
+	*mock.Call
+}
+
+// PresentList is a helper method to define mock.On call
+//   - products []*entities.Product
+//   - total int64
+//   - request *dto.ListProductsRequestDto
+//   - more bool
+func (_e *MockProductPresenter_Expecter) PresentList(products interface{}, total interface{}, request interface{}, more interface{}) *MockProductPresenter_PresentList_Call {
+	return &MockProductPresenter_PresentList_Call{Call: _e.mock.On("PresentList", products, total, request, more)}
+}
+
+func (_c *MockProductPresenter_PresentList_Call) Run(run func(products []*entities.Product, total int64, request *dto.ListProductsRequestDto, more bool)) *MockProductPresenter_PresentList_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].([]*entities.Product), args[1].(int64), args[2].(*dto.ListProductsRequestDto), args[3].(bool))
+	})
+	return _c
+}
+
+func (_c *MockProductPresenter_PresentList_Call) Return(_a0 *dto.ListProductsResponseDto) *MockProductPresenter_PresentList_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockProductPresenter_PresentList_Call) RunAndReturn(run func([]*entities.Product, int64, *dto.ListProductsRequestDto, bool) *dto.ListProductsResponseDto) *MockProductPresenter_PresentList_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PresentLookup provides a mock function with given fields: products, missingIDs
+func (_m *MockProductPresenter) PresentLookup(products []*entities.Product, missingIDs []uint) *dto.LookupProductsResponseDto {
+	ret := _m.Called(products, missingIDs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PresentLookup")
+	}
+
+	var r0 *dto.LookupProductsResponseDto
+	if rf, ok := ret.Get(0).(func([]*entities.Product, []uint) *dto.LookupProductsResponseDto); ok {
+		r0 = rf(products, missingIDs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.LookupProductsResponseDto)
+		}
+	}
+
+	return r0
+}
+
+// MockProductPresenter_PresentLookup_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PresentLookup'
+type MockProductPresenter_PresentLookup_Call struct {
+	*mock.Call
+}
+
+// PresentLookup is a helper method to define mock.On call
+//   - products []*entities.Product
+//   - missingIDs []uint
+func (_e *MockProductPresenter_Expecter) PresentLookup(products interface{}, missingIDs interface{}) *MockProductPresenter_PresentLookup_Call {
+	return &MockProductPresenter_PresentLookup_Call{Call: _e.mock.On("PresentLookup", products, missingIDs)}
+}
+
+func (_c *MockProductPresenter_PresentLookup_Call) Run(run func(products []*entities.Product, missingIDs []uint)) *MockProductPresenter_PresentLookup_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].([]*entities.Product), args[1].([]uint))
+	})
+	return _c
+}
+
+func (_c *MockProductPresenter_PresentLookup_Call) Return(_a0 *dto.LookupProductsResponseDto) *MockProductPresenter_PresentLookup_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockProductPresenter_PresentLookup_Call) RunAndReturn(run func([]*entities.Product, []uint) *dto.LookupProductsResponseDto) *MockProductPresenter_PresentLookup_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PresentOne provides a mock function with given fields: product
+func (_m *MockProductPresenter) PresentOne(product *entities.Product) *dto.GetProductResponseDto {
+	ret := _m.Called(product)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PresentOne")
+	}
+
+	var r0 *dto.GetProductResponseDto
+	if rf, ok := ret.Get(0).(func(*entities.Product) *dto.GetProductResponseDto); ok {
+		r0 = rf(product)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.GetProductResponseDto)
+		}
+	}
+
+	return r0
+}
+
+// MockProductPresenter_PresentOne_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PresentOne'
+type MockProductPresenter_PresentOne_Call struct {
+	*mock.Call
+}
+
+// PresentOne is a helper method to define mock.On call
+//   - product *entities.Product
+func (_e *MockProductPresenter_Expecter) PresentOne(product interface{}) *MockProductPresenter_PresentOne_Call {
+	return &MockProductPresenter_PresentOne_Call{Call: _e.mock.On("PresentOne", product)}
+}
+
+func (_c *MockProductPresenter_PresentOne_Call) Run(run func(product *entities.Product)) *MockProductPresenter_PresentOne_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*entities.Product))
+	})
+	return _c
+}
+
+func (_c *MockProductPresenter_PresentOne_Call) Return(_a0 *dto.GetProductResponseDto) *MockProductPresenter_PresentOne_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockProductPresenter_PresentOne_Call) RunAndReturn(run func(*entities.Product) *dto.GetProductResponseDto) *MockProductPresenter_PresentOne_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewMockProductPresenter creates a new instance of MockProductPresenter. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewMockProductPresenter(t interface {
@@ -3,7 +3,11 @@
 package mocks
 
 import (
+	context "context"
+
+	entities "github.com/mathefer/tc-fiap-product/internal/product/domain/entities"
 	commands "github.com/mathefer/tc-fiap-product/internal/product/usecase/commands"
+
 	mock "github.com/stretchr/testify/mock"
 )
 
@@ -20,22 +24,34 @@ func (_m *MockAddProductUseCase) EXPECT() *MockAddProductUseCase_Expecter {
 	return &MockAddProductUseCase_Expecter{mock: &_m.Mock}
 }
 
-// Execute provides a mock function with given fields: command
-func (_m *MockAddProductUseCase) Execute(command *commands.AddProductCommand) error {
-	ret := _m.Called(command)
+// Execute provides a mock function with given fields: ctx, command
+func (_m *MockAddProductUseCase) Execute(ctx context.Context, command *commands.AddProductCommand) (*entities.Product, error) {
+	ret := _m.Called(ctx, command)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Execute")
 	}
 
-	var r0 error
-	if rf, ok := ret.Get(0).(func(*commands.AddProductCommand) error); ok {
-		r0 = rf(command)
+	var r0 *entities.Product
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *commands.AddProductCommand) (*entities.Product, error)); ok {
+		return rf(ctx, command)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *commands.AddProductCommand) *entities.Product); ok {
+		r0 = rf(ctx, command)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.Product)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *commands.AddProductCommand) error); ok {
+		r1 = rf(ctx, command)
 	} else {
-		r0 = ret.Error(0)
+		r1 = ret.Error(1)
 	}
 
-	return r0
+	return r0, r1
 }
 
 // MockAddProductUseCase_Execute_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Execute'
@@ -44,24 +60,25 @@ type MockAddProductUseCase_Execute_Call struct {
 }
 
 // Execute is a helper method to define mock.On call
+//   - ctx context.Context
 //   - command *commands.AddProductCommand
-func (_e *MockAddProductUseCase_Expecter) Execute(command interface{}) *MockAddProductUseCase_Execute_Call {
-	return &MockAddProductUseCase_Execute_Call{Call: _e.mock.On("Execute", command)}
+func (_e *MockAddProductUseCase_Expecter) Execute(ctx interface{}, command interface{}) *MockAddProductUseCase_Execute_Call {
+	return &MockAddProductUseCase_Execute_Call{Call: _e.mock.On("Execute", ctx, command)}
 }
 
-func (_c *MockAddProductUseCase_Execute_Call) Run(run func(command *commands.AddProductCommand)) *MockAddProductUseCase_Execute_Call {
+func (_c *MockAddProductUseCase_Execute_Call) Run(run func(ctx context.Context, command *commands.AddProductCommand)) *MockAddProductUseCase_Execute_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(*commands.AddProductCommand))
+		run(args[0].(context.Context), args[1].(*commands.AddProductCommand))
 	})
 	return _c
 }
 
-func (_c *MockAddProductUseCase_Execute_Call) Return(_a0 error) *MockAddProductUseCase_Execute_Call {
-	_c.Call.Return(_a0)
+func (_c *MockAddProductUseCase_Execute_Call) Return(_a0 *entities.Product, _a1 error) *MockAddProductUseCase_Execute_Call {
+	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockAddProductUseCase_Execute_Call) RunAndReturn(run func(*commands.AddProductCommand) error) *MockAddProductUseCase_Execute_Call {
+func (_c *MockAddProductUseCase_Execute_Call) RunAndReturn(run func(context.Context, *commands.AddProductCommand) (*entities.Product, error)) *MockAddProductUseCase_Execute_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -3,8 +3,9 @@
 package mocks
 
 import (
-	commands "github.com/mathefer/tc-fiap-product/internal/product/usecase/commands"
+	context "context"
 
+	commands "github.com/mathefer/tc-fiap-product/internal/product/usecase/commands"
 	mock "github.com/stretchr/testify/mock"
 )
 
@@ -21,17 +22,17 @@ func (_m *MockDeleteProductUseCase) EXPECT() *MockDeleteProductUseCase_Expecter
 	return &MockDeleteProductUseCase_Expecter{mock: &_m.Mock}
 }
 
-// Execute provides a mock function with given fields: command
-func (_m *MockDeleteProductUseCase) Execute(command *commands.DeleteProductCommand) error {
-	ret := _m.Called(command)
+// Execute provides a mock function with given fields: ctx, command
+func (_m *MockDeleteProductUseCase) Execute(ctx context.Context, command *commands.DeleteProductCommand) error {
+	ret := _m.Called(ctx, command)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Execute")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(*commands.DeleteProductCommand) error); ok {
-		r0 = rf(command)
+	if rf, ok := ret.Get(0).(func(context.Context, *commands.DeleteProductCommand) error); ok {
+		r0 = rf(ctx, command)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -45,14 +46,15 @@ type MockDeleteProductUseCase_Execute_Call struct {
 }
 
 // Execute is a helper method to define mock.On call
+//   - ctx context.Context
 //   - command *commands.DeleteProductCommand
-func (_e *MockDeleteProductUseCase_Expecter) Execute(command interface{}) *MockDeleteProductUseCase_Execute_Call {
-	return &MockDeleteProductUseCase_Execute_Call{Call: _e.mock.On("Execute", command)}
+func (_e *MockDeleteProductUseCase_Expecter) Execute(ctx interface{}, command interface{}) *MockDeleteProductUseCase_Execute_Call {
+	return &MockDeleteProductUseCase_Execute_Call{Call: _e.mock.On("Execute", ctx, command)}
 }
 
-func (_c *MockDeleteProductUseCase_Execute_Call) Run(run func(command *commands.DeleteProductCommand)) *MockDeleteProductUseCase_Execute_Call {
+func (_c *MockDeleteProductUseCase_Execute_Call) Run(run func(ctx context.Context, command *commands.DeleteProductCommand)) *MockDeleteProductUseCase_Execute_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(*commands.DeleteProductCommand))
+		run(args[0].(context.Context), args[1].(*commands.DeleteProductCommand))
 	})
 	return _c
 }
@@ -62,7 +64,7 @@ func (_c *MockDeleteProductUseCase_Execute_Call) Return(_a0 error) *MockDeletePr
 	return _c
 }
 
-func (_c *MockDeleteProductUseCase_Execute_Call) RunAndReturn(run func(*commands.DeleteProductCommand) error) *MockDeleteProductUseCase_Execute_Call {
+func (_c *MockDeleteProductUseCase_Execute_Call) RunAndReturn(run func(context.Context, *commands.DeleteProductCommand) error) *MockDeleteProductUseCase_Execute_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -3,6 +3,8 @@
 package mocks
 
 import (
+	context "context"
+
 	entities "github.com/mathefer/tc-fiap-product/internal/product/domain/entities"
 	commands "github.com/mathefer/tc-fiap-product/internal/product/usecase/commands"
 
@@ -22,9 +24,9 @@ func (_m *MockGetProductUseCase) EXPECT() *MockGetProductUseCase_Expecter {
 	return &MockGetProductUseCase_Expecter{mock: &_m.Mock}
 }
 
-// Execute provides a mock function with given fields: command
-func (_m *MockGetProductUseCase) Execute(command *commands.GetProductCommand) ([]*entities.Product, error) {
-	ret := _m.Called(command)
+// Execute provides a mock function with given fields: ctx, command
+func (_m *MockGetProductUseCase) Execute(ctx context.Context, command *commands.GetProductCommand) ([]*entities.Product, error) {
+	ret := _m.Called(ctx, command)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Execute")
@@ -32,19 +34,19 @@ func (_m *MockGetProductUseCase) Execute(command *commands.GetProductCommand) ([
 
 	var r0 []*entities.Product
 	var r1 error
-	if rf, ok := ret.Get(0).(func(*commands.GetProductCommand) ([]*entities.Product, error)); ok {
-		return rf(command)
+	if rf, ok := ret.Get(0).(func(context.Context, *commands.GetProductCommand) ([]*entities.Product, error)); ok {
+		return rf(ctx, command)
 	}
-	if rf, ok := ret.Get(0).(func(*commands.GetProductCommand) []*entities.Product); ok {
-		r0 = rf(command)
+	if rf, ok := ret.Get(0).(func(context.Context, *commands.GetProductCommand) []*entities.Product); ok {
+		r0 = rf(ctx, command)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*entities.Product)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(*commands.GetProductCommand) error); ok {
-		r1 = rf(command)
+	if rf, ok := ret.Get(1).(func(context.Context, *commands.GetProductCommand) error); ok {
+		r1 = rf(ctx, command)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -58,14 +60,15 @@ type MockGetProductUseCase_Execute_Call struct {
 }
 
 // Execute is a helper method to define mock.On call
+//   - ctx context.Context
 //   - command *commands.GetProductCommand
-func (_e *MockGetProductUseCase_Expecter) Execute(command interface{}) *MockGetProductUseCase_Execute_Call {
-	return &MockGetProductUseCase_Execute_Call{Call: _e.mock.On("Execute", command)}
+func (_e *MockGetProductUseCase_Expecter) Execute(ctx interface{}, command interface{}) *MockGetProductUseCase_Execute_Call {
+	return &MockGetProductUseCase_Execute_Call{Call: _e.mock.On("Execute", ctx, command)}
 }
 
-func (_c *MockGetProductUseCase_Execute_Call) Run(run func(command *commands.GetProductCommand)) *MockGetProductUseCase_Execute_Call {
+func (_c *MockGetProductUseCase_Execute_Call) Run(run func(ctx context.Context, command *commands.GetProductCommand)) *MockGetProductUseCase_Execute_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(*commands.GetProductCommand))
+		run(args[0].(context.Context), args[1].(*commands.GetProductCommand))
 	})
 	return _c
 }
@@ -75,7 +78,7 @@ func (_c *MockGetProductUseCase_Execute_Call) Return(_a0 []*entities.Product, _a
 	return _c
 }
 
-func (_c *MockGetProductUseCase_Execute_Call) RunAndReturn(run func(*commands.GetProductCommand) ([]*entities.Product, error)) *MockGetProductUseCase_Execute_Call {
+func (_c *MockGetProductUseCase_Execute_Call) RunAndReturn(run func(context.Context, *commands.GetProductCommand) ([]*entities.Product, error)) *MockGetProductUseCase_Execute_Call {
 	_c.Call.Return(run)
 	return _c
 }
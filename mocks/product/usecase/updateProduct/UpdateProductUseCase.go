@@ -3,7 +3,11 @@
 package mocks
 
 import (
+	context "context"
+
+	entities "github.com/mathefer/tc-fiap-product/internal/product/domain/entities"
 	commands "github.com/mathefer/tc-fiap-product/internal/product/usecase/commands"
+
 	mock "github.com/stretchr/testify/mock"
 )
 
@@ -20,22 +24,34 @@ func (_m *MockUpdateProductUseCase) EXPECT() *MockUpdateProductUseCase_Expecter
 	return &MockUpdateProductUseCase_Expecter{mock: &_m.Mock}
 }
 
-// Execute provides a mock function with given fields: command
-func (_m *MockUpdateProductUseCase) Execute(command *commands.UpdateProductCommand) error {
-	ret := _m.Called(command)
+// Execute provides a mock function with given fields: ctx, command
+func (_m *MockUpdateProductUseCase) Execute(ctx context.Context, command *commands.UpdateProductCommand) (*entities.Product, error) {
+	ret := _m.Called(ctx, command)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Execute")
 	}
 
-	var r0 error
-	if rf, ok := ret.Get(0).(func(*commands.UpdateProductCommand) error); ok {
-		r0 = rf(command)
+	var r0 *entities.Product
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *commands.UpdateProductCommand) (*entities.Product, error)); ok {
+		return rf(ctx, command)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *commands.UpdateProductCommand) *entities.Product); ok {
+		r0 = rf(ctx, command)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.Product)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *commands.UpdateProductCommand) error); ok {
+		r1 = rf(ctx, command)
 	} else {
-		r0 = ret.Error(0)
+		r1 = ret.Error(1)
 	}
 
-	return r0
+	return r0, r1
 }
 
 // MockUpdateProductUseCase_Execute_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Execute'
@@ -44,24 +60,25 @@ type MockUpdateProductUseCase_Execute_Call struct {
 }
 
 // Execute is a helper method to define mock.On call
+//   - ctx context.Context
 //   - command *commands.UpdateProductCommand
-func (_e *MockUpdateProductUseCase_Expecter) Execute(command interface{}) *MockUpdateProductUseCase_Execute_Call {
-	return &MockUpdateProductUseCase_Execute_Call{Call: _e.mock.On("Execute", command)}
+func (_e *MockUpdateProductUseCase_Expecter) Execute(ctx interface{}, command interface{}) *MockUpdateProductUseCase_Execute_Call {
+	return &MockUpdateProductUseCase_Execute_Call{Call: _e.mock.On("Execute", ctx, command)}
 }
 
-func (_c *MockUpdateProductUseCase_Execute_Call) Run(run func(command *commands.UpdateProductCommand)) *MockUpdateProductUseCase_Execute_Call {
+func (_c *MockUpdateProductUseCase_Execute_Call) Run(run func(ctx context.Context, command *commands.UpdateProductCommand)) *MockUpdateProductUseCase_Execute_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(*commands.UpdateProductCommand))
+		run(args[0].(context.Context), args[1].(*commands.UpdateProductCommand))
 	})
 	return _c
 }
 
-func (_c *MockUpdateProductUseCase_Execute_Call) Return(_a0 error) *MockUpdateProductUseCase_Execute_Call {
-	_c.Call.Return(_a0)
+func (_c *MockUpdateProductUseCase_Execute_Call) Return(_a0 *entities.Product, _a1 error) *MockUpdateProductUseCase_Execute_Call {
+	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockUpdateProductUseCase_Execute_Call) RunAndReturn(run func(*commands.UpdateProductCommand) error) *MockUpdateProductUseCase_Execute_Call {
+func (_c *MockUpdateProductUseCase_Execute_Call) RunAndReturn(run func(context.Context, *commands.UpdateProductCommand) (*entities.Product, error)) *MockUpdateProductUseCase_Execute_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -0,0 +1,20 @@
+// Package cache stores encoded values under string keys for a limited time,
+// either in process memory or in a Redis-compatible server shared by every
+// replica.
+package cache
+
+import (
+	"context"
+	"time"
+)
+
+// Cache is a key-value store whose entries expire. Implementations are safe
+// for concurrent use.
+type Cache interface {
+	// Get returns the value stored under key and whether there was one.
+	Get(ctx context.Context, key string) ([]byte, bool, error)
+	// Set stores value under key for ttl.
+	Set(ctx context.Context, key string, value []byte, ttl time.Duration) error
+	// Delete removes the given keys; missing keys are ignored.
+	Delete(ctx context.Context, keys ...string) error
+}
@@ -0,0 +1,100 @@
+package cache
+
+import (
+	"container/list"
+	"context"
+	"sync"
+	"time"
+)
+
+var _ Cache = (*LRU)(nil)
+
+// LRU is a Cache held in process memory. Once it holds maxEntries entries,
+// storing another evicts the least recently used one. Expired entries are
+// dropped when they are read or evicted.
+type LRU struct {
+	mu         sync.Mutex
+	maxEntries int
+	entries    map[string]*list.Element
+	// order holds the entries from the most to the least recently used.
+	order *list.List
+	now   func() time.Time
+}
+
+type lruEntry struct {
+	key       string
+	value     []byte
+	expiresAt time.Time
+}
+
+func NewLRU(maxEntries int) *LRU {
+	return &LRU{
+		maxEntries: maxEntries,
+		entries:    make(map[string]*list.Element),
+		order:      list.New(),
+		now:        time.Now,
+	}
+}
+
+func (c *LRU) Get(ctx context.Context, key string) ([]byte, bool, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	element, ok := c.entries[key]
+	if !ok {
+		return nil, false, nil
+	}
+	entry := element.Value.(*lruEntry)
+	if !c.now().Before(entry.expiresAt) {
+		c.remove(element)
+		return nil, false, nil
+	}
+	c.order.MoveToFront(element)
+	return entry.value, true, nil
+}
+
+func (c *LRU) Set(ctx context.Context, key string, value []byte, ttl time.Duration) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	expiresAt := c.now().Add(ttl)
+	if element, ok := c.entries[key]; ok {
+		entry := element.Value.(*lruEntry)
+		entry.value = value
+		entry.expiresAt = expiresAt
+		c.order.MoveToFront(element)
+		return nil
+	}
+
+	c.entries[key] = c.order.PushFront(&lruEntry{key: key, value: value, expiresAt: expiresAt})
+	for c.order.Len() > c.maxEntries {
+		c.remove(c.order.Back())
+	}
+	return nil
+}
+
+func (c *LRU) Delete(ctx context.Context, keys ...string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for _, key := range keys {
+		if element, ok := c.entries[key]; ok {
+			c.remove(element)
+		}
+	}
+	return nil
+}
+
+// Len returns the number of entries held, including expired ones that have
+// not been dropped yet.
+func (c *LRU) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.order.Len()
+}
+
+// remove drops element. The caller must hold the lock.
+func (c *LRU) remove(element *list.Element) {
+	c.order.Remove(element)
+	delete(c.entries, element.Value.(*lruEntry).key)
+}
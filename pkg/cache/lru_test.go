@@ -0,0 +1,112 @@
+package cache
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// fakeClock returns a controllable time, starting at an arbitrary instant.
+type fakeClock struct {
+	now time.Time
+}
+
+func (c *fakeClock) Now() time.Time {
+	return c.now
+}
+
+func newTestLRU(maxEntries int) (*LRU, *fakeClock) {
+	clock := &fakeClock{now: time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)}
+	lru := NewLRU(maxEntries)
+	lru.now = clock.Now
+	return lru, clock
+}
+
+func TestLRU_SetAndGet(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	lru, _ := newTestLRU(2)
+
+	// Act
+	assert.NoError(t, lru.Set(ctx, "a", []byte("1"), time.Minute))
+	value, ok, err := lru.Get(ctx, "a")
+	_, missing, _ := lru.Get(ctx, "b")
+
+	// Assert
+	assert.NoError(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, []byte("1"), value)
+	assert.False(t, missing)
+}
+
+func TestLRU_Expires(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	lru, clock := newTestLRU(2)
+	assert.NoError(t, lru.Set(ctx, "a", []byte("1"), time.Minute))
+
+	// Act
+	clock.now = clock.now.Add(time.Minute)
+	_, ok, err := lru.Get(ctx, "a")
+
+	// Assert - the expired entry is dropped
+	assert.NoError(t, err)
+	assert.False(t, ok)
+	assert.Zero(t, lru.Len())
+}
+
+func TestLRU_EvictsLeastRecentlyUsed(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	lru, _ := newTestLRU(2)
+	assert.NoError(t, lru.Set(ctx, "a", []byte("1"), time.Minute))
+	assert.NoError(t, lru.Set(ctx, "b", []byte("2"), time.Minute))
+	_, _, _ = lru.Get(ctx, "a")
+
+	// Act
+	assert.NoError(t, lru.Set(ctx, "c", []byte("3"), time.Minute))
+
+	// Assert - b was used least recently
+	_, a, _ := lru.Get(ctx, "a")
+	_, b, _ := lru.Get(ctx, "b")
+	_, c, _ := lru.Get(ctx, "c")
+	assert.True(t, a)
+	assert.False(t, b)
+	assert.True(t, c)
+	assert.Equal(t, 2, lru.Len())
+}
+
+func TestLRU_SetReplacesAndRefreshes(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	lru, clock := newTestLRU(2)
+	assert.NoError(t, lru.Set(ctx, "a", []byte("1"), time.Minute))
+
+	// Act
+	clock.now = clock.now.Add(30 * time.Second)
+	assert.NoError(t, lru.Set(ctx, "a", []byte("2"), time.Minute))
+	clock.now = clock.now.Add(45 * time.Second)
+	value, ok, _ := lru.Get(ctx, "a")
+
+	// Assert
+	assert.True(t, ok)
+	assert.Equal(t, []byte("2"), value)
+	assert.Equal(t, 1, lru.Len())
+}
+
+func TestLRU_Delete(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	lru, _ := newTestLRU(2)
+	assert.NoError(t, lru.Set(ctx, "a", []byte("1"), time.Minute))
+
+	// Act
+	err := lru.Delete(ctx, "a", "missing")
+
+	// Assert
+	assert.NoError(t, err)
+	_, ok, _ := lru.Get(ctx, "a")
+	assert.False(t, ok)
+}
@@ -0,0 +1,84 @@
+package cache
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+var _ Cache = (*Redis)(nil)
+
+const (
+	// maxIdleConns bounds the connections a Redis keeps open between
+	// commands.
+	maxIdleConns = 8
+	// maxRetries is how often a command that failed on a broken connection,
+	// e.g. one the server closed while it sat in the pool, is sent again on
+	// a fresh one.
+	maxRetries = 1
+)
+
+// RedisOptions describes how to reach a Redis-compatible server.
+type RedisOptions struct {
+	// Addr is the host:port of the server.
+	Addr     string
+	Password string
+	DB       int
+	// Timeout bounds dialing and each attempt to send a command and read its
+	// reply. Context deadlines do not change it, whether earlier or later;
+	// cancelling the context only stops waiting for a pooled connection.
+	Timeout time.Duration
+}
+
+// Redis is a Cache kept in a Redis-compatible server, so that every replica
+// of the service sees the same entries.
+type Redis struct {
+	client *redis.Client
+}
+
+func NewRedis(options RedisOptions) *Redis {
+	return &Redis{client: redis.NewClient(&redis.Options{
+		Addr:         options.Addr,
+		Password:     options.Password,
+		DB:           options.DB,
+		DialTimeout:  options.Timeout,
+		ReadTimeout:  options.Timeout,
+		WriteTimeout: options.Timeout,
+		MaxIdleConns: maxIdleConns,
+		MaxRetries:   maxRetries,
+	})}
+}
+
+func (r *Redis) Get(ctx context.Context, key string) ([]byte, bool, error) {
+	value, err := r.client.Get(ctx, key).Bytes()
+	if errors.Is(err, redis.Nil) {
+		return nil, false, nil
+	}
+	if err != nil {
+		return nil, false, err
+	}
+	return value, true, nil
+}
+
+func (r *Redis) Set(ctx context.Context, key string, value []byte, ttl time.Duration) error {
+	return r.client.Set(ctx, key, value, ttl).Err()
+}
+
+func (r *Redis) Delete(ctx context.Context, keys ...string) error {
+	if len(keys) == 0 {
+		return nil
+	}
+	return r.client.Del(ctx, keys...).Err()
+}
+
+// Ping checks that the server answers.
+func (r *Redis) Ping(ctx context.Context) error {
+	return r.client.Ping(ctx).Err()
+}
+
+// Close closes every connection to the server.
+func (r *Redis) Close() error {
+	return r.client.Close()
+}
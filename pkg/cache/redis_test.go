@@ -0,0 +1,100 @@
+package cache
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRedis_SetGetDelete(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	server := miniredis.RunT(t)
+	redis := NewRedis(RedisOptions{Addr: server.Addr(), Timeout: time.Second})
+	defer redis.Close()
+
+	// Act
+	setErr := redis.Set(ctx, "product:id:1", []byte(`{"ID":1}`), 30*time.Second)
+	ttl := server.TTL("product:id:1")
+	value, found, getErr := redis.Get(ctx, "product:id:1")
+	deleteErr := redis.Delete(ctx, "product:id:1", "product:id:2")
+	_, foundAfterDelete, _ := redis.Get(ctx, "product:id:1")
+
+	// Assert
+	assert.NoError(t, setErr)
+	assert.NoError(t, getErr)
+	assert.NoError(t, deleteErr)
+	assert.Equal(t, 30*time.Second, ttl)
+	assert.True(t, found)
+	assert.Equal(t, []byte(`{"ID":1}`), value)
+	assert.False(t, foundAfterDelete)
+}
+
+func TestRedis_AuthenticatesAndSelectsDatabase(t *testing.T) {
+	// Arrange
+	server := miniredis.RunT(t)
+	server.RequireAuth("secret")
+	redis := NewRedis(RedisOptions{Addr: server.Addr(), Password: "secret", DB: 2, Timeout: time.Second})
+	defer redis.Close()
+
+	// Act
+	err := redis.Set(context.Background(), "product:id:1", []byte("1"), time.Minute)
+
+	// Assert
+	assert.NoError(t, err)
+	value, getErr := server.DB(2).Get("product:id:1")
+	require.NoError(t, getErr)
+	assert.Equal(t, "1", value)
+}
+
+func TestRedis_WrongPassword(t *testing.T) {
+	// Arrange
+	server := miniredis.RunT(t)
+	server.RequireAuth("secret")
+	redis := NewRedis(RedisOptions{Addr: server.Addr(), Password: "wrong", Timeout: time.Second})
+	defer redis.Close()
+
+	// Act
+	err := redis.Ping(context.Background())
+
+	// Assert
+	assert.ErrorContains(t, err, "WRONGPASS")
+}
+
+func TestRedis_DeleteAfterServerClosedConnection(t *testing.T) {
+	// Arrange - the pooled connection is closed by a server restart, and the
+	// invalidation must still reach the server
+	ctx := context.Background()
+	server := miniredis.RunT(t)
+	redis := NewRedis(RedisOptions{Addr: server.Addr(), Timeout: time.Second})
+	defer redis.Close()
+	require.NoError(t, redis.Set(ctx, "product:id:1", []byte("1"), time.Minute))
+	server.Close()
+	require.NoError(t, server.Restart())
+
+	// Act
+	err := redis.Delete(ctx, "product:id:1")
+
+	// Assert
+	assert.NoError(t, err)
+	assert.False(t, server.Exists("product:id:1"))
+}
+
+func TestRedis_Unreachable(t *testing.T) {
+	// Arrange
+	server := miniredis.RunT(t)
+	addr := server.Addr()
+	server.Close()
+	redis := NewRedis(RedisOptions{Addr: addr, Timeout: time.Second})
+	defer redis.Close()
+
+	// Act
+	_, _, err := redis.Get(context.Background(), "product:id:1")
+
+	// Assert
+	assert.Error(t, err)
+}
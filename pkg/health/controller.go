@@ -0,0 +1,55 @@
+package health
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+)
+
+type Controller struct {
+	readiness *Readiness
+}
+
+func NewController(readiness *Readiness) *Controller {
+	return &Controller{readiness: readiness}
+}
+
+func (c *Controller) RegisterRoutes(r chi.Router) {
+	r.Get("/healthz", c.Liveness)
+	r.Get("/readyz", c.Readiness)
+}
+
+// @Summary     Liveness probe
+// @Description Reports that the process is running. It never checks dependencies.
+// @Tags        Health
+// @Produce     json
+// @Success     200  {object} health.Report
+// @Router      /healthz [get]
+func (c *Controller) Liveness(w http.ResponseWriter, r *http.Request) {
+	writeReport(w, http.StatusOK, Report{Status: StatusOK})
+}
+
+// @Summary     Readiness probe
+// @Description Reports whether the service can take traffic: the database answers, migrations are applied and the service is not shutting down.
+// @Tags        Health
+// @Produce     json
+// @Success     200  {object} health.Report
+// @Failure     503  {object} health.Report
+// @Router      /readyz [get]
+func (c *Controller) Readiness(w http.ResponseWriter, r *http.Request) {
+	report := c.readiness.Run(r.Context())
+
+	status := http.StatusOK
+	if report.Status != StatusOK {
+		status = http.StatusServiceUnavailable
+	}
+	writeReport(w, status, report)
+}
+
+func writeReport(w http.ResponseWriter, status int, report Report) {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "no-store")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(report)
+}
@@ -0,0 +1,67 @@
+package health_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/mathefer/tc-fiap-product/pkg/health"
+	"github.com/stretchr/testify/assert"
+)
+
+func newRouter(readiness *health.Readiness) *chi.Mux {
+	router := chi.NewRouter()
+	health.NewController(readiness).RegisterRoutes(router)
+	return router
+}
+
+func TestController_Liveness(t *testing.T) {
+	// Arrange
+	failing := health.NewReadiness(health.Check{Name: "database", Run: func(ctx context.Context) error { return errors.New("down") }})
+	req := httptest.NewRequest(http.MethodGet, "/healthz", nil)
+	w := httptest.NewRecorder()
+
+	// Act
+	newRouter(failing).ServeHTTP(w, req)
+
+	// Assert - liveness does not depend on the database
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "application/json", w.Header().Get("Content-Type"))
+	assert.JSONEq(t, `{"status":"ok"}`, w.Body.String())
+}
+
+func TestController_Readiness_Ready(t *testing.T) {
+	// Arrange
+	readiness := health.NewReadiness(health.Check{Name: "database", Run: passing})
+	req := httptest.NewRequest(http.MethodGet, "/readyz", nil)
+	w := httptest.NewRecorder()
+
+	// Act
+	newRouter(readiness).ServeHTTP(w, req)
+
+	// Assert
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.JSONEq(t, `{"status":"ok","checks":{"database":{"status":"ok"},"draining":{"status":"ok"}}}`, w.Body.String())
+}
+
+func TestController_Readiness_NotReady(t *testing.T) {
+	// Arrange
+	readiness := health.NewReadiness(health.Check{Name: "database", Run: func(ctx context.Context) error { return errors.New("down") }})
+	req := httptest.NewRequest(http.MethodGet, "/readyz", nil)
+	w := httptest.NewRecorder()
+
+	// Act
+	newRouter(readiness).ServeHTTP(w, req)
+
+	// Assert
+	assert.Equal(t, http.StatusServiceUnavailable, w.Code)
+
+	var report health.Report
+	assert.NoError(t, json.NewDecoder(w.Body).Decode(&report))
+	assert.Equal(t, health.StatusFail, report.Status)
+	assert.Equal(t, "down", report.Checks["database"].Error)
+}
@@ -0,0 +1,93 @@
+package health
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+const (
+	StatusOK   = "ok"
+	StatusFail = "fail"
+
+	// checkTimeout bounds the readiness checks, which run in parallel, so a
+	// hung dependency cannot stall the probe past the kubelet timeout.
+	checkTimeout = 2 * time.Second
+)
+
+// Check is a single named readiness check. Run returns nil when the
+// dependency is usable.
+type Check struct {
+	Name string
+	Run  func(ctx context.Context) error
+}
+
+// CheckResult is the outcome of one check.
+type CheckResult struct {
+	Status string `json:"status"`
+	Error  string `json:"error,omitempty"`
+}
+
+// Report is the body returned by the health endpoints.
+type Report struct {
+	Status string                 `json:"status"`
+	Checks map[string]CheckResult `json:"checks,omitempty"`
+}
+
+// Readiness runs the readiness checks and tracks whether the service is
+// draining, i.e. shutting down and no longer wanting new traffic.
+type Readiness struct {
+	checks   []Check
+	draining atomic.Bool
+}
+
+func NewReadiness(checks ...Check) *Readiness {
+	return &Readiness{checks: checks}
+}
+
+// SetDraining marks the service as shutting down so that readiness fails
+// and the load balancer stops routing new requests to it.
+func (r *Readiness) SetDraining(draining bool) {
+	r.draining.Store(draining)
+}
+
+// Run executes every check in parallel and reports StatusOK only when all
+// of them pass and the service is not draining.
+func (r *Readiness) Run(ctx context.Context) Report {
+	report := Report{Status: StatusOK, Checks: make(map[string]CheckResult, len(r.checks)+1)}
+
+	if r.draining.Load() {
+		report.Status = StatusFail
+		report.Checks["draining"] = CheckResult{Status: StatusFail, Error: "service is shutting down"}
+	} else {
+		report.Checks["draining"] = CheckResult{Status: StatusOK}
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, checkTimeout)
+	defer cancel()
+
+	var (
+		wg sync.WaitGroup
+		mu sync.Mutex
+	)
+	for _, check := range r.checks {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			err := check.Run(ctx)
+
+			mu.Lock()
+			defer mu.Unlock()
+			if err != nil {
+				report.Status = StatusFail
+				report.Checks[check.Name] = CheckResult{Status: StatusFail, Error: err.Error()}
+				return
+			}
+			report.Checks[check.Name] = CheckResult{Status: StatusOK}
+		}()
+	}
+	wg.Wait()
+
+	return report
+}
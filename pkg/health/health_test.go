@@ -0,0 +1,97 @@
+package health_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/mathefer/tc-fiap-product/pkg/health"
+	"github.com/stretchr/testify/assert"
+)
+
+func passing(ctx context.Context) error { return nil }
+
+func TestReadiness_Run_AllChecksPass(t *testing.T) {
+	// Arrange
+	readiness := health.NewReadiness(health.Check{Name: "database", Run: passing})
+
+	// Act
+	report := readiness.Run(context.Background())
+
+	// Assert
+	assert.Equal(t, health.StatusOK, report.Status)
+	assert.Equal(t, health.CheckResult{Status: health.StatusOK}, report.Checks["database"])
+	assert.Equal(t, health.CheckResult{Status: health.StatusOK}, report.Checks["draining"])
+}
+
+func TestReadiness_Run_FailingCheck(t *testing.T) {
+	// Arrange
+	readiness := health.NewReadiness(
+		health.Check{Name: "database", Run: func(ctx context.Context) error { return errors.New("connection refused") }},
+		health.Check{Name: "migrations", Run: passing},
+	)
+
+	// Act
+	report := readiness.Run(context.Background())
+
+	// Assert
+	assert.Equal(t, health.StatusFail, report.Status)
+	assert.Equal(t, health.CheckResult{Status: health.StatusFail, Error: "connection refused"}, report.Checks["database"])
+	assert.Equal(t, health.StatusOK, report.Checks["migrations"].Status)
+}
+
+func TestReadiness_Run_Draining(t *testing.T) {
+	// Arrange
+	readiness := health.NewReadiness(health.Check{Name: "database", Run: passing})
+	readiness.SetDraining(true)
+
+	// Act
+	report := readiness.Run(context.Background())
+
+	// Assert
+	assert.Equal(t, health.StatusFail, report.Status)
+	assert.Equal(t, health.StatusFail, report.Checks["draining"].Status)
+	assert.Equal(t, health.StatusOK, report.Checks["database"].Status)
+}
+
+func TestReadiness_Run_ChecksHaveDeadline(t *testing.T) {
+	// Arrange
+	var hasDeadline bool
+	readiness := health.NewReadiness(health.Check{Name: "database", Run: func(ctx context.Context) error {
+		_, hasDeadline = ctx.Deadline()
+		return nil
+	}})
+
+	// Act
+	readiness.Run(context.Background())
+
+	// Assert
+	assert.True(t, hasDeadline)
+}
+
+func TestReadiness_Run_ChecksRunInParallel(t *testing.T) {
+	// Arrange - each check only returns once the other one has started
+	started := make(chan struct{}, 2)
+	waitForOther := func(ctx context.Context) error {
+		started <- struct{}{}
+		for len(started) < 2 {
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-time.After(time.Millisecond):
+			}
+		}
+		return nil
+	}
+	readiness := health.NewReadiness(
+		health.Check{Name: "database", Run: waitForOther},
+		health.Check{Name: "migrations", Run: waitForOther},
+	)
+
+	// Act
+	report := readiness.Run(context.Background())
+
+	// Assert
+	assert.Equal(t, health.StatusOK, report.Status)
+}
@@ -0,0 +1,21 @@
+package logging
+
+import (
+	"log/slog"
+	"time"
+
+	gormlogger "gorm.io/gorm/logger"
+)
+
+// slowQueryThreshold is the duration above which GORM logs a query.
+const slowQueryThreshold = 200 * time.Millisecond
+
+// NewGormLogger routes GORM's own messages (slow queries, errors) to logger
+// at warn level.
+func NewGormLogger(logger *slog.Logger) gormlogger.Interface {
+	return gormlogger.New(slog.NewLogLogger(logger.Handler(), slog.LevelWarn), gormlogger.Config{
+		SlowThreshold:             slowQueryThreshold,
+		LogLevel:                  gormlogger.Warn,
+		IgnoreRecordNotFoundError: true,
+	})
+}
@@ -0,0 +1,73 @@
+// Package logging provides the service's structured JSON logger. Log calls
+// made with a request context automatically carry the request ID, the chi
+// route, the trace and span IDs and any attributes added with
+// ContextWithAttrs, such as the product ID.
+package logging
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"os"
+
+	"github.com/go-chi/chi/v5"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// NewLogger writes JSON lines at level or above to stdout.
+func NewLogger(level slog.Leveler) *slog.Logger {
+	return New(os.Stdout, level)
+}
+
+// New writes JSON lines at level or above to w.
+func New(w io.Writer, level slog.Leveler) *slog.Logger {
+	return slog.New(&contextHandler{Handler: slog.NewJSONHandler(w, &slog.HandlerOptions{Level: level})})
+}
+
+// NewNop discards every log line. It is meant for tests.
+func NewNop() *slog.Logger {
+	return slog.New(slog.DiscardHandler)
+}
+
+type attrsKey struct{}
+
+// ContextWithAttrs returns a copy of ctx whose log lines also carry attrs.
+func ContextWithAttrs(ctx context.Context, attrs ...slog.Attr) context.Context {
+	existing, _ := ctx.Value(attrsKey{}).([]slog.Attr)
+	merged := make([]slog.Attr, 0, len(existing)+len(attrs))
+	merged = append(merged, existing...)
+	merged = append(merged, attrs...)
+	return context.WithValue(ctx, attrsKey{}, merged)
+}
+
+// contextHandler adds the request attributes found in the context to every
+// record before handing it to the JSON handler.
+type contextHandler struct {
+	slog.Handler
+}
+
+func (h *contextHandler) Handle(ctx context.Context, record slog.Record) error {
+	if ctx != nil {
+		if id := RequestIDFromContext(ctx); id != "" {
+			record.AddAttrs(slog.String("request_id", id))
+		}
+		if routeCtx := chi.RouteContext(ctx); routeCtx != nil && routeCtx.RoutePattern() != "" {
+			record.AddAttrs(slog.String("route", routeCtx.RoutePattern()))
+		}
+		if sc := trace.SpanContextFromContext(ctx); sc.IsValid() {
+			record.AddAttrs(slog.String("trace_id", sc.TraceID().String()), slog.String("span_id", sc.SpanID().String()))
+		}
+		if attrs, ok := ctx.Value(attrsKey{}).([]slog.Attr); ok {
+			record.AddAttrs(attrs...)
+		}
+	}
+	return h.Handler.Handle(ctx, record)
+}
+
+func (h *contextHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	return &contextHandler{Handler: h.Handler.WithAttrs(attrs)}
+}
+
+func (h *contextHandler) WithGroup(name string) slog.Handler {
+	return &contextHandler{Handler: h.Handler.WithGroup(name)}
+}
@@ -0,0 +1,82 @@
+package logging_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"log/slog"
+	"testing"
+
+	"github.com/mathefer/tc-fiap-product/pkg/logging"
+	"github.com/stretchr/testify/assert"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+)
+
+func decodeLines(t *testing.T, out *bytes.Buffer) []map[string]any {
+	var lines []map[string]any
+	for _, line := range bytes.Split(bytes.TrimSpace(out.Bytes()), []byte("\n")) {
+		var entry map[string]any
+		assert.NoError(t, json.Unmarshal(line, &entry))
+		lines = append(lines, entry)
+	}
+	return lines
+}
+
+func TestLogger_AddsContextAttributes(t *testing.T) {
+	// Arrange
+	var out bytes.Buffer
+	logger := logging.New(&out, slog.LevelInfo)
+
+	ctx := logging.ContextWithRequestID(context.Background(), "req-1")
+	ctx = logging.ContextWithAttrs(ctx, slog.Uint64("product_id", 7))
+	ctx, span := sdktrace.NewTracerProvider().Tracer("test").Start(ctx, "operation")
+	defer span.End()
+
+	// Act
+	logger.With("component", "test").InfoContext(ctx, "Product loaded", "count", 1)
+
+	// Assert
+	entry := decodeLines(t, &out)[0]
+	assert.Equal(t, "Product loaded", entry["msg"])
+	assert.Equal(t, "INFO", entry["level"])
+	assert.Equal(t, "req-1", entry["request_id"])
+	assert.Equal(t, float64(7), entry["product_id"])
+	assert.Equal(t, "test", entry["component"])
+	assert.Equal(t, float64(1), entry["count"])
+	assert.Equal(t, span.SpanContext().TraceID().String(), entry["trace_id"])
+	assert.Equal(t, span.SpanContext().SpanID().String(), entry["span_id"])
+}
+
+func TestLogger_OmitsMissingContextAttributes(t *testing.T) {
+	// Arrange
+	var out bytes.Buffer
+	logger := logging.New(&out, slog.LevelInfo)
+
+	// Act
+	logger.Info("Starting HTTP server")
+	logger.Debug("not written")
+
+	// Assert
+	lines := decodeLines(t, &out)
+	assert.Len(t, lines, 1)
+	assert.NotContains(t, lines[0], "request_id")
+	assert.NotContains(t, lines[0], "trace_id")
+}
+
+func TestContextWithAttrs_DoesNotLeakIntoParent(t *testing.T) {
+	// Arrange
+	var out bytes.Buffer
+	logger := logging.New(&out, slog.LevelInfo)
+	parent := logging.ContextWithAttrs(context.Background(), slog.String("a", "1"))
+
+	// Act
+	child := logging.ContextWithAttrs(parent, slog.String("b", "2"))
+	logger.InfoContext(parent, "parent")
+	logger.InfoContext(child, "child")
+
+	// Assert
+	lines := decodeLines(t, &out)
+	assert.NotContains(t, lines[0], "b")
+	assert.Equal(t, "1", lines[1]["a"])
+	assert.Equal(t, "2", lines[1]["b"])
+}
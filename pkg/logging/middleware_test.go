@@ -0,0 +1,83 @@
+package logging_test
+
+import (
+	"bytes"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/mathefer/tc-fiap-product/pkg/logging"
+	"github.com/stretchr/testify/assert"
+)
+
+func newRouter(logger *slog.Logger) *chi.Mux {
+	r := chi.NewRouter()
+	r.Use(logging.RequestID)
+	r.Use(logging.AccessLog(logger))
+	r.Get("/v1/product/{id}", func(w http.ResponseWriter, r *http.Request) {
+		logger.InfoContext(r.Context(), "Product loaded")
+		w.WriteHeader(http.StatusNotFound)
+	})
+	return r
+}
+
+func TestRequestID_PropagatesIncomingHeader(t *testing.T) {
+	// Arrange
+	var out bytes.Buffer
+	r := newRouter(logging.New(&out, slog.LevelInfo))
+	req := httptest.NewRequest(http.MethodGet, "/v1/product/7", nil)
+	req.Header.Set(logging.RequestIDHeader, "abc-123")
+	rec := httptest.NewRecorder()
+
+	// Act
+	r.ServeHTTP(rec, req)
+
+	// Assert
+	assert.Equal(t, "abc-123", rec.Header().Get(logging.RequestIDHeader))
+
+	lines := decodeLines(t, &out)
+	assert.Len(t, lines, 2)
+	handler, access := lines[0], lines[1]
+	assert.Equal(t, "abc-123", handler["request_id"])
+	assert.Equal(t, "/v1/product/{id}", handler["route"])
+	assert.Equal(t, "Request completed", access["msg"])
+	assert.Equal(t, "abc-123", access["request_id"])
+	assert.Equal(t, "/v1/product/{id}", access["route"])
+	assert.Equal(t, "/v1/product/7", access["path"])
+	assert.Equal(t, float64(http.StatusNotFound), access["status"])
+}
+
+func TestRequestID_GeneratesWhenMissingOrInvalid(t *testing.T) {
+	for _, incoming := range []string{"", "has spaces", strings.Repeat("x", 200)} {
+		// Arrange
+		r := newRouter(logging.NewNop())
+		req := httptest.NewRequest(http.MethodGet, "/v1/product/7", nil)
+		req.Header.Set(logging.RequestIDHeader, incoming)
+		rec := httptest.NewRecorder()
+
+		// Act
+		r.ServeHTTP(rec, req)
+
+		// Assert
+		id := rec.Header().Get(logging.RequestIDHeader)
+		assert.Len(t, id, 32, incoming)
+		assert.NotEqual(t, incoming, id)
+	}
+}
+
+func TestAccessLog_UnmatchedRoute(t *testing.T) {
+	// Arrange
+	var out bytes.Buffer
+	r := newRouter(logging.New(&out, slog.LevelInfo))
+
+	// Act
+	r.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/nowhere", nil))
+
+	// Assert
+	access := decodeLines(t, &out)[0]
+	assert.Equal(t, "unmatched", access["route"])
+	assert.Equal(t, float64(http.StatusNotFound), access["status"])
+}
@@ -0,0 +1,43 @@
+package metrics
+
+import (
+	"fmt"
+	"log/slog"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+type Controller struct {
+	handler http.Handler
+}
+
+func NewController(gatherer prometheus.Gatherer) *Controller {
+	return &Controller{handler: promhttp.HandlerFor(gatherer, promhttp.HandlerOpts{
+		ErrorLog:      errorLog{},
+		ErrorHandling: promhttp.ContinueOnError,
+	})}
+}
+
+func (c *Controller) RegisterRoutes(r chi.Router) {
+	r.Get("/metrics", c.Metrics)
+}
+
+// @Summary     Prometheus metrics
+// @Description Request, database, catalog, Go runtime and process metrics in the Prometheus text format.
+// @Tags        Metrics
+// @Produce     plain
+// @Success     200
+// @Router      /metrics [get]
+func (c *Controller) Metrics(w http.ResponseWriter, r *http.Request) {
+	c.handler.ServeHTTP(w, r)
+}
+
+// errorLog logs the collectors that failed during a scrape through slog.
+type errorLog struct{}
+
+func (errorLog) Println(v ...any) {
+	slog.Error("Failed to collect metrics", "error", fmt.Sprint(v...))
+}
@@ -0,0 +1,55 @@
+package metrics
+
+import (
+	"context"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// collectTimeout bounds the work a GaugeFunc does on each scrape, since the
+// client library does not pass the scrape's context to collectors.
+const collectTimeout = 5 * time.Second
+
+// Sample is one value of a gauge computed at scrape time.
+type Sample struct {
+	LabelValues []string
+	Value       float64
+}
+
+// GaugeFunc is a gauge family whose values are computed on every scrape,
+// e.g. by counting rows in the database.
+type GaugeFunc struct {
+	desc    *prometheus.Desc
+	collect func(ctx context.Context) ([]Sample, error)
+}
+
+var _ prometheus.Collector = (*GaugeFunc)(nil)
+
+func NewGaugeFunc(name, help string, labelNames []string, collect func(ctx context.Context) ([]Sample, error)) *GaugeFunc {
+	return &GaugeFunc{desc: prometheus.NewDesc(name, help, labelNames, nil), collect: collect}
+}
+
+func (g *GaugeFunc) Describe(ch chan<- *prometheus.Desc) {
+	ch <- g.desc
+}
+
+// Collect reports a failure as an invalid metric, which the /metrics
+// handler logs and skips so that one broken gauge does not hide the others.
+func (g *GaugeFunc) Collect(ch chan<- prometheus.Metric) {
+	ctx, cancel := context.WithTimeout(context.Background(), collectTimeout)
+	defer cancel()
+
+	samples, err := g.collect(ctx)
+	if err != nil {
+		ch <- prometheus.NewInvalidMetric(g.desc, err)
+		return
+	}
+	for _, sample := range samples {
+		metric, err := prometheus.NewConstMetric(g.desc, prometheus.GaugeValue, sample.Value, sample.LabelValues...)
+		if err != nil {
+			metric = prometheus.NewInvalidMetric(g.desc, err)
+		}
+		ch <- metric
+	}
+}
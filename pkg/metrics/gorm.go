@@ -0,0 +1,93 @@
+package metrics
+
+import (
+	"errors"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"gorm.io/gorm"
+)
+
+const startKey = "metrics:start"
+
+// GormMetrics is a GORM plugin recording query duration and errors per
+// operation (create, query, update, delete, row, raw) and table.
+type GormMetrics struct {
+	duration *prometheus.HistogramVec
+	errors   *prometheus.CounterVec
+}
+
+var _ gorm.Plugin = (*GormMetrics)(nil)
+
+func NewGormMetrics(registerer prometheus.Registerer) *GormMetrics {
+	factory := promauto.With(registerer)
+	return &GormMetrics{
+		duration: factory.NewHistogramVec(prometheus.HistogramOpts{
+			Name:    "db_query_duration_seconds",
+			Help:    "Database query latency in seconds by operation and table.",
+			Buckets: prometheus.DefBuckets,
+		}, []string{"operation", "table"}),
+		errors: factory.NewCounterVec(prometheus.CounterOpts{
+			Name: "db_query_errors_total",
+			Help: "Failed database queries by operation and table. Record not found is not an error.",
+		}, []string{"operation", "table"}),
+	}
+}
+
+func (m *GormMetrics) Name() string {
+	return "metrics"
+}
+
+func (m *GormMetrics) Initialize(db *gorm.DB) error {
+	callback := db.Callback()
+	register := []struct {
+		operation string
+		before    func(name string, fn func(*gorm.DB)) error
+		after     func(name string, fn func(*gorm.DB)) error
+	}{
+		{"create", callback.Create().Before("gorm:create").Register, callback.Create().After("gorm:create").Register},
+		{"query", callback.Query().Before("gorm:query").Register, callback.Query().After("gorm:query").Register},
+		{"update", callback.Update().Before("gorm:update").Register, callback.Update().After("gorm:update").Register},
+		{"delete", callback.Delete().Before("gorm:delete").Register, callback.Delete().After("gorm:delete").Register},
+		{"row", callback.Row().Before("gorm:row").Register, callback.Row().After("gorm:row").Register},
+		{"raw", callback.Raw().Before("gorm:raw").Register, callback.Raw().After("gorm:raw").Register},
+	}
+
+	for _, r := range register {
+		if err := r.before("metrics:before_"+r.operation, m.start); err != nil {
+			return err
+		}
+		if err := r.after("metrics:after_"+r.operation, m.observe(r.operation)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m *GormMetrics) start(db *gorm.DB) {
+	db.InstanceSet(startKey, time.Now())
+}
+
+func (m *GormMetrics) observe(operation string) func(*gorm.DB) {
+	return func(db *gorm.DB) {
+		value, ok := db.InstanceGet(startKey)
+		if !ok {
+			return
+		}
+		start, ok := value.(time.Time)
+		if !ok {
+			return
+		}
+
+		table := db.Statement.Table
+		if table == "" {
+			table = "unknown"
+		}
+
+		m.duration.WithLabelValues(operation, table).Observe(time.Since(start).Seconds())
+		if db.Error != nil && !errors.Is(db.Error, gorm.ErrRecordNotFound) {
+			m.errors.WithLabelValues(operation, table).Inc()
+		}
+	}
+}
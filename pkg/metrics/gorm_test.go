@@ -0,0 +1,41 @@
+package metrics_test
+
+import (
+	"testing"
+
+	"github.com/mathefer/tc-fiap-product/pkg/metrics"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+type widget struct {
+	ID   uint
+	Name string
+}
+
+func TestGormMetrics_RecordsQueries(t *testing.T) {
+	// Arrange
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{Logger: logger.Default.LogMode(logger.Silent)})
+	assert.NoError(t, err)
+	assert.NoError(t, db.AutoMigrate(&widget{}))
+
+	registry := prometheus.NewRegistry()
+	assert.NoError(t, db.Use(metrics.NewGormMetrics(registry)))
+
+	// Act
+	assert.NoError(t, db.Create(&widget{Name: "a"}).Error)
+	var found widget
+	assert.NoError(t, db.First(&found).Error)
+	assert.Error(t, db.First(&found, 99).Error)
+	assert.Error(t, db.Table("missing").Create(map[string]any{"name": "b"}).Error)
+
+	// Assert
+	output := collect(t, registry)
+	assert.Contains(t, output, `db_query_duration_seconds_count{operation="create",table="widgets"} 1`)
+	assert.Contains(t, output, `db_query_duration_seconds_count{operation="query",table="widgets"} 2`)
+	assert.Contains(t, output, `db_query_errors_total{operation="create",table="missing"} 1`)
+	assert.NotContains(t, output, `db_query_errors_total{operation="query"`)
+}
@@ -0,0 +1,65 @@
+package metrics
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/chi/v5/middleware"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+// unmatchedRoute labels requests that matched no route, so that arbitrary
+// paths cannot create new series.
+const unmatchedRoute = "unmatched"
+
+// HTTPMetrics records request counts and latencies per chi route pattern.
+type HTTPMetrics struct {
+	requests *prometheus.CounterVec
+	duration *prometheus.HistogramVec
+}
+
+func NewHTTPMetrics(registerer prometheus.Registerer) *HTTPMetrics {
+	factory := promauto.With(registerer)
+	return &HTTPMetrics{
+		requests: factory.NewCounterVec(prometheus.CounterOpts{
+			Name: "http_requests_total",
+			Help: "HTTP requests by method, route and status class.",
+		}, []string{"method", "route", "status"}),
+		duration: factory.NewHistogramVec(prometheus.HistogramOpts{
+			Name:    "http_request_duration_seconds",
+			Help:    "HTTP request latency in seconds by method and route.",
+			Buckets: prometheus.DefBuckets,
+		}, []string{"method", "route"}),
+	}
+}
+
+// Middleware records every request once the handler has returned. It must
+// be installed on the router with Use so the route pattern is resolved.
+func (m *HTTPMetrics) Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		start := time.Now()
+		ww := middleware.NewWrapResponseWriter(w, r.ProtoMajor)
+
+		next.ServeHTTP(ww, r)
+
+		route := unmatchedRoute
+		if routeCtx := chi.RouteContext(r.Context()); routeCtx != nil && routeCtx.RoutePattern() != "" {
+			route = routeCtx.RoutePattern()
+		}
+
+		m.requests.WithLabelValues(r.Method, route, statusClass(ww.Status())).Inc()
+		m.duration.WithLabelValues(r.Method, route).Observe(time.Since(start).Seconds())
+	})
+}
+
+// statusClass turns 404 into "4xx". Handlers that never write a header
+// answered 200.
+func statusClass(status int) string {
+	if status == 0 {
+		status = http.StatusOK
+	}
+	return strconv.Itoa(status/100) + "xx"
+}
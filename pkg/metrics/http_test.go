@@ -0,0 +1,58 @@
+package metrics_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/mathefer/tc-fiap-product/pkg/metrics"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHTTPMetrics_Middleware(t *testing.T) {
+	// Arrange
+	registry := prometheus.NewRegistry()
+	httpMetrics := metrics.NewHTTPMetrics(registry)
+
+	router := chi.NewRouter()
+	router.Use(httpMetrics.Middleware)
+	router.Get("/v1/product/{id}", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	})
+	router.Get("/healthz", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte("ok"))
+	})
+
+	// Act
+	for _, path := range []string{"/v1/product/1", "/v1/product/2", "/healthz", "/does-not-exist"} {
+		router.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, path, nil))
+	}
+
+	// Assert
+	output := collect(t, registry)
+	assert.Contains(t, output, `http_requests_total{method="GET",route="/v1/product/{id}",status="4xx"} 2`)
+	assert.Contains(t, output, `http_requests_total{method="GET",route="/healthz",status="2xx"} 1`)
+	assert.Contains(t, output, `http_requests_total{method="GET",route="unmatched",status="4xx"} 1`)
+	assert.Contains(t, output, `http_request_duration_seconds_count{method="GET",route="/v1/product/{id}"} 2`)
+}
+
+func TestController_Metrics(t *testing.T) {
+	// Arrange
+	registry := prometheus.NewRegistry()
+	promauto.With(registry).NewCounter(prometheus.CounterOpts{Name: "requests_total", Help: "Requests."}).Inc()
+
+	router := chi.NewRouter()
+	metrics.NewController(registry).RegisterRoutes(router)
+	w := httptest.NewRecorder()
+
+	// Act
+	router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+
+	// Assert
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Contains(t, w.Header().Get("Content-Type"), "text/plain; version=0.0.4")
+	assert.Contains(t, w.Body.String(), "requests_total 1")
+}
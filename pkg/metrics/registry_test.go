@@ -0,0 +1,114 @@
+package metrics_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/mathefer/tc-fiap-product/pkg/metrics"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/stretchr/testify/assert"
+)
+
+// collect scrapes gatherer the way Prometheus does.
+func collect(t *testing.T, gatherer prometheus.Gatherer) string {
+	t.Helper()
+	w := httptest.NewRecorder()
+	metrics.NewController(gatherer).Metrics(w, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+	assert.Equal(t, http.StatusOK, w.Code)
+	return w.Body.String()
+}
+
+func TestNewRegistry_RuntimeAndProcessMetrics(t *testing.T) {
+	// Act
+	output := collect(t, metrics.NewRegistry())
+
+	// Assert
+	assert.Contains(t, output, "go_goroutines ")
+	assert.Contains(t, output, "process_start_time_seconds ")
+}
+
+func TestGaugeFunc_Collect(t *testing.T) {
+	// Arrange
+	registry := prometheus.NewRegistry()
+	registry.MustRegister(metrics.NewGaugeFunc("products", "Products.", []string{"category"},
+		func(ctx context.Context) ([]metrics.Sample, error) {
+			return []metrics.Sample{
+				{LabelValues: []string{"2"}, Value: 5},
+				{LabelValues: []string{"1"}, Value: 3},
+			}, nil
+		}))
+
+	// Act
+	output := collect(t, registry)
+
+	// Assert
+	assert.Equal(t, `# HELP products Products.
+# TYPE products gauge
+products{category="1"} 3
+products{category="2"} 5
+`, output)
+}
+
+func TestGaugeFunc_FailureDoesNotHideOtherMetrics(t *testing.T) {
+	// Arrange
+	registry := prometheus.NewRegistry()
+	registry.MustRegister(metrics.NewGaugeFunc("broken", "Broken.", nil, func(ctx context.Context) ([]metrics.Sample, error) {
+		return nil, errors.New("database down")
+	}))
+	promauto.With(registry).NewCounter(prometheus.CounterOpts{Name: "requests_total", Help: "Requests."}).Inc()
+
+	// Act
+	output := collect(t, registry)
+
+	// Assert
+	assert.NotContains(t, output, "broken")
+	assert.Contains(t, output, "requests_total 1")
+}
+
+// blockingWriter stands for a client that stopped reading the scrape.
+type blockingWriter struct {
+	*httptest.ResponseRecorder
+	release chan struct{}
+}
+
+func (w *blockingWriter) Write(p []byte) (int, error) {
+	<-w.release
+	return w.ResponseRecorder.Write(p)
+}
+
+func TestController_SlowScrapeDoesNotBlockRecording(t *testing.T) {
+	// Arrange
+	registry := prometheus.NewRegistry()
+	counter := promauto.With(registry).NewCounterVec(prometheus.CounterOpts{Name: "requests_total", Help: "Requests."}, []string{"route"})
+	counter.WithLabelValues("/a").Inc()
+
+	w := &blockingWriter{ResponseRecorder: httptest.NewRecorder(), release: make(chan struct{})}
+	scraped := make(chan struct{})
+	go func() {
+		metrics.NewController(registry).Metrics(w, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+		close(scraped)
+	}()
+
+	// Act - requests keep being counted while the scrape is stuck writing
+	recorded := make(chan struct{})
+	go func() {
+		for range 100 {
+			counter.WithLabelValues("/a").Inc()
+		}
+		close(recorded)
+	}()
+
+	// Assert
+	select {
+	case <-recorded:
+	case <-time.After(time.Second):
+		t.Fatal("recording blocked behind the scrape")
+	}
+	close(w.release)
+	<-scraped
+}
@@ -0,0 +1,47 @@
+package rest
+
+import (
+	"net/http"
+	"strings"
+	"time"
+)
+
+// NotModified reports whether a GET or HEAD request may be answered with
+// 304 Not Modified because the client already holds the representation with
+// the given entity tag and modification time. If-None-Match is compared
+// weakly and takes precedence over If-Modified-Since, as RFC 9110 requires.
+func NotModified(r *http.Request, etag string, lastModified time.Time) bool {
+	if r.Method != http.MethodGet && r.Method != http.MethodHead {
+		return false
+	}
+
+	if header := r.Header.Get("If-None-Match"); header != "" {
+		return etag != "" && matchesETag(header, etag)
+	}
+
+	if lastModified.IsZero() {
+		return false
+	}
+	since, err := http.ParseTime(r.Header.Get("If-Modified-Since"))
+	if err != nil {
+		return false
+	}
+	// HTTP dates have a resolution of one second.
+	return !lastModified.Truncate(time.Second).After(since)
+}
+
+// matchesETag reports whether any tag in an If-None-Match header equals
+// etag, ignoring the weak prefix of both.
+func matchesETag(header, etag string) bool {
+	if strings.TrimSpace(header) == "*" {
+		return true
+	}
+
+	opaque := strings.TrimPrefix(etag, "W/")
+	for _, candidate := range strings.Split(header, ",") {
+		if strings.TrimPrefix(strings.TrimSpace(candidate), "W/") == opaque {
+			return true
+		}
+	}
+	return false
+}
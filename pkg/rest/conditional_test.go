@@ -0,0 +1,99 @@
+package rest_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/mathefer/tc-fiap-product/pkg/rest"
+	"github.com/stretchr/testify/assert"
+)
+
+var lastModified = time.Date(2024, 1, 1, 12, 0, 0, 500, time.UTC)
+
+func conditionalRequest(method string, headers map[string]string) *http.Request {
+	r := httptest.NewRequest(method, "/v1/product?category=1", nil)
+	for name, value := range headers {
+		r.Header.Set(name, value)
+	}
+	return r
+}
+
+func TestNotModified_IfNoneMatch(t *testing.T) {
+	tests := []struct {
+		header string
+		want   bool
+	}{
+		{`W/"3"`, true},
+		{`"3"`, true},
+		{`W/"1", W/"3"`, true},
+		{`*`, true},
+		{`W/"2"`, false},
+		{`W/"1", "2"`, false},
+	}
+	for _, tt := range tests {
+		// Arrange
+		r := conditionalRequest(http.MethodGet, map[string]string{"If-None-Match": tt.header})
+
+		// Act
+		got := rest.NotModified(r, `W/"3"`, lastModified)
+
+		// Assert
+		assert.Equal(t, tt.want, got, tt.header)
+	}
+}
+
+func TestNotModified_IfNoneMatchTakesPrecedence(t *testing.T) {
+	// Arrange - the date alone would be satisfied
+	r := conditionalRequest(http.MethodGet, map[string]string{
+		"If-None-Match":     `W/"2"`,
+		"If-Modified-Since": lastModified.Add(time.Hour).Format(http.TimeFormat),
+	})
+
+	// Act
+	got := rest.NotModified(r, `W/"3"`, lastModified)
+
+	// Assert
+	assert.False(t, got)
+}
+
+func TestNotModified_IfModifiedSince(t *testing.T) {
+	tests := []struct {
+		since time.Time
+		want  bool
+	}{
+		// The fraction of a second is lost in the Last-Modified header.
+		{lastModified.Truncate(time.Second), true},
+		{lastModified.Add(time.Minute), true},
+		{lastModified.Add(-time.Second), false},
+	}
+	for _, tt := range tests {
+		// Arrange
+		r := conditionalRequest(http.MethodGet, map[string]string{"If-Modified-Since": tt.since.Format(http.TimeFormat)})
+
+		// Act
+		got := rest.NotModified(r, `W/"3"`, lastModified)
+
+		// Assert
+		assert.Equal(t, tt.want, got, tt.since)
+	}
+}
+
+func TestNotModified_Unconditional(t *testing.T) {
+	tests := []struct {
+		name    string
+		request *http.Request
+	}{
+		{"no headers", conditionalRequest(http.MethodGet, nil)},
+		{"invalid date", conditionalRequest(http.MethodGet, map[string]string{"If-Modified-Since": "yesterday"})},
+		{"not a read", conditionalRequest(http.MethodPut, map[string]string{"If-None-Match": "*"})},
+	}
+	for _, tt := range tests {
+		// Act
+		got := rest.NotModified(tt.request, `W/"3"`, lastModified)
+
+		// Assert
+		assert.False(t, got, tt.name)
+	}
+}
@@ -0,0 +1,31 @@
+package rest
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+)
+
+// ErrInvalidCursor is returned when a pagination cursor cannot be decoded
+var ErrInvalidCursor = errors.New("invalid pagination cursor")
+
+// EncodeCursor turns the position where a listing stopped into an opaque
+// token that clients send back unchanged to fetch the next page. It panics
+// if position cannot be encoded as JSON.
+func EncodeCursor(position any) string {
+	raw, err := json.Marshal(position)
+	if err != nil {
+		panic(err)
+	}
+	return base64.RawURLEncoding.EncodeToString(raw)
+}
+
+// DecodeCursor stores the position carried by a token built with
+// EncodeCursor in the value pointed to by position.
+func DecodeCursor(cursor string, position any) error {
+	raw, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil || json.Unmarshal(raw, position) != nil {
+		return ErrInvalidCursor
+	}
+	return nil
+}
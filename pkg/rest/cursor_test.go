@@ -0,0 +1,61 @@
+package rest_test
+
+import (
+	"testing"
+
+	"github.com/mathefer/tc-fiap-product/pkg/rest"
+	"github.com/stretchr/testify/assert"
+)
+
+type position struct {
+	ID   uint   `json:"id"`
+	Name string `json:"name"`
+}
+
+func TestCursor_RoundTrip(t *testing.T) {
+	// Arrange
+	after := position{ID: 40, Name: "Hamburguer"}
+
+	// Act
+	cursor := rest.EncodeCursor(after)
+	var decoded position
+	err := rest.DecodeCursor(cursor, &decoded)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.NotContains(t, cursor, "Hamburguer")
+	assert.Equal(t, after, decoded)
+}
+
+func TestDecodeCursor_InvalidBase64(t *testing.T) {
+	// Act
+	var decoded position
+	err := rest.DecodeCursor("not a cursor!", &decoded)
+
+	// Assert
+	assert.ErrorIs(t, err, rest.ErrInvalidCursor)
+}
+
+func TestDecodeCursor_WrongPayload(t *testing.T) {
+	// Arrange
+	cursor := "aGVsbG8" // "hello"
+
+	// Act
+	var decoded position
+	err := rest.DecodeCursor(cursor, &decoded)
+
+	// Assert
+	assert.ErrorIs(t, err, rest.ErrInvalidCursor)
+}
+
+func TestDecodeCursor_WrongType(t *testing.T) {
+	// Arrange
+	cursor := "eyJpZCI6LTF9" // {"id":-1}
+
+	// Act
+	var decoded position
+	err := rest.DecodeCursor(cursor, &decoded)
+
+	// Assert
+	assert.ErrorIs(t, err, rest.ErrInvalidCursor)
+}
@@ -0,0 +1,49 @@
+package rest
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"strconv"
+	"strings"
+)
+
+// ErrInvalidETag is returned when an If-Match header cannot be interpreted
+var ErrInvalidETag = errors.New("invalid entity tag")
+
+// EncodeETag returns the entity tag of the given version of a resource. It
+// is weak because the representation may vary with content coding, for
+// instance when a proxy compresses it.
+func EncodeETag(version uint) string {
+	return `W/"` + strconv.FormatUint(uint64(version), 10) + `"`
+}
+
+// HashETag returns a weak entity tag derived from a representation, for
+// responses such as listings that have no version of their own.
+func HashETag(body []byte) string {
+	sum := sha256.Sum256(body)
+	return `W/"` + hex.EncodeToString(sum[:16]) + `"`
+}
+
+// ParseIfMatch returns the version named by an If-Match header built from
+// EncodeETag, or 0 when the header is empty or "*", which match any version.
+// A single entity tag is accepted. The weak prefix is ignored, since every
+// tag EncodeETag produces has it, so If-Match compares versions rather than
+// applying the strong comparison of RFC 9110.
+func ParseIfMatch(header string) (uint, error) {
+	header = strings.TrimSpace(header)
+	if header == "" || header == "*" {
+		return 0, nil
+	}
+
+	tag := strings.TrimPrefix(header, "W/")
+	if len(tag) < 2 || tag[0] != '"' || tag[len(tag)-1] != '"' {
+		return 0, ErrInvalidETag
+	}
+
+	version, err := strconv.ParseUint(tag[1:len(tag)-1], 10, 0)
+	if err != nil || version == 0 {
+		return 0, ErrInvalidETag
+	}
+	return uint(version), nil
+}
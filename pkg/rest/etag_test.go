@@ -0,0 +1,69 @@
+package rest_test
+
+import (
+	"testing"
+
+	"github.com/mathefer/tc-fiap-product/pkg/rest"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestETag_RoundTrip(t *testing.T) {
+	// Act
+	etag := rest.EncodeETag(3)
+	version, err := rest.ParseIfMatch(etag)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, `W/"3"`, etag)
+	assert.Equal(t, uint(3), version)
+}
+
+func TestParseIfMatch_StrongTag(t *testing.T) {
+	// Act
+	version, err := rest.ParseIfMatch(`"5"`)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, uint(5), version)
+}
+
+func TestHashETag(t *testing.T) {
+	// Act
+	etag := rest.HashETag([]byte(`[{"id":1}]`))
+
+	// Assert - weak, stable and different for different bodies
+	assert.Regexp(t, `^W/"[0-9a-f]{32}"$`, etag)
+	assert.Equal(t, etag, rest.HashETag([]byte(`[{"id":1}]`)))
+	assert.NotEqual(t, etag, rest.HashETag([]byte(`[{"id":2}]`)))
+}
+
+func TestParseIfMatch_AnyVersion(t *testing.T) {
+	for _, header := range []string{"", "*", " * "} {
+		// Act
+		version, err := rest.ParseIfMatch(header)
+
+		// Assert
+		assert.NoError(t, err, header)
+		assert.Zero(t, version, header)
+	}
+}
+
+func TestParseIfMatch_WeakTag(t *testing.T) {
+	// Act
+	version, err := rest.ParseIfMatch(`W/"5"`)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, uint(5), version)
+}
+
+func TestParseIfMatch_Invalid(t *testing.T) {
+	for _, header := range []string{`3`, `"abc"`, `"0"`, `"-1"`, `"1", "2"`, `"`} {
+		// Act
+		version, err := rest.ParseIfMatch(header)
+
+		// Assert
+		assert.ErrorIs(t, err, rest.ErrInvalidETag, header)
+		assert.Zero(t, version, header)
+	}
+}
@@ -0,0 +1,54 @@
+package rest
+
+import (
+	"encoding/json"
+	"net/http"
+)
+
+// ProblemContentType is the media type of RFC 7807 error responses.
+const ProblemContentType = "application/problem+json"
+
+// Problem is an RFC 7807 problem details document.
+type Problem struct {
+	Type     string         `json:"type"`
+	Title    string         `json:"title"`
+	Status   int            `json:"status"`
+	Detail   string         `json:"detail,omitempty"`
+	Instance string         `json:"instance,omitempty"`
+	Errors   []FieldProblem `json:"errors,omitempty"`
+}
+
+// FieldProblem explains why a single request field was rejected.
+type FieldProblem struct {
+	Field   string `json:"field"`
+	Message string `json:"message"`
+}
+
+// NewProblem builds a generic problem for the given status code.
+// The title is the standard HTTP status text.
+func NewProblem(status int, detail string) *Problem {
+	return &Problem{
+		Type:   "about:blank",
+		Title:  http.StatusText(status),
+		Status: status,
+		Detail: detail,
+	}
+}
+
+// WriteProblem writes problem as an application/problem+json response.
+// When the problem has no instance the request path is used.
+func WriteProblem(w http.ResponseWriter, r *http.Request, problem *Problem) {
+	if problem.Instance == "" && r != nil {
+		problem.Instance = r.URL.Path
+	}
+
+	w.Header().Set("Content-Type", ProblemContentType)
+	w.Header().Set("X-Content-Type-Options", "nosniff")
+	w.WriteHeader(problem.Status)
+	json.NewEncoder(w).Encode(problem)
+}
+
+// WriteError is a shortcut for writing a generic problem with a detail message.
+func WriteError(w http.ResponseWriter, r *http.Request, status int, detail string) {
+	WriteProblem(w, r, NewProblem(status, detail))
+}
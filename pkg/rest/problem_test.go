@@ -0,0 +1,79 @@
+package rest_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/mathefer/tc-fiap-product/pkg/rest"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewProblem(t *testing.T) {
+	// Act
+	problem := rest.NewProblem(http.StatusNotFound, "Product not found")
+
+	// Assert
+	assert.Equal(t, "about:blank", problem.Type)
+	assert.Equal(t, "Not Found", problem.Title)
+	assert.Equal(t, http.StatusNotFound, problem.Status)
+	assert.Equal(t, "Product not found", problem.Detail)
+	assert.Empty(t, problem.Instance)
+}
+
+func TestWriteProblem(t *testing.T) {
+	// Arrange
+	req := httptest.NewRequest(http.MethodPost, "/v1/product", nil)
+	w := httptest.NewRecorder()
+	problem := rest.NewProblem(http.StatusUnprocessableEntity, "validation failed")
+	problem.Errors = []rest.FieldProblem{{Field: "name", Message: "is required"}}
+
+	// Act
+	rest.WriteProblem(w, req, problem)
+
+	// Assert
+	assert.Equal(t, http.StatusUnprocessableEntity, w.Code)
+	assert.Equal(t, rest.ProblemContentType, w.Header().Get("Content-Type"))
+
+	var body map[string]any
+	err := json.NewDecoder(w.Body).Decode(&body)
+	assert.NoError(t, err)
+	assert.Equal(t, "about:blank", body["type"])
+	assert.Equal(t, "Unprocessable Entity", body["title"])
+	assert.Equal(t, float64(422), body["status"])
+	assert.Equal(t, "validation failed", body["detail"])
+	assert.Equal(t, "/v1/product", body["instance"])
+	assert.Equal(t, []any{map[string]any{"field": "name", "message": "is required"}}, body["errors"])
+}
+
+func TestWriteProblem_KeepsInstance(t *testing.T) {
+	// Arrange
+	req := httptest.NewRequest(http.MethodGet, "/v1/product/1", nil)
+	w := httptest.NewRecorder()
+	problem := rest.NewProblem(http.StatusNotFound, "")
+	problem.Instance = "/custom"
+
+	// Act
+	rest.WriteProblem(w, req, problem)
+
+	// Assert
+	assert.Contains(t, w.Body.String(), `"instance":"/custom"`)
+	assert.NotContains(t, w.Body.String(), `"detail"`)
+	assert.NotContains(t, w.Body.String(), `"errors"`)
+}
+
+func TestWriteError(t *testing.T) {
+	// Arrange
+	req := httptest.NewRequest(http.MethodGet, "/v1/product?category=x", nil)
+	w := httptest.NewRecorder()
+
+	// Act
+	rest.WriteError(w, req, http.StatusBadRequest, "Invalid category parameter")
+
+	// Assert
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	assert.Equal(t, rest.ProblemContentType, w.Header().Get("Content-Type"))
+	assert.Contains(t, w.Body.String(), `"detail":"Invalid category parameter"`)
+	assert.Contains(t, w.Body.String(), `"instance":"/v1/product"`)
+}
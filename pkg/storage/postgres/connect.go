@@ -0,0 +1,99 @@
+package postgres
+
+import (
+	"cmp"
+	"context"
+	"database/sql"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+)
+
+// Connect waits initialConnectBackoff after the first failed attempt and
+// doubles the wait up to maxConnectBackoff, unless ConnectConfig says
+// otherwise.
+const (
+	initialConnectBackoff = 500 * time.Millisecond
+	maxConnectBackoff     = 10 * time.Second
+)
+
+// PoolConfig sizes the connection pool. Zero values mean no limit, as in
+// database/sql.
+type PoolConfig struct {
+	MaxOpenConns    int
+	MaxIdleConns    int
+	ConnMaxLifetime time.Duration
+	ConnMaxIdleTime time.Duration
+}
+
+// Apply configures db's pool.
+func (c PoolConfig) Apply(db *sql.DB) {
+	db.SetMaxOpenConns(c.MaxOpenConns)
+	db.SetMaxIdleConns(c.MaxIdleConns)
+	db.SetConnMaxLifetime(c.ConnMaxLifetime)
+	db.SetConnMaxIdleTime(c.ConnMaxIdleTime)
+}
+
+// ConnectConfig controls how Connect reaches the database at startup.
+type ConnectConfig struct {
+	Pool PoolConfig
+	// Timeout is the deadline for the first successful connection; zero
+	// waits for as long as the caller's context allows.
+	Timeout time.Duration
+	// InitialBackoff and MaxBackoff bound the exponential wait between
+	// attempts; zero values use the defaults.
+	InitialBackoff time.Duration
+	MaxBackoff     time.Duration
+}
+
+// Connect opens a GORM connection with the given pool and pings the database
+// until it answers, waiting with exponential backoff between attempts. It
+// gives up when config.Timeout or ctx expires, so a database that is briefly
+// unavailable delays startup instead of failing it.
+func Connect(ctx context.Context, dsn string, config ConnectConfig, logger *slog.Logger) (*gorm.DB, error) {
+	db, err := gorm.Open(postgres.Open(dsn), &gorm.Config{TranslateError: true, DisableAutomaticPing: true})
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to database: %w", err)
+	}
+	sqlDB, err := db.DB()
+	if err != nil {
+		return nil, err
+	}
+	config.Pool.Apply(sqlDB)
+
+	if config.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, config.Timeout)
+		defer cancel()
+	}
+
+	backoff := cmp.Or(config.InitialBackoff, initialConnectBackoff)
+	maxBackoff := cmp.Or(config.MaxBackoff, maxConnectBackoff)
+	for attempt := 1; ; attempt++ {
+		err = sqlDB.PingContext(ctx)
+		if err == nil {
+			return db, nil
+		}
+		if ctx.Err() != nil {
+			break
+		}
+
+		logger.Warn("Database not reachable, retrying", "attempt", attempt, "backoff", backoff, "error", err)
+		timer := time.NewTimer(backoff)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+		case <-timer.C:
+		}
+		if ctx.Err() != nil {
+			break
+		}
+		backoff = min(2*backoff, maxBackoff)
+	}
+
+	_ = sqlDB.Close()
+	return nil, fmt.Errorf("failed to connect to database: %w", err)
+}
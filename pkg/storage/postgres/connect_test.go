@@ -0,0 +1,66 @@
+package postgres_test
+
+import (
+	"bytes"
+	"context"
+	"log/slog"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/mathefer/tc-fiap-product/pkg/storage/postgres"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+func TestPoolConfig_Apply(t *testing.T) {
+	// Arrange
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{Logger: logger.Default.LogMode(logger.Silent)})
+	assert.NoError(t, err)
+	sqlDB, err := db.DB()
+	assert.NoError(t, err)
+
+	// Act
+	postgres.PoolConfig{MaxOpenConns: 7, MaxIdleConns: 3}.Apply(sqlDB)
+
+	// Assert
+	assert.Equal(t, 7, sqlDB.Stats().MaxOpenConnections)
+}
+
+func TestConnect_RetriesUntilDeadline(t *testing.T) {
+	// Arrange - nothing listens on port 1, so every attempt is refused
+	dsn := "host=127.0.0.1 port=1 user=fake password=fake dbname=fake sslmode=disable"
+	config := postgres.ConnectConfig{
+		Timeout:        300 * time.Millisecond,
+		InitialBackoff: 20 * time.Millisecond,
+		MaxBackoff:     50 * time.Millisecond,
+	}
+	var logs bytes.Buffer
+	start := time.Now()
+
+	// Act
+	db, err := postgres.Connect(context.Background(), dsn, config, slog.New(slog.NewTextHandler(&logs, nil)))
+
+	// Assert
+	assert.Error(t, err)
+	assert.Nil(t, db)
+	assert.Contains(t, err.Error(), "failed to connect to database")
+	assert.Less(t, time.Since(start), 2*time.Second)
+	assert.GreaterOrEqual(t, strings.Count(logs.String(), "Database not reachable, retrying"), 2)
+}
+
+func TestConnect_CanceledContext(t *testing.T) {
+	// Arrange
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	config := postgres.ConnectConfig{InitialBackoff: time.Hour, MaxBackoff: time.Hour}
+
+	// Act
+	db, err := postgres.Connect(ctx, "host=127.0.0.1 port=1 user=fake password=fake dbname=fake sslmode=disable", config, slog.New(slog.DiscardHandler))
+
+	// Assert - no retry once the caller gave up
+	assert.Error(t, err)
+	assert.Nil(t, db)
+}
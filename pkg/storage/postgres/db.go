@@ -1,14 +1,16 @@
 package postgres
 
 import (
+	"context"
 	"errors"
 	"fmt"
-	"log"
-	"os"
+	"log/slog"
+	"time"
 
-	productEntities "github.com/mathefer/tc-fiap-product/internal/product/domain/entities"
 	"gorm.io/driver/postgres"
 	"gorm.io/gorm"
+
+	"github.com/mathefer/tc-fiap-product/pkg/logging"
 )
 
 // DBConfig holds database configuration
@@ -24,40 +26,41 @@ type DBConfig struct {
 // ErrMissingEnvVars is returned when required environment variables are not set
 var ErrMissingEnvVars = errors.New("database environment variables are not properly set")
 
+// Settings configures NewPostgresDB.
+type Settings struct {
+	DSN     string
+	Connect ConnectConfig
+	// QueryTimeout bounds every statement; zero disables it.
+	QueryTimeout time.Duration
+	// MigrateOnStart applies pending migrations once connected, instead of
+	// leaving them to the "migrate up" subcommand.
+	MigrateOnStart bool
+}
+
 // NewPostgresDB creates a new PostgreSQL database connection.
-// It reads configuration from environment variables and panics on failure.
+// It retries until the database answers or settings.Connect.Timeout passes
+// and, when settings.MigrateOnStart is set, applies pending migrations.
+// GORM's own messages (slow queries, errors) go to logger.
 // For production use.
-func NewPostgresDB() *gorm.DB {
-	dsn, err := NewDBConfigFromEnv()
-	if err != nil {
-		log.Fatalf("Failed to load database config: %v", err)
-	}
-
-	db, err := NewDB(dsn)
+func NewPostgresDB(settings Settings, logger *slog.Logger) (*gorm.DB, error) {
+	db, err := Connect(context.Background(), settings.DSN, settings.Connect, logger)
 	if err != nil {
-		log.Fatalf("Failed to connect to database: %v", err)
+		return nil, err
 	}
+	db.Logger = logging.NewGormLogger(logger)
 
-	if err := Migrate(db); err != nil {
-		log.Fatalf("Failed to migrate database: %v", err)
+	if err := db.Use(NewQueryTimeout(settings.QueryTimeout)); err != nil {
+		return nil, err
 	}
 
-	return db
-}
-
-// NewDBConfigFromEnv reads database configuration from environment variables.
-// Returns error if any required variable is missing.
-func NewDBConfigFromEnv() (string, error) {
-	config := DBConfig{
-		Host:     os.Getenv("DB_HOST"),
-		Port:     os.Getenv("DB_PORT"),
-		User:     os.Getenv("DB_USER"),
-		Password: os.Getenv("DB_PASSWORD"),
-		DBName:   os.Getenv("DB_NAME"),
-		SSLMode:  os.Getenv("DB_SSLMODE"),
+	if settings.MigrateOnStart {
+		if err := Migrate(context.Background(), db, logger); err != nil {
+			return nil, err
+		}
 	}
 
-	return BuildDSN(config)
+	logger.Info("Connected to database")
+	return db, nil
 }
 
 // BuildDSN constructs a PostgreSQL DSN from the given config.
@@ -75,18 +78,66 @@ func BuildDSN(config DBConfig) (string, error) {
 // NewDB creates a new GORM database connection with the given DSN.
 // Returns error if connection fails.
 func NewDB(dsn string) (*gorm.DB, error) {
-	db, err := gorm.Open(postgres.Open(dsn), &gorm.Config{})
+	db, err := gorm.Open(postgres.Open(dsn), &gorm.Config{TranslateError: true})
 	if err != nil {
 		return nil, fmt.Errorf("failed to connect to database: %w", err)
 	}
 	return db, nil
 }
 
-// Migrate runs database migrations for all entities.
+// Migrate applies the pending versioned migrations.
 // Returns error if migration fails.
-func Migrate(db *gorm.DB) error {
-	if err := db.AutoMigrate(&productEntities.Product{}); err != nil {
+func Migrate(ctx context.Context, db *gorm.DB, logger *slog.Logger) error {
+	migrator, err := newMigrator(db, logger)
+	if err != nil {
+		return err
+	}
+	if _, err := migrator.Up(ctx); err != nil {
 		return fmt.Errorf("failed to migrate database: %w", err)
 	}
 	return nil
 }
+
+// Ping checks that the database accepts connections.
+func Ping(ctx context.Context, db *gorm.DB) error {
+	sqlDB, err := db.DB()
+	if err != nil {
+		return err
+	}
+	return sqlDB.PingContext(ctx)
+}
+
+// CheckMigrations returns an error while migrations are pending, e.g.
+// because the "migrate up" job has not finished yet. It only reads the
+// schema, so it is safe to run on every readiness probe.
+func CheckMigrations(ctx context.Context, db *gorm.DB) error {
+	if !db.WithContext(ctx).Migrator().HasTable("schema_migrations") {
+		return errors.New("schema_migrations table is missing")
+	}
+	migrator, err := newMigrator(db, slog.Default())
+	if err != nil {
+		return err
+	}
+	pending, err := migrator.Pending(ctx)
+	if err != nil {
+		return err
+	}
+	if pending > 0 {
+		return fmt.Errorf("%d migrations pending", pending)
+	}
+	return nil
+}
+
+// newMigrator creates a Migrator on db's connection pool, leaving out the
+// advisory lock on databases other than PostgreSQL.
+func newMigrator(db *gorm.DB, logger *slog.Logger) (*Migrator, error) {
+	sqlDB, err := db.DB()
+	if err != nil {
+		return nil, err
+	}
+	var opts []MigratorOption
+	if db.Dialector.Name() != "postgres" {
+		opts = append(opts, WithoutAdvisoryLock())
+	}
+	return NewMigrator(sqlDB, logger, opts...)
+}
@@ -1,11 +1,15 @@
 package postgres_test
 
 import (
-	"os"
+	"context"
+	"log/slog"
 	"testing"
 
 	"github.com/mathefer/tc-fiap-product/pkg/storage/postgres"
 	"github.com/stretchr/testify/assert"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
 )
 
 func TestBuildDSN_Success(t *testing.T) {
@@ -165,54 +169,6 @@ func TestBuildDSN_AllFieldsEmpty(t *testing.T) {
 	assert.Empty(t, dsn)
 }
 
-func TestNewDBConfigFromEnv_Success(t *testing.T) {
-	// Arrange - Set environment variables
-	os.Setenv("DB_HOST", "localhost")
-	os.Setenv("DB_PORT", "5432")
-	os.Setenv("DB_USER", "testuser")
-	os.Setenv("DB_PASSWORD", "testpass")
-	os.Setenv("DB_NAME", "testdb")
-	os.Setenv("DB_SSLMODE", "disable")
-	defer func() {
-		os.Unsetenv("DB_HOST")
-		os.Unsetenv("DB_PORT")
-		os.Unsetenv("DB_USER")
-		os.Unsetenv("DB_PASSWORD")
-		os.Unsetenv("DB_NAME")
-		os.Unsetenv("DB_SSLMODE")
-	}()
-
-	// Act
-	dsn, err := postgres.NewDBConfigFromEnv()
-
-	// Assert
-	assert.NoError(t, err)
-	assert.Contains(t, dsn, "host=localhost")
-	assert.Contains(t, dsn, "port=5432")
-	assert.Contains(t, dsn, "user=testuser")
-	assert.Contains(t, dsn, "password=testpass")
-	assert.Contains(t, dsn, "dbname=testdb")
-	assert.Contains(t, dsn, "sslmode=disable")
-}
-
-func TestNewDBConfigFromEnv_MissingVariables(t *testing.T) {
-	// Arrange - Ensure environment variables are not set
-	os.Unsetenv("DB_HOST")
-	os.Unsetenv("DB_PORT")
-	os.Unsetenv("DB_USER")
-	os.Unsetenv("DB_PASSWORD")
-	os.Unsetenv("DB_NAME")
-	os.Unsetenv("DB_SSLMODE")
-
-	// Act
-	dsn, err := postgres.NewDBConfigFromEnv()
-
-	// Assert
-	assert.Error(t, err)
-	assert.ErrorIs(t, err, postgres.ErrMissingEnvVars)
-	assert.Empty(t, dsn)
-}
-
 func TestNewDB_InvalidDSN(t *testing.T) {
 	// Arrange - Use an invalid DSN that will fail to connect
 	invalidDSN := "host=invalid_host_that_does_not_exist port=9999 user=fake password=fake dbname=fake sslmode=disable"
@@ -245,3 +201,42 @@ func TestDBConfig_StructFields(t *testing.T) {
 	assert.Equal(t, "testdb", config.DBName)
 	assert.Equal(t, "disable", config.SSLMode)
 }
+
+func TestCheckMigrations(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	db := openMigrationDB(t)
+
+	// Act & Assert - an empty database is not migrated, and checking it does
+	// not create schema_migrations
+	assert.Error(t, postgres.CheckMigrations(ctx, db))
+	assert.False(t, db.Migrator().HasTable("schema_migrations"))
+
+	// Act & Assert - a partially migrated database is not ready either
+	sqlDB, err := db.DB()
+	assert.NoError(t, err)
+	migrator, err := postgres.NewMigrator(sqlDB, slog.New(slog.DiscardHandler), postgres.WithoutAdvisoryLock())
+	assert.NoError(t, err)
+	statuses, err := migrator.Status(ctx)
+	assert.NoError(t, err)
+	assert.NoError(t, db.Exec("INSERT INTO schema_migrations (version, name) VALUES (?, ?)", statuses[0].Version, statuses[0].Name).Error)
+	assert.ErrorContains(t, postgres.CheckMigrations(ctx, db), "pending")
+
+	// Act & Assert - once every migration is recorded the schema is ready
+	for _, status := range statuses[1:] {
+		assert.NoError(t, db.Exec("INSERT INTO schema_migrations (version, name) VALUES (?, ?)", status.Version, status.Name).Error)
+	}
+	assert.NoError(t, postgres.CheckMigrations(ctx, db))
+}
+
+func TestPing(t *testing.T) {
+	// Arrange
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{Logger: logger.Default.LogMode(logger.Silent)})
+	assert.NoError(t, err)
+
+	// Act
+	err = postgres.Ping(context.Background(), db)
+
+	// Assert
+	assert.NoError(t, err)
+}
@@ -0,0 +1,387 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"embed"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"log/slog"
+	"regexp"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+)
+
+//go:embed migrations/*.sql
+var embeddedMigrations embed.FS
+
+// loadEmbeddedMigrations parses embeddedMigrations once, as they cannot change
+// while the binary runs.
+var loadEmbeddedMigrations = sync.OnceValues(func() ([]Migration, error) {
+	return LoadMigrations(embeddedMigrations)
+})
+
+// migrationLockID is the pg_advisory_lock key held while migrating, so that
+// replicas starting together apply each migration exactly once.
+const migrationLockID int64 = 7_261_204_318
+
+// migrationFileName matches files such as 0003_product_price_money.up.sql.
+var migrationFileName = regexp.MustCompile(`^(\d+)_(\w+)\.(up|down)\.sql$`)
+
+// ErrMigrateUsage is returned by RunMigrateCommand for unknown arguments.
+var ErrMigrateUsage = errors.New("usage: migrate up|down|status")
+
+// Migration is one versioned schema change and the SQL that undoes it.
+type Migration struct {
+	Version int64
+	Name    string
+	Up      string
+	Down    string
+}
+
+// MigrationStatus tells whether a migration has been applied, and when.
+type MigrationStatus struct {
+	Migration
+	AppliedAt *time.Time
+}
+
+// Migrator applies the versioned migrations and records them in the
+// schema_migrations table.
+type Migrator struct {
+	db           *sql.DB
+	migrations   []Migration
+	advisoryLock bool
+	logger       *slog.Logger
+}
+
+// MigratorOption customizes a Migrator.
+type MigratorOption func(*Migrator)
+
+// WithMigrations replaces the embedded migrations, e.g. with ones written for
+// another database in tests.
+func WithMigrations(migrations []Migration) MigratorOption {
+	return func(m *Migrator) {
+		m.migrations = migrations
+	}
+}
+
+// WithoutAdvisoryLock skips pg_advisory_lock, for databases that lack it.
+func WithoutAdvisoryLock() MigratorOption {
+	return func(m *Migrator) {
+		m.advisoryLock = false
+	}
+}
+
+// NewMigrator creates a Migrator for the migrations embedded in the binary.
+func NewMigrator(db *sql.DB, logger *slog.Logger, opts ...MigratorOption) (*Migrator, error) {
+	migrations, err := loadEmbeddedMigrations()
+	if err != nil {
+		return nil, err
+	}
+
+	m := &Migrator{db: db, migrations: migrations, advisoryLock: true, logger: logger}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m, nil
+}
+
+// LoadMigrations reads the NNNN_name.up.sql and NNNN_name.down.sql files
+// found anywhere in fsys, ordered by version. Every version needs both files.
+func LoadMigrations(fsys fs.FS) ([]Migration, error) {
+	byVersion := map[int64]*Migration{}
+	err := fs.WalkDir(fsys, ".", func(path string, d fs.DirEntry, err error) error {
+		if err != nil || d.IsDir() {
+			return err
+		}
+		match := migrationFileName.FindStringSubmatch(d.Name())
+		if match == nil {
+			return nil
+		}
+
+		version, err := strconv.ParseInt(match[1], 10, 64)
+		if err != nil {
+			return fmt.Errorf("migration %s: %w", d.Name(), err)
+		}
+		body, err := fs.ReadFile(fsys, path)
+		if err != nil {
+			return err
+		}
+
+		migration, ok := byVersion[version]
+		if !ok {
+			migration = &Migration{Version: version, Name: match[2]}
+			byVersion[version] = migration
+		}
+		if migration.Name != match[2] {
+			return fmt.Errorf("migration %d is named both %q and %q", version, migration.Name, match[2])
+		}
+		target := &migration.Up
+		if match[3] == "down" {
+			target = &migration.Down
+		}
+		if *target != "" {
+			return fmt.Errorf("migration %s is duplicated", d.Name())
+		}
+		*target = string(body)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	migrations := make([]Migration, 0, len(byVersion))
+	for _, migration := range byVersion {
+		if migration.Up == "" || migration.Down == "" {
+			return nil, fmt.Errorf("migration %04d_%s needs both up and down files", migration.Version, migration.Name)
+		}
+		migrations = append(migrations, *migration)
+	}
+	sort.Slice(migrations, func(i, j int) bool { return migrations[i].Version < migrations[j].Version })
+	return migrations, nil
+}
+
+// Up applies every pending migration in version order, each in its own
+// transaction, and returns the ones it applied.
+func (m *Migrator) Up(ctx context.Context) ([]Migration, error) {
+	var applied []Migration
+	err := m.locked(ctx, func(conn *sql.Conn) error {
+		versions, err := appliedVersions(ctx, conn)
+		if err != nil {
+			return err
+		}
+		for _, migration := range m.migrations {
+			if _, ok := versions[migration.Version]; ok {
+				continue
+			}
+			err := inTx(ctx, conn, migration.Up,
+				"INSERT INTO schema_migrations (version, name) VALUES ($1, $2)", migration.Version, migration.Name)
+			if err != nil {
+				return fmt.Errorf("migration %04d_%s: %w", migration.Version, migration.Name, err)
+			}
+			m.logger.Info("Migration applied", "version", migration.Version, "name", migration.Name)
+			applied = append(applied, migration)
+		}
+		return nil
+	})
+	return applied, err
+}
+
+// Down rolls back the most recently applied migration and returns it, or nil
+// when nothing has been applied.
+func (m *Migrator) Down(ctx context.Context) (*Migration, error) {
+	var rolledBack *Migration
+	err := m.locked(ctx, func(conn *sql.Conn) error {
+		versions, err := appliedVersions(ctx, conn)
+		if err != nil {
+			return err
+		}
+		var latest int64 = -1
+		for version := range versions {
+			latest = max(latest, version)
+		}
+		if latest < 0 {
+			return nil
+		}
+
+		migration, ok := m.find(latest)
+		if !ok {
+			return fmt.Errorf("migration %d is applied but unknown to this binary", latest)
+		}
+		err = inTx(ctx, conn, migration.Down, "DELETE FROM schema_migrations WHERE version = $1", migration.Version)
+		if err != nil {
+			return fmt.Errorf("migration %04d_%s: %w", migration.Version, migration.Name, err)
+		}
+		m.logger.Info("Migration rolled back", "version", migration.Version, "name", migration.Name)
+		rolledBack = &migration
+		return nil
+	})
+	return rolledBack, err
+}
+
+// Status lists every known migration and when it was applied, creating the
+// schema_migrations table when it is missing.
+func (m *Migrator) Status(ctx context.Context) ([]MigrationStatus, error) {
+	conn, err := m.db.Conn(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+
+	if err := createMigrationsTable(ctx, conn); err != nil {
+		return nil, err
+	}
+	versions, err := appliedVersions(ctx, conn)
+	if err != nil {
+		return nil, err
+	}
+
+	statuses := make([]MigrationStatus, 0, len(m.migrations))
+	for _, migration := range m.migrations {
+		status := MigrationStatus{Migration: migration}
+		if appliedAt, ok := versions[migration.Version]; ok {
+			status.AppliedAt = &appliedAt
+		}
+		statuses = append(statuses, status)
+	}
+	return statuses, nil
+}
+
+// Pending returns how many known migrations have not been applied. Unlike
+// Status it only reads schema_migrations, which must already exist, so that
+// it can back a readiness probe without running DDL.
+func (m *Migrator) Pending(ctx context.Context) (int, error) {
+	conn, err := m.db.Conn(ctx)
+	if err != nil {
+		return 0, err
+	}
+	defer conn.Close()
+
+	versions, err := appliedVersions(ctx, conn)
+	if err != nil {
+		return 0, err
+	}
+	pending := 0
+	for _, migration := range m.migrations {
+		if _, ok := versions[migration.Version]; !ok {
+			pending++
+		}
+	}
+	return pending, nil
+}
+
+func (m *Migrator) find(version int64) (Migration, bool) {
+	for _, migration := range m.migrations {
+		if migration.Version == version {
+			return migration, true
+		}
+	}
+	return Migration{}, false
+}
+
+// locked runs fn on a dedicated connection holding the migration lock. The
+// lock is tied to the session, so another replica blocks until it is released
+// and then finds the migrations already applied.
+func (m *Migrator) locked(ctx context.Context, fn func(conn *sql.Conn) error) (err error) {
+	conn, err := m.db.Conn(ctx)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	if m.advisoryLock {
+		if _, err := conn.ExecContext(ctx, "SELECT pg_advisory_lock($1)", migrationLockID); err != nil {
+			return fmt.Errorf("failed to acquire migration lock: %w", err)
+		}
+		defer func() {
+			_, unlockErr := conn.ExecContext(context.WithoutCancel(ctx), "SELECT pg_advisory_unlock($1)", migrationLockID)
+			if unlockErr != nil && err == nil {
+				err = fmt.Errorf("failed to release migration lock: %w", unlockErr)
+			}
+		}()
+	}
+
+	if err := createMigrationsTable(ctx, conn); err != nil {
+		return err
+	}
+	return fn(conn)
+}
+
+func createMigrationsTable(ctx context.Context, conn *sql.Conn) error {
+	_, err := conn.ExecContext(ctx, `CREATE TABLE IF NOT EXISTS schema_migrations (
+		version    BIGINT PRIMARY KEY,
+		name       VARCHAR(255) NOT NULL,
+		applied_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+	)`)
+	if err != nil {
+		return fmt.Errorf("failed to create schema_migrations: %w", err)
+	}
+	return nil
+}
+
+func appliedVersions(ctx context.Context, conn *sql.Conn) (map[int64]time.Time, error) {
+	rows, err := conn.QueryContext(ctx, "SELECT version, applied_at FROM schema_migrations")
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	versions := map[int64]time.Time{}
+	for rows.Next() {
+		var version int64
+		var appliedAt time.Time
+		if err := rows.Scan(&version, &appliedAt); err != nil {
+			return nil, err
+		}
+		versions[version] = appliedAt
+	}
+	return versions, rows.Err()
+}
+
+// inTx runs a migration script and the statement recording it atomically.
+func inTx(ctx context.Context, conn *sql.Conn, script, record string, args ...any) error {
+	tx, err := conn.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	if _, err := tx.ExecContext(ctx, script); err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+	if _, err := tx.ExecContext(ctx, record, args...); err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+	return tx.Commit()
+}
+
+// RunMigrateCommand implements the "migrate up|down|status" subcommand,
+// writing a line per migration to out.
+func RunMigrateCommand(ctx context.Context, m *Migrator, args []string, out io.Writer) error {
+	if len(args) != 1 {
+		return ErrMigrateUsage
+	}
+
+	switch args[0] {
+	case "up":
+		applied, err := m.Up(ctx)
+		if err != nil {
+			return err
+		}
+		if len(applied) == 0 {
+			fmt.Fprintln(out, "no pending migrations")
+		}
+		for _, migration := range applied {
+			fmt.Fprintf(out, "applied %04d_%s\n", migration.Version, migration.Name)
+		}
+	case "down":
+		migration, err := m.Down(ctx)
+		if err != nil {
+			return err
+		}
+		if migration == nil {
+			fmt.Fprintln(out, "no migrations to roll back")
+			return nil
+		}
+		fmt.Fprintf(out, "rolled back %04d_%s\n", migration.Version, migration.Name)
+	case "status":
+		statuses, err := m.Status(ctx)
+		if err != nil {
+			return err
+		}
+		for _, status := range statuses {
+			state := "pending"
+			if status.AppliedAt != nil {
+				state = "applied " + status.AppliedAt.UTC().Format(time.RFC3339)
+			}
+			fmt.Fprintf(out, "%04d_%s\t%s\n", status.Version, status.Name, state)
+		}
+	default:
+		return ErrMigrateUsage
+	}
+	return nil
+}
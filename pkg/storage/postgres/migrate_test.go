@@ -0,0 +1,254 @@
+package postgres_test
+
+import (
+	"bytes"
+	"context"
+	"log/slog"
+	"regexp"
+	"testing"
+	"testing/fstest"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/mathefer/tc-fiap-product/pkg/storage/postgres"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+// sqliteMigrations stands in for the embedded PostgreSQL migrations.
+var sqliteMigrations = fstest.MapFS{
+	"0001_create_widget.up.sql":   {Data: []byte("CREATE TABLE widget (id INTEGER PRIMARY KEY, name TEXT);")},
+	"0001_create_widget.down.sql": {Data: []byte("DROP TABLE widget;")},
+	"0002_widget_color.up.sql":    {Data: []byte("ALTER TABLE widget ADD COLUMN color TEXT;")},
+	"0002_widget_color.down.sql":  {Data: []byte("ALTER TABLE widget DROP COLUMN color;")},
+	"README.md":                   {Data: []byte("not a migration")},
+}
+
+func openMigrationDB(t *testing.T) *gorm.DB {
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{Logger: logger.Default.LogMode(logger.Silent)})
+	assert.NoError(t, err)
+	sqlDB, err := db.DB()
+	assert.NoError(t, err)
+	// Every connection to :memory: is a separate database.
+	sqlDB.SetMaxOpenConns(1)
+	return db
+}
+
+func newSQLiteMigrator(t *testing.T, db *gorm.DB, fsys fstest.MapFS) *postgres.Migrator {
+	migrations, err := postgres.LoadMigrations(fsys)
+	assert.NoError(t, err)
+	sqlDB, err := db.DB()
+	assert.NoError(t, err)
+	migrator, err := postgres.NewMigrator(sqlDB, slog.New(slog.DiscardHandler),
+		postgres.WithMigrations(migrations), postgres.WithoutAdvisoryLock())
+	assert.NoError(t, err)
+	return migrator
+}
+
+func TestLoadMigrations(t *testing.T) {
+	// Act
+	migrations, err := postgres.LoadMigrations(sqliteMigrations)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Len(t, migrations, 2)
+	assert.Equal(t, int64(1), migrations[0].Version)
+	assert.Equal(t, "create_widget", migrations[0].Name)
+	assert.Equal(t, "DROP TABLE widget;", migrations[0].Down)
+	assert.Equal(t, int64(2), migrations[1].Version)
+}
+
+func TestLoadMigrations_Invalid(t *testing.T) {
+	tests := []struct {
+		name  string
+		files fstest.MapFS
+	}{
+		{"missing down", fstest.MapFS{"0001_a.up.sql": {Data: []byte("SELECT 1;")}}},
+		{"name mismatch", fstest.MapFS{
+			"0001_a.up.sql":   {Data: []byte("SELECT 1;")},
+			"0001_b.down.sql": {Data: []byte("SELECT 1;")},
+		}},
+		{"duplicate version", fstest.MapFS{
+			"0001_a.up.sql":       {Data: []byte("SELECT 1;")},
+			"0001_a.down.sql":     {Data: []byte("SELECT 1;")},
+			"dir/0001_a.up.sql":   {Data: []byte("SELECT 2;")},
+			"dir/0001_a.down.sql": {Data: []byte("SELECT 2;")},
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Act
+			_, err := postgres.LoadMigrations(tt.files)
+
+			// Assert
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestNewMigrator_EmbeddedMigrations(t *testing.T) {
+	// Arrange
+	db := openMigrationDB(t)
+	sqlDB, err := db.DB()
+	assert.NoError(t, err)
+	migrator, err := postgres.NewMigrator(sqlDB, slog.New(slog.DiscardHandler), postgres.WithoutAdvisoryLock())
+	assert.NoError(t, err)
+
+	// Act
+	statuses, err := migrator.Status(context.Background())
+
+	// Assert - versions are contiguous and nothing is applied yet
+	assert.NoError(t, err)
+	assert.NotEmpty(t, statuses)
+	for i, status := range statuses {
+		assert.Equal(t, int64(i+1), status.Version)
+		assert.Nil(t, status.AppliedAt)
+	}
+}
+
+func TestMigrator_UpDownStatus(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	db := openMigrationDB(t)
+	migrator := newSQLiteMigrator(t, db, sqliteMigrations)
+
+	// Act & Assert - Up applies everything once
+	applied, err := migrator.Up(ctx)
+	assert.NoError(t, err)
+	assert.Len(t, applied, 2)
+	assert.True(t, db.Migrator().HasColumn("widget", "color"))
+
+	applied, err = migrator.Up(ctx)
+	assert.NoError(t, err)
+	assert.Empty(t, applied)
+
+	pending, err := migrator.Pending(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, 0, pending)
+
+	// Act & Assert - Down rolls back only the latest migration
+	rolledBack, err := migrator.Down(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, "widget_color", rolledBack.Name)
+	assert.True(t, db.Migrator().HasTable("widget"))
+	assert.False(t, db.Migrator().HasColumn("widget", "color"))
+
+	statuses, err := migrator.Status(ctx)
+	assert.NoError(t, err)
+	assert.NotNil(t, statuses[0].AppliedAt)
+	assert.Nil(t, statuses[1].AppliedAt)
+
+	// Act & Assert - Down with nothing applied is a no-op
+	_, err = migrator.Down(ctx)
+	assert.NoError(t, err)
+	rolledBack, err = migrator.Down(ctx)
+	assert.NoError(t, err)
+	assert.Nil(t, rolledBack)
+	assert.False(t, db.Migrator().HasTable("widget"))
+}
+
+func TestMigrator_Up_FailedMigrationIsNotRecorded(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	db := openMigrationDB(t)
+	migrator := newSQLiteMigrator(t, db, fstest.MapFS{
+		"0001_create_widget.up.sql":   sqliteMigrations["0001_create_widget.up.sql"],
+		"0001_create_widget.down.sql": sqliteMigrations["0001_create_widget.down.sql"],
+		"0002_broken.up.sql":          {Data: []byte("ALTER TABLE missing ADD COLUMN color TEXT;")},
+		"0002_broken.down.sql":        {Data: []byte("SELECT 1;")},
+	})
+
+	// Act
+	applied, err := migrator.Up(ctx)
+
+	// Assert
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "0002_broken")
+	assert.Len(t, applied, 1)
+	pending, err := migrator.Pending(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, pending)
+}
+
+func TestMigrator_Up_HoldsAdvisoryLock(t *testing.T) {
+	// Arrange
+	sqlDB, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer sqlDB.Close()
+
+	migrations, err := postgres.LoadMigrations(fstest.MapFS{
+		"0001_create_widget.up.sql":   sqliteMigrations["0001_create_widget.up.sql"],
+		"0001_create_widget.down.sql": sqliteMigrations["0001_create_widget.down.sql"],
+	})
+	assert.NoError(t, err)
+	migrator, err := postgres.NewMigrator(sqlDB, slog.New(slog.DiscardHandler), postgres.WithMigrations(migrations))
+	assert.NoError(t, err)
+
+	mock.ExpectExec(regexp.QuoteMeta("SELECT pg_advisory_lock($1)")).WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec("CREATE TABLE IF NOT EXISTS schema_migrations").WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectQuery("SELECT version, applied_at FROM schema_migrations").
+		WillReturnRows(sqlmock.NewRows([]string{"version", "applied_at"}))
+	mock.ExpectBegin()
+	mock.ExpectExec("CREATE TABLE widget").WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec("INSERT INTO schema_migrations").
+		WithArgs(int64(1), "create_widget").WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+	mock.ExpectExec(regexp.QuoteMeta("SELECT pg_advisory_unlock($1)")).WillReturnResult(sqlmock.NewResult(0, 0))
+
+	// Act
+	applied, err := migrator.Up(context.Background())
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Len(t, applied, 1)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestMigrator_Pending_OnlyReads(t *testing.T) {
+	// Arrange
+	sqlDB, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer sqlDB.Close()
+
+	migrations, err := postgres.LoadMigrations(sqliteMigrations)
+	assert.NoError(t, err)
+	migrator, err := postgres.NewMigrator(sqlDB, slog.New(slog.DiscardHandler), postgres.WithMigrations(migrations))
+	assert.NoError(t, err)
+
+	mock.ExpectQuery("SELECT version, applied_at FROM schema_migrations").
+		WillReturnRows(sqlmock.NewRows([]string{"version", "applied_at"}).AddRow(int64(1), time.Now()))
+
+	// Act
+	pending, err := migrator.Pending(context.Background())
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, 1, pending)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRunMigrateCommand(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	db := openMigrationDB(t)
+	migrator := newSQLiteMigrator(t, db, sqliteMigrations)
+	var out bytes.Buffer
+
+	// Act & Assert
+	assert.NoError(t, postgres.RunMigrateCommand(ctx, migrator, []string{"up"}, &out))
+	assert.Equal(t, "applied 0001_create_widget\napplied 0002_widget_color\n", out.String())
+
+	out.Reset()
+	assert.NoError(t, postgres.RunMigrateCommand(ctx, migrator, []string{"down"}, &out))
+	assert.Equal(t, "rolled back 0002_widget_color\n", out.String())
+
+	out.Reset()
+	assert.NoError(t, postgres.RunMigrateCommand(ctx, migrator, []string{"status"}, &out))
+	assert.Regexp(t, `^0001_create_widget\tapplied \S+\n0002_widget_color\tpending\n$`, out.String())
+
+	assert.ErrorIs(t, postgres.RunMigrateCommand(ctx, migrator, nil, &out), postgres.ErrMigrateUsage)
+	assert.ErrorIs(t, postgres.RunMigrateCommand(ctx, migrator, []string{"sideways"}, &out), postgres.ErrMigrateUsage)
+}
@@ -0,0 +1,23 @@
+CREATE TABLE IF NOT EXISTS category (
+    id           BIGSERIAL PRIMARY KEY,
+    created_at   TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP,
+    display_name VARCHAR(100) NOT NULL,
+    sort_order   BIGINT NOT NULL,
+    active       BOOLEAN NOT NULL
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_category_display_name ON category (display_name);
+
+-- The default categories keep their historical IDs, which existing products
+-- refer to; a database seeded before versioned migrations is left alone.
+INSERT INTO category (id, display_name, sort_order, active)
+SELECT v.id, v.display_name, v.sort_order, v.active
+FROM (VALUES
+    (1, 'Lanche', 1, TRUE),
+    (2, 'Acompanhamento', 2, TRUE),
+    (3, 'Bebida', 3, TRUE),
+    (4, 'Sobremesa', 4, TRUE)
+) AS v (id, display_name, sort_order, active)
+WHERE NOT EXISTS (SELECT 1 FROM category);
+
+SELECT setval(pg_get_serial_sequence('category', 'id'), (SELECT MAX(id) FROM category));
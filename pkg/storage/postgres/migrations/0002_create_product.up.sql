@@ -0,0 +1,32 @@
+CREATE TABLE IF NOT EXISTS product (
+    id          BIGSERIAL PRIMARY KEY,
+    created_at  TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP,
+    name        VARCHAR(255) NOT NULL,
+    category    BIGINT NOT NULL,
+    description VARCHAR(255),
+    image_link  VARCHAR(255)
+);
+
+CREATE INDEX IF NOT EXISTS idx_product_category ON product (category);
+
+-- Databases created by AutoMigrate may hold products whose category was never
+-- seeded, which would make the foreign key below fail. Each missing ID gets an
+-- inactive placeholder category instead, so those products are kept and stay
+-- readable, while new products are rejected until the category is renamed and
+-- reactivated.
+INSERT INTO category (id, display_name, sort_order, active)
+SELECT DISTINCT p.category, 'Unknown category ' || p.category, p.category, FALSE
+FROM product AS p
+WHERE NOT EXISTS (SELECT 1 FROM category AS c WHERE c.id = p.category);
+
+SELECT setval(pg_get_serial_sequence('category', 'id'), (SELECT MAX(id) FROM category));
+
+DO $$
+BEGIN
+    IF NOT EXISTS (SELECT 1 FROM pg_constraint WHERE conname = 'fk_product_category_ref') THEN
+        ALTER TABLE product ADD CONSTRAINT fk_product_category_ref
+            FOREIGN KEY (category) REFERENCES category (id)
+            ON UPDATE CASCADE ON DELETE RESTRICT;
+    END IF;
+END
+$$;
@@ -0,0 +1,4 @@
+ALTER TABLE product ADD COLUMN price DECIMAL;
+UPDATE product SET price = price_amount / 100.0;
+ALTER TABLE product DROP COLUMN price_currency;
+ALTER TABLE product DROP COLUMN price_amount;
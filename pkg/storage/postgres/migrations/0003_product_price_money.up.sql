@@ -0,0 +1,16 @@
+ALTER TABLE product ADD COLUMN IF NOT EXISTS price_amount BIGINT NOT NULL DEFAULT 0;
+ALTER TABLE product ADD COLUMN IF NOT EXISTS price_currency VARCHAR(3) NOT NULL DEFAULT 'BRL';
+
+-- Databases created before prices were stored in cents still have the float
+-- price column; move it into price_amount and drop it.
+DO $$
+BEGIN
+    IF EXISTS (
+        SELECT 1 FROM information_schema.columns
+        WHERE table_schema = current_schema() AND table_name = 'product' AND column_name = 'price'
+    ) THEN
+        UPDATE product SET price_amount = ROUND(price * 100), price_currency = 'BRL';
+        ALTER TABLE product DROP COLUMN price;
+    END IF;
+END
+$$;
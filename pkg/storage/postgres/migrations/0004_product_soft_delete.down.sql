@@ -0,0 +1,3 @@
+-- Products deleted while soft delete was in place become visible again.
+DROP INDEX IF EXISTS idx_product_deleted_at;
+ALTER TABLE product DROP COLUMN IF EXISTS deleted_at;
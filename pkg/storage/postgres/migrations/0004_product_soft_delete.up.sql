@@ -0,0 +1,3 @@
+ALTER TABLE product ADD COLUMN IF NOT EXISTS deleted_at TIMESTAMPTZ;
+
+CREATE INDEX IF NOT EXISTS idx_product_deleted_at ON product (deleted_at);
@@ -0,0 +1,11 @@
+CREATE TABLE IF NOT EXISTS idempotency_key (
+    key           VARCHAR(255) PRIMARY KEY,
+    request_hash  VARCHAR(64) NOT NULL,
+    status_code   INTEGER NOT NULL DEFAULT 0,
+    content_type  VARCHAR(255) NOT NULL DEFAULT '',
+    location      VARCHAR(255) NOT NULL DEFAULT '',
+    response_body BYTEA,
+    created_at    TIMESTAMPTZ NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_idempotency_key_created_at ON idempotency_key (created_at);
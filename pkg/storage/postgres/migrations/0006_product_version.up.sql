@@ -0,0 +1,3 @@
+-- Incremented on every update so that concurrent writers can detect that the
+-- product changed since they read it.
+ALTER TABLE product ADD COLUMN IF NOT EXISTS version BIGINT NOT NULL DEFAULT 1;
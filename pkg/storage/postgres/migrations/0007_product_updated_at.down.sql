@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS idx_product_updated_at;
+ALTER TABLE product DROP COLUMN IF EXISTS updated_at;
@@ -0,0 +1,10 @@
+-- Set on every write, including soft deletion, so that readers can tell
+-- whether the catalog changed since they last fetched it.
+ALTER TABLE product ADD COLUMN IF NOT EXISTS updated_at TIMESTAMPTZ;
+
+UPDATE product SET updated_at = COALESCE(deleted_at, created_at, CURRENT_TIMESTAMP) WHERE updated_at IS NULL;
+
+ALTER TABLE product ALTER COLUMN updated_at SET DEFAULT CURRENT_TIMESTAMP;
+ALTER TABLE product ALTER COLUMN updated_at SET NOT NULL;
+
+CREATE INDEX IF NOT EXISTS idx_product_updated_at ON product (updated_at);
@@ -0,0 +1,3 @@
+-- Replayed with the stored response so that a retried creation still gets
+-- the version to send as If-Match.
+ALTER TABLE idempotency_key ADD COLUMN IF NOT EXISTS etag VARCHAR(255) NOT NULL DEFAULT '';
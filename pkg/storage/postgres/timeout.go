@@ -0,0 +1,85 @@
+package postgres
+
+import (
+	"context"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+const timeoutKey = "timeout:state"
+
+// timeoutState remembers the caller's context so that it can be put back:
+// chained queries such as Count followed by Find share one statement.
+type timeoutState struct {
+	parent context.Context
+	cancel context.CancelFunc
+}
+
+// QueryTimeout is a GORM plugin bounding every statement to a maximum
+// duration. The limit applies on top of the caller's context, so a query
+// is canceled by whichever comes first: the request going away or the
+// timeout. Row and Rows are left alone because their results are read
+// after the callbacks return.
+type QueryTimeout struct {
+	timeout time.Duration
+}
+
+var _ gorm.Plugin = (*QueryTimeout)(nil)
+
+func NewQueryTimeout(timeout time.Duration) *QueryTimeout {
+	return &QueryTimeout{timeout: timeout}
+}
+
+func (t *QueryTimeout) Name() string {
+	return "timeout"
+}
+
+func (t *QueryTimeout) Initialize(db *gorm.DB) error {
+	callback := db.Callback()
+	register := []struct {
+		operation string
+		before    func(name string, fn func(*gorm.DB)) error
+		after     func(name string, fn func(*gorm.DB)) error
+	}{
+		{"create", callback.Create().Before("gorm:create").Register, callback.Create().After("gorm:create").Register},
+		{"query", callback.Query().Before("gorm:query").Register, callback.Query().After("gorm:query").Register},
+		{"update", callback.Update().Before("gorm:update").Register, callback.Update().After("gorm:update").Register},
+		{"delete", callback.Delete().Before("gorm:delete").Register, callback.Delete().After("gorm:delete").Register},
+		{"raw", callback.Raw().Before("gorm:raw").Register, callback.Raw().After("gorm:raw").Register},
+	}
+
+	for _, r := range register {
+		if err := r.before("timeout:before_"+r.operation, t.start); err != nil {
+			return err
+		}
+		if err := r.after("timeout:after_"+r.operation, t.stop); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (t *QueryTimeout) start(db *gorm.DB) {
+	if t.timeout <= 0 {
+		return
+	}
+	parent := db.Statement.Context
+	if parent == nil {
+		parent = context.Background()
+	}
+	ctx, cancel := context.WithTimeout(parent, t.timeout)
+	db.Statement.Context = ctx
+	db.InstanceSet(timeoutKey, timeoutState{parent: parent, cancel: cancel})
+}
+
+func (t *QueryTimeout) stop(db *gorm.DB) {
+	value, ok := db.InstanceGet(timeoutKey)
+	if !ok {
+		return
+	}
+	if state, ok := value.(timeoutState); ok {
+		state.cancel()
+		db.Statement.Context = state.parent
+	}
+}
@@ -0,0 +1,73 @@
+package postgres_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/mathefer/tc-fiap-product/pkg/storage/postgres"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+type widget struct {
+	ID   uint
+	Name string
+}
+
+func openWithTimeout(t *testing.T, timeout time.Duration) *gorm.DB {
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{Logger: logger.Default.LogMode(logger.Silent)})
+	assert.NoError(t, err)
+	assert.NoError(t, db.AutoMigrate(&widget{}))
+	assert.NoError(t, db.Create(&widget{Name: "a"}).Error)
+	assert.NoError(t, db.Use(postgres.NewQueryTimeout(timeout)))
+	return db
+}
+
+func TestQueryTimeout_CancelsSlowQueries(t *testing.T) {
+	// Arrange
+	db := openWithTimeout(t, time.Nanosecond)
+
+	// Act
+	var found widget
+	err := db.WithContext(context.Background()).First(&found).Error
+
+	// Assert
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+}
+
+func TestQueryTimeout_ChainedQueriesKeepTheCallerContext(t *testing.T) {
+	// Arrange
+	db := openWithTimeout(t, time.Minute)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	// Act
+	query := db.WithContext(ctx).Model(&widget{})
+	var total int64
+	countErr := query.Count(&total).Error
+	var widgets []widget
+	findErr := query.Find(&widgets).Error
+
+	// Assert
+	assert.NoError(t, countErr)
+	assert.NoError(t, findErr)
+	assert.Equal(t, int64(1), total)
+	assert.Len(t, widgets, 1)
+	assert.NoError(t, ctx.Err())
+}
+
+func TestQueryTimeout_HonoursCanceledCaller(t *testing.T) {
+	// Arrange
+	db := openWithTimeout(t, time.Minute)
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	// Act
+	err := db.WithContext(ctx).Create(&widget{Name: "b"}).Error
+
+	// Assert
+	assert.ErrorIs(t, err, context.Canceled)
+}
@@ -0,0 +1,30 @@
+package sqlite
+
+import (
+	"fmt"
+
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+)
+
+// MemoryPath opens a database that lives only as long as the process.
+const MemoryPath = ":memory:"
+
+// NewDB opens the SQLite database file at path, creating it if needed, with
+// foreign keys enforced as in PostgreSQL.
+// Returns error if the database cannot be opened.
+func NewDB(path string) (*gorm.DB, error) {
+	db, err := gorm.Open(sqlite.Open(path+"?_foreign_keys=1"), &gorm.Config{TranslateError: true})
+	if err != nil {
+		return nil, fmt.Errorf("failed to open sqlite database %q: %w", path, err)
+	}
+
+	sqlDB, err := db.DB()
+	if err != nil {
+		return nil, err
+	}
+	// SQLite takes one writer at a time, and every connection to :memory:
+	// would get a database of its own, so share a single connection.
+	sqlDB.SetMaxOpenConns(1)
+	return db, nil
+}
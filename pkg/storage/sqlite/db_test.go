@@ -0,0 +1,57 @@
+package sqlite_test
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/mathefer/tc-fiap-product/pkg/storage/sqlite"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+)
+
+type parent struct {
+	ID uint
+}
+
+type child struct {
+	ID       uint
+	ParentID uint
+	Parent   *parent `gorm:"constraint:OnDelete:RESTRICT"`
+}
+
+func TestNewDB_Memory(t *testing.T) {
+	// Arrange
+	db, err := sqlite.NewDB(sqlite.MemoryPath)
+	assert.NoError(t, err)
+
+	// Act - later statements see the tables created by earlier ones
+	assert.NoError(t, db.AutoMigrate(&parent{}, &child{}))
+	assert.NoError(t, db.Create(&parent{ID: 1}).Error)
+
+	// Assert
+	var count int64
+	assert.NoError(t, db.Model(&parent{}).Count(&count).Error)
+	assert.Equal(t, int64(1), count)
+}
+
+func TestNewDB_EnforcesForeignKeys(t *testing.T) {
+	// Arrange
+	db, err := sqlite.NewDB(filepath.Join(t.TempDir(), "test.db"))
+	assert.NoError(t, err)
+	assert.NoError(t, db.AutoMigrate(&parent{}, &child{}))
+
+	// Act
+	err = db.Create(&child{ParentID: 42}).Error
+
+	// Assert
+	assert.ErrorIs(t, err, gorm.ErrForeignKeyViolated)
+}
+
+func TestNewDB_InvalidPath(t *testing.T) {
+	// Act
+	db, err := sqlite.NewDB(filepath.Join(t.TempDir(), "missing", "test.db"))
+
+	// Assert
+	assert.Error(t, err)
+	assert.Nil(t, db)
+}
@@ -0,0 +1,67 @@
+package tracing
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+)
+
+// otlpTracesPath is appended to the collector's base URL, as the
+// OTEL_EXPORTER_OTLP_ENDPOINT specification requires.
+const otlpTracesPath = "/v1/traces"
+
+// Config selects where spans are exported. The service fills it from the
+// standard OpenTelemetry environment variables.
+type Config struct {
+	// Exporter is "none", "stdout" or "otlp".
+	Exporter     string
+	OTLPEndpoint string
+	ServiceName  string
+}
+
+// NewTracerProvider builds the tracer provider selected by config. With
+// "none" spans are still created, so trace context keeps propagating and
+// logs carry trace IDs, but nothing is exported.
+func NewTracerProvider(ctx context.Context, config Config) (*sdktrace.TracerProvider, error) {
+	var attributes []attribute.KeyValue
+	if config.ServiceName != "" {
+		attributes = append(attributes, attribute.String("service.name", config.ServiceName))
+	}
+	res, err := resource.Merge(resource.Default(), resource.NewSchemaless(attributes...))
+	if err != nil {
+		return nil, err
+	}
+	options := []sdktrace.TracerProviderOption{sdktrace.WithResource(res)}
+
+	switch config.Exporter {
+	case "", "none":
+	case "stdout", "console":
+		exporter, err := stdouttrace.New(stdouttrace.WithWriter(os.Stdout))
+		if err != nil {
+			return nil, err
+		}
+		options = append(options, sdktrace.WithSyncer(exporter))
+	case "otlp":
+		var exporterOptions []otlptracehttp.Option
+		if config.OTLPEndpoint != "" {
+			exporterOptions = append(exporterOptions, otlptracehttp.WithEndpointURL(strings.TrimSuffix(config.OTLPEndpoint, "/")+otlpTracesPath))
+		}
+		exporter, err := otlptracehttp.New(ctx, exporterOptions...)
+		if err != nil {
+			return nil, err
+		}
+		// Spans are exported in the background and dropped when the queue
+		// is full, so that a slow collector never slows requests down.
+		options = append(options, sdktrace.WithBatcher(exporter))
+	default:
+		return nil, fmt.Errorf("unsupported OTEL_TRACES_EXPORTER %q (use none, stdout or otlp)", config.Exporter)
+	}
+	return sdktrace.NewTracerProvider(options...), nil
+}
@@ -0,0 +1,20 @@
+package tracing
+
+import (
+	"github.com/uptrace/opentelemetry-go-extra/otelgorm"
+	"go.opentelemetry.io/otel/trace"
+	"gorm.io/gorm"
+)
+
+// NewGormTracing returns the otelgorm plugin, which records a client span
+// per query as a child of the span in the statement's context, so queries
+// only join the request's trace when the repository calls db.WithContext(ctx).
+// The SQL is recorded with placeholders, never with the bound values, and
+// connection pool metrics are left to Prometheus.
+func NewGormTracing(provider trace.TracerProvider) gorm.Plugin {
+	return otelgorm.NewPlugin(
+		otelgorm.WithTracerProvider(provider),
+		otelgorm.WithoutQueryVariables(),
+		otelgorm.WithoutMetrics(),
+	)
+}
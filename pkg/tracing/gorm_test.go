@@ -0,0 +1,60 @@
+package tracing_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/mathefer/tc-fiap-product/pkg/tracing"
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+type widget struct {
+	ID   uint
+	Name string
+}
+
+func TestGormTracing_RecordsQueriesInTheRequestTrace(t *testing.T) {
+	// Arrange
+	provider, exporter := useInMemoryTracer(t)
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{Logger: logger.Default.LogMode(logger.Silent)})
+	assert.NoError(t, err)
+	assert.NoError(t, db.AutoMigrate(&widget{}))
+	assert.NoError(t, db.Use(tracing.NewGormTracing(provider)))
+
+	ctx, parent := tracing.Start(context.Background(), "request")
+
+	// Act
+	assert.NoError(t, db.WithContext(ctx).Create(&widget{Name: "a"}).Error)
+	var found widget
+	assert.Error(t, db.WithContext(ctx).First(&found, 99).Error)
+	assert.Error(t, db.WithContext(ctx).Table("missing").Create(map[string]any{"name": "b"}).Error)
+	parent.End()
+
+	// Assert
+	spans := exporter.GetSpans()
+	assert.Len(t, spans, 4)
+
+	create := spans[0]
+	assert.Equal(t, trace.SpanKindClient, create.SpanKind)
+	assert.Equal(t, parent.SpanContext().SpanID(), create.Parent.SpanID())
+	assert.Contains(t, create.Attributes, attribute.String("db.system", "sqlite"))
+	assert.Contains(t, create.Attributes, attribute.String("db.sql.table", "widgets"))
+	assert.Contains(t, create.Attributes, attribute.Int64("db.rows_affected", 1))
+
+	notFound := spans[1]
+	assert.Equal(t, codes.Unset, notFound.Status.Code)
+	for _, kv := range notFound.Attributes {
+		if kv.Key == "db.statement" {
+			assert.NotContains(t, kv.Value.AsString(), "99", "bound values are not recorded")
+		}
+	}
+
+	failed := spans[2]
+	assert.Equal(t, codes.Error, failed.Status.Code)
+}
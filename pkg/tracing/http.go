@@ -0,0 +1,38 @@
+package tracing
+
+import (
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// Middleware starts a server span for every request with otelhttp,
+// continuing the trace from an incoming traceparent header when there is
+// one, and echoes the span's traceparent in the response. It must be
+// installed on the router with Use so that the span can be named after the
+// route pattern.
+func Middleware(provider trace.TracerProvider) func(http.Handler) http.Handler {
+	propagator := propagation.TraceContext{}
+	return func(next http.Handler) http.Handler {
+		routed := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			propagator.Inject(r.Context(), propagation.HeaderCarrier(w.Header()))
+
+			next.ServeHTTP(w, r)
+
+			if routeCtx := chi.RouteContext(r.Context()); routeCtx != nil && routeCtx.RoutePattern() != "" {
+				span := trace.SpanFromContext(r.Context())
+				span.SetName(r.Method + " " + routeCtx.RoutePattern())
+				span.SetAttributes(attribute.String("http.route", routeCtx.RoutePattern()))
+			}
+		})
+		return otelhttp.NewHandler(routed, "",
+			otelhttp.WithTracerProvider(provider),
+			otelhttp.WithPropagators(propagator),
+			otelhttp.WithSpanNameFormatter(func(_ string, r *http.Request) string { return r.Method }),
+		)
+	}
+}
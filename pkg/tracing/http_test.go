@@ -0,0 +1,65 @@
+package tracing_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/mathefer/tc-fiap-product/pkg/tracing"
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func TestMiddleware_ContinuesIncomingTrace(t *testing.T) {
+	// Arrange
+	provider, exporter := useInMemoryTracer(t)
+	r := chi.NewRouter()
+	r.Use(tracing.Middleware(provider))
+	r.Get("/v1/product/{id}", func(w http.ResponseWriter, r *http.Request) {
+		_, span := tracing.Start(r.Context(), "handler")
+		span.End()
+		w.WriteHeader(http.StatusInternalServerError)
+	})
+
+	req := httptest.NewRequest(http.MethodGet, "/v1/product/7", nil)
+	req.Header.Set("traceparent", "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01")
+	rec := httptest.NewRecorder()
+
+	// Act
+	r.ServeHTTP(rec, req)
+
+	// Assert
+	spans := exporter.GetSpans()
+	assert.Len(t, spans, 2)
+	handler, server := spans[0], spans[1]
+	assert.Equal(t, "GET /v1/product/{id}", server.Name)
+	assert.Equal(t, trace.SpanKindServer, server.SpanKind)
+	assert.Equal(t, "4bf92f3577b34da6a3ce929d0e0e4736", server.SpanContext.TraceID().String())
+	assert.Equal(t, "00f067aa0ba902b7", server.Parent.SpanID().String())
+	assert.Equal(t, codes.Error, server.Status.Code)
+	assert.Contains(t, server.Attributes, attribute.String("http.route", "/v1/product/{id}"))
+	assert.Contains(t, server.Attributes, attribute.Int("http.response.status_code", http.StatusInternalServerError))
+	assert.Equal(t, server.SpanContext.SpanID(), handler.Parent.SpanID())
+	assert.Equal(t, "00-4bf92f3577b34da6a3ce929d0e0e4736-"+server.SpanContext.SpanID().String()+"-01", rec.Header().Get("traceparent"))
+}
+
+func TestMiddleware_StartsNewTraceWithoutHeader(t *testing.T) {
+	// Arrange
+	provider, exporter := useInMemoryTracer(t)
+	r := chi.NewRouter()
+	r.Use(tracing.Middleware(provider))
+	r.Get("/ok", func(w http.ResponseWriter, r *http.Request) {})
+
+	// Act
+	r.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/ok", nil))
+
+	// Assert
+	spans := exporter.GetSpans()
+	assert.Len(t, spans, 1)
+	assert.False(t, spans[0].Parent.IsValid())
+	assert.Equal(t, codes.Unset, spans[0].Status.Code)
+	assert.Contains(t, spans[0].Attributes, attribute.Int("http.response.status_code", http.StatusOK))
+}
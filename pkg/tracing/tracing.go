@@ -0,0 +1,30 @@
+// Package tracing sets up OpenTelemetry tracing across the HTTP, use case
+// and database layers and exports spans over OTLP/HTTP or to stdout.
+package tracing
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// instrumentationName names the tracer of the service's own spans.
+const instrumentationName = "github.com/mathefer/tc-fiap-product"
+
+// Start begins a span named name as a child of the span in ctx, using the
+// global tracer provider. The returned context carries the new span.
+func Start(ctx context.Context, name string, options ...trace.SpanStartOption) (context.Context, trace.Span) {
+	return otel.Tracer(instrumentationName).Start(ctx, name, options...)
+}
+
+// RecordError marks span as failed with err. A nil err is ignored so that
+// it can be called unconditionally before End.
+func RecordError(span trace.Span, err error) {
+	if err == nil {
+		return
+	}
+	span.RecordError(err)
+	span.SetStatus(codes.Error, err.Error())
+}
@@ -0,0 +1,117 @@
+package tracing_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/mathefer/tc-fiap-product/pkg/tracing"
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// useInMemoryTracer installs a tracer provider recording to memory as the
+// global one for the duration of the test.
+func useInMemoryTracer(t *testing.T) (*sdktrace.TracerProvider, *tracetest.InMemoryExporter) {
+	exporter := tracetest.NewInMemoryExporter()
+	provider := sdktrace.NewTracerProvider(sdktrace.WithSyncer(exporter))
+	previous := otel.GetTracerProvider()
+	otel.SetTracerProvider(provider)
+	t.Cleanup(func() { otel.SetTracerProvider(previous) })
+	return provider, exporter
+}
+
+func TestStart_ChildSpansShareTheTrace(t *testing.T) {
+	// Arrange
+	_, exporter := useInMemoryTracer(t)
+
+	// Act
+	ctx, parent := tracing.Start(context.Background(), "parent")
+	_, child := tracing.Start(ctx, "child", trace.WithAttributes(attribute.String("key", "value")))
+	tracing.RecordError(child, errors.New("boom"))
+	child.End()
+	parent.End()
+
+	// Assert
+	spans := exporter.GetSpans()
+	assert.Len(t, spans, 2)
+	assert.Equal(t, "child", spans[0].Name)
+	assert.Equal(t, parent.SpanContext().TraceID(), spans[0].SpanContext.TraceID())
+	assert.Equal(t, parent.SpanContext().SpanID(), spans[0].Parent.SpanID())
+	assert.Equal(t, sdktrace.Status{Code: codes.Error, Description: "boom"}, spans[0].Status)
+	assert.Equal(t, []attribute.KeyValue{attribute.String("key", "value")}, spans[0].Attributes)
+	assert.Equal(t, "parent", spans[1].Name)
+	assert.False(t, spans[1].Parent.IsValid())
+}
+
+func TestRecordError_IgnoresNil(t *testing.T) {
+	// Arrange
+	_, exporter := useInMemoryTracer(t)
+	_, span := tracing.Start(context.Background(), "operation")
+
+	// Act
+	tracing.RecordError(span, nil)
+	span.End()
+
+	// Assert
+	assert.Equal(t, codes.Unset, exporter.GetSpans()[0].Status.Code)
+	assert.Empty(t, exporter.GetSpans()[0].Events)
+}
+
+func TestNewTracerProvider_NoneStillCreatesSpans(t *testing.T) {
+	// Arrange
+	provider, err := tracing.NewTracerProvider(context.Background(), tracing.Config{Exporter: "none", ServiceName: "tc-fiap-product"})
+	assert.NoError(t, err)
+	defer provider.Shutdown(context.Background())
+
+	// Act
+	_, span := provider.Tracer("test").Start(context.Background(), "operation")
+	span.End()
+
+	// Assert - logs and propagation need a valid trace ID
+	assert.True(t, span.SpanContext().IsValid())
+}
+
+func TestNewTracerProvider_UnknownExporter(t *testing.T) {
+	_, err := tracing.NewTracerProvider(context.Background(), tracing.Config{Exporter: "zipkin"})
+
+	assert.EqualError(t, err, `unsupported OTEL_TRACES_EXPORTER "zipkin" (use none, stdout or otlp)`)
+}
+
+func TestNewTracerProvider_OTLPPostsBatchesOnShutdown(t *testing.T) {
+	// Arrange
+	var path, contentType string
+	var body []byte
+	collector := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		path, contentType = r.URL.Path, r.Header.Get("Content-Type")
+		body, _ = io.ReadAll(r.Body)
+	}))
+	defer collector.Close()
+
+	provider, err := tracing.NewTracerProvider(context.Background(), tracing.Config{
+		Exporter:     "otlp",
+		OTLPEndpoint: collector.URL + "/",
+		ServiceName:  "tc-fiap-product",
+	})
+	assert.NoError(t, err)
+	_, span := provider.Tracer("test").Start(context.Background(), "GET /v1/product")
+	span.End()
+
+	// Act
+	err = provider.Shutdown(context.Background())
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, "/v1/traces", path)
+	assert.Equal(t, "application/x-protobuf", contentType)
+	assert.Contains(t, string(body), "tc-fiap-product")
+	assert.Contains(t, string(body), "GET /v1/product")
+}